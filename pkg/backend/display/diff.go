@@ -388,8 +388,15 @@ func renderDiff(
 
 	var details string
 	if metadata.DetailedDiff != nil {
+		// Only translate the detailed diff if it reports any changes; otherwise the inputs are printed unchanged.
+		var diff *resource.ObjectDiff
+		adds, updates, deletes, replaces := deploy.SummarizeDetailedDiff(metadata.DetailedDiff)
+		if adds+updates+deletes+replaces > 0 {
+			diff = engine.TranslateDetailedDiff(&metadata)
+		}
+
 		var buf bytes.Buffer
-		if diff := engine.TranslateDetailedDiff(&metadata); diff != nil {
+		if diff != nil {
 			PrintObjectDiff(&buf, *diff, nil /*include*/, planning, indent+1, opts.SummaryDiff, opts.TruncateOutput, debug)
 		} else {
 			PrintObject(
@@ -1090,6 +1090,8 @@ func (display *ProgressDisplay) getStepDoneDescription(step engine.StepEventMeta
 				opText = "discarding failed"
 			case deploy.OpImport, deploy.OpImportReplacement:
 				opText = "importing failed"
+			case deploy.OpAdopt:
+				opText = "adopting failed"
 			default:
 				contract.Failf("Unrecognized resource step op: %v", op)
 				return ""
@@ -1124,6 +1126,8 @@ func (display *ProgressDisplay) getStepDoneDescription(step engine.StepEventMeta
 				opText = "imported"
 			case deploy.OpImportReplacement:
 				opText = "imported replacement"
+			case deploy.OpAdopt:
+				opText = "adopted"
 			default:
 				contract.Failf("Unrecognized resource step op: %v", op)
 				return ""
@@ -1189,6 +1193,8 @@ func (display *ProgressDisplay) getPreviewText(step engine.StepEventMetadata) st
 		return "import"
 	case deploy.OpImportReplacement:
 		return "import replacement"
+	case deploy.OpAdopt:
+		return "adopt"
 	}
 
 	contract.Failf("Unrecognized resource step op: %v", step.Op)
@@ -1218,6 +1224,8 @@ func (display *ProgressDisplay) getPreviewDoneText(step engine.StepEventMetadata
 		return "discard"
 	case deploy.OpImport, deploy.OpImportReplacement:
 		return "import"
+	case deploy.OpAdopt:
+		return "adopt"
 	}
 
 	contract.Failf("Unrecognized resource step op: %v", step.Op)
@@ -1297,6 +1305,8 @@ func (display *ProgressDisplay) getStepInProgressDescription(step engine.StepEve
 			opText = "importing"
 		case deploy.OpImportReplacement:
 			opText = "importing replacement"
+		case deploy.OpAdopt:
+			opText = "adopting"
 		default:
 			contract.Failf("Unrecognized resource step op: %v", op)
 			return ""
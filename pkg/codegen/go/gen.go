@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"go/format"
 	"io"
+	"math"
 	"os"
 	"path"
 	"reflect"
@@ -169,8 +170,10 @@ type pkgContext struct {
 	// duplicateTokens tracks tokens that exist for both types and resources
 	duplicateTokens map[string]bool
 	functionNames   map[*schema.Function]string
-	tool            string
-	packages        map[string]*pkgContext
+	// enumRawInputs tracks the names of the raw input constructors that are generated for enums
+	enumRawInputs map[*schema.EnumType]string
+	tool          string
+	packages      map[string]*pkgContext
 
 	// Name overrides set in GoPackageInfo
 	modToPkg         map[string]string // Module name -> package name
@@ -186,6 +189,21 @@ type pkgContext struct {
 
 	// Determines if we should emit object defaults code
 	disableObjectDefaults bool
+
+	// Determines if we should emit an enum constraint interface and IsEnum marker methods
+	generateEnumConstraint bool
+
+	// Determines if number enum constants are emitted from validated canonical literals
+	preciseEnumLiterals bool
+
+	// Determines if round-trip fuzz tests are emitted for enums
+	generateEnumFuzzTests bool
+
+	// Determines if tests of the generated enum value lists are emitted
+	generateEnumAllTests bool
+
+	// Determines if convenience methods are emitted for enums and their outputs
+	generateEnumHelpers bool
 }
 
 func (pkg *pkgContext) detailsForType(t schema.Type) *typeDetails {
@@ -1107,7 +1125,7 @@ func (pkg *pkgContext) toOutputMethod(t schema.Type) string {
 // comment. If indent is true, each line is indented with tab character. It returns the number of lines in the
 // resulting comment. It guarantees that each line is terminated with newline character.
 func printComment(w io.Writer, comment string, indent bool) int {
-	comment = codegen.FilterExamples(comment, "go")
+	comment = sanitizeComment(codegen.FilterExamples(comment, "go"))
 
 	lines := strings.Split(comment, "\n")
 	for len(lines) > 0 && lines[len(lines)-1] == "" {
@@ -1126,6 +1144,15 @@ func printComment(w io.Writer, comment string, indent bool) int {
 	return len(lines)
 }
 
+// sanitizeComment normalizes line endings in a description and replaces any characters that may not appear in Go
+// source, such as NUL bytes, byte order marks, and invalid UTF-8, with the Unicode replacement character.
+func sanitizeComment(comment string) string {
+	comment = strings.ReplaceAll(comment, "\r\n", "\n")
+	comment = strings.ReplaceAll(comment, "\r", "\n")
+	comment = strings.ToValidUTF8(comment, "\uFFFD")
+	return strings.NewReplacer("\x00", "\uFFFD", "\uFEFF", "\uFFFD").Replace(comment)
+}
+
 func printCommentWithDeprecationMessage(w io.Writer, comment, deprecationMessage string, indent bool) {
 	lines := printComment(w, comment, indent)
 	if deprecationMessage != "" {
@@ -1395,6 +1422,687 @@ func (pkg *pkgContext) genPtrOutput(w io.Writer, baseName, elementType string) {
 	fmt.Fprint(w, "}\n\n")
 }
 
+// enumConstraintName returns the name of the constraint interface satisfied by the enums in this package. The name
+// is Enum unless that collides with another type in the package.
+func (pkg *pkgContext) enumConstraintName() string {
+	if pkg.names.Has("Enum") {
+		return "PulumiEnum"
+	}
+	return "Enum"
+}
+
+// genEnumConstraint generates a constraint interface that is satisfied by every enum type in this package. The
+// constraint's type set is the union of the enums' underlying types, which allows generic functions to be written
+// over any enum in the package.
+func (pkg *pkgContext) genEnumConstraint(w io.Writer) {
+	underlying := codegen.NewStringSet()
+	for _, e := range pkg.enums {
+		underlying.Add("~" + pkg.typeString(e.ElementType))
+	}
+
+	name := pkg.enumConstraintName()
+	fmt.Fprintf(w, "// %s is the constraint satisfied by every enum type in this package.\n", name)
+	fmt.Fprintf(w, "type %s interface {\n", name)
+	fmt.Fprintf(w, "\t%s\n\n", strings.Join(underlying.SortedValues(), " | "))
+	fmt.Fprintf(w, "\tIsEnum()\n")
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// genEnumMeta generates a pulumi.EnumMeta variable that describes the enum and its declared values. Nothing is
+// generated if the variable's name is already taken by another type in the package.
+func (pkg *pkgContext) genEnumMeta(w io.Writer, name string, enumType *schema.EnumType) {
+	metaName := name + "Meta"
+	if pkg.names.Has(metaName) {
+		return
+	}
+
+	fmt.Fprintf(w, "// %s describes the %s enum and its values.\n", metaName, name)
+	fmt.Fprintf(w, "var %s = pulumi.EnumMeta{\n", metaName)
+	fmt.Fprintf(w, "Name: %q,\n", name)
+	fmt.Fprintf(w, "Type: %q,\n", enumType.Token)
+	fmt.Fprintf(w, "Values: []pulumi.EnumValueMeta{\n")
+	for _, e := range enumType.Elements {
+		fmt.Fprintf(w, "{Name: %q, Value: %s", e.Name, e.Name)
+		if e.DeprecationMessage != "" {
+			fmt.Fprintf(w, ", Deprecated: %q", e.DeprecationMessage)
+		}
+		if pkg.preciseEnumLiterals && enumType.ElementType == schema.NumberType {
+			lit, err := enumNumberLiteral(e.Value.(float64))
+			contract.AssertNoErrorf(err, "enum literals are validated when their constants are generated")
+			fmt.Fprintf(w, ", Literal: %q", lit)
+		}
+		fmt.Fprintf(w, "},\n")
+	}
+	fmt.Fprintf(w, "},\n")
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// enumDefaultValue returns the default value that the package's properties declare for the given enum type, if any.
+// Nil is returned if no property of the enum type has a default, or if its properties disagree about the default.
+func (pkg *pkgContext) enumDefaultValue(enumType *schema.EnumType) *schema.DefaultValue {
+	def, err := pkg.pkg.Definition()
+	contract.AssertNoErrorf(err, "Could not load definition for %q", pkg.pkg.Name())
+
+	var result *schema.DefaultValue
+	conflict := false
+	visit := func(props []*schema.Property) {
+		for _, p := range props {
+			if p.DefaultValue == nil || codegen.UnwrapType(p.Type) != enumType {
+				continue
+			}
+			switch {
+			case result == nil:
+				result = p.DefaultValue
+			case result.Value != p.DefaultValue.Value ||
+				strings.Join(result.Environment, " ") != strings.Join(p.DefaultValue.Environment, " "):
+				conflict = true
+			}
+		}
+	}
+	for _, t := range def.Types {
+		if obj, ok := t.(*schema.ObjectType); ok {
+			visit(obj.Properties)
+		}
+	}
+	for _, r := range def.Resources {
+		visit(r.InputProperties)
+	}
+	if def.Provider != nil {
+		visit(def.Provider.InputProperties)
+	}
+	for _, f := range def.Functions {
+		if f.Inputs != nil {
+			visit(f.Inputs.Properties)
+		}
+	}
+	if conflict {
+		return nil
+	}
+	return result
+}
+
+// enumDefault returns the default value to generate for the given enum, along with the name of the declared constant
+// for its static value, if any. The constants are the names of the enum's declared constants, as returned by
+// enumConstantNames. Nil is returned if there is no default to generate or its name is already taken. It is an error
+// if the static value does not match any of the enum's declared constants.
+func (pkg *pkgContext) enumDefault(name string, enumType *schema.EnumType, constants []string,
+) (*schema.DefaultValue, string, error) {
+	dv := pkg.enumDefaultValue(enumType)
+	if dv == nil {
+		return nil, "", nil
+	}
+
+	defaultName := name + "Default"
+	constant := ""
+	for i, e := range enumType.Elements {
+		if constants[i] == defaultName {
+			return nil, "", nil
+		}
+		if dv.Value != nil && e.Value == dv.Value {
+			constant = constants[i]
+		}
+	}
+	if pkg.names.Has(defaultName) {
+		return nil, "", nil
+	}
+	if dv.Value != nil && constant == "" {
+		return nil, "", fmt.Errorf("default value %v of %s does not match any of its declared values",
+			dv.Value, enumType.Token)
+	}
+	return dv, constant, nil
+}
+
+// genEnumDefault generates a Default constant for an enum whose properties declare a default value. The constant
+// refers to the enum's declared constant for the value. If the default may be read from the environment, a function
+// that reads the environment is generated instead.
+func (pkg *pkgContext) genEnumDefault(w io.Writer, name string, enumType *schema.EnumType, constants []string,
+	usingGenericTypes bool,
+) error {
+	dv, constant, err := pkg.enumDefault(name, enumType, constants)
+	if err != nil || dv == nil {
+		return err
+	}
+
+	defaultName := name + "Default"
+	if len(dv.Environment) == 0 {
+		fmt.Fprintf(w, "// %s is the default value of properties of type %s.\n", defaultName, name)
+		fmt.Fprintf(w, "const %s = %s\n\n", defaultName, constant)
+		return nil
+	}
+
+	// The generic variant has no imports, so it cannot read the environment.
+	if usingGenericTypes {
+		return nil
+	}
+
+	parser, typ := "nil", "string"
+	switch enumType.ElementType {
+	case schema.IntType:
+		parser, typ = pkg.internalModuleName+".ParseEnvInt", "int"
+	case schema.NumberType:
+		parser, typ = pkg.internalModuleName+".ParseEnvFloat", "float64"
+	}
+
+	vars := make([]string, len(dv.Environment))
+	for i, e := range dv.Environment {
+		vars[i] = fmt.Sprintf("%q", e)
+	}
+	source := fmt.Sprintf("the %s environment variable if it is set", dv.Environment[0])
+	if len(dv.Environment) > 1 {
+		source = fmt.Sprintf("the first of the %s environment variables that is set", strings.Join(dv.Environment, ", "))
+	}
+	fallback := "the zero value"
+	if constant != "" {
+		fallback = constant
+	}
+	fmt.Fprintf(w, "// %s returns the default value of properties of type %s. It reads the value from\n", defaultName, name)
+	fmt.Fprintf(w, "// %s, and returns %s otherwise.\n", source, fallback)
+	fmt.Fprintf(w, "func %s() %s {\n", defaultName, name)
+	fmt.Fprintf(w, "if d := %s.GetEnvOrDefault(nil, %s, %s); d != nil {\n",
+		pkg.internalModuleName, parser, strings.Join(vars, ", "))
+	fmt.Fprintf(w, "return %s(d.(%s))\n", name, typ)
+	fmt.Fprintf(w, "}\n")
+	if constant != "" {
+		fmt.Fprintf(w, "return %s\n", constant)
+	} else {
+		fmt.Fprintf(w, "var ret %s\n", name)
+		fmt.Fprintf(w, "return ret\n")
+	}
+	fmt.Fprintf(w, "}\n\n")
+	return nil
+}
+
+// enumUnsetName returns the name of the sentinel constant to generate for the zero value of the given enum. The empty
+// string is returned if one of the enum's declared values is the zero value, or if the sentinel's name is taken.
+func (pkg *pkgContext) enumUnsetName(name string, enumType *schema.EnumType) string {
+	unsetName := name + "Unset"
+	for _, e := range enumType.Elements {
+		if e.Name == unsetName || reflect.ValueOf(e.Value).IsZero() {
+			return ""
+		}
+	}
+	if pkg.names.Has(unsetName) {
+		return ""
+	}
+	return unsetName
+}
+
+// genEnumZero generates an IsZero method for an enum, along with the given sentinel constant for its zero value, if
+// any. The zero value of a string enum is the empty string.
+func (pkg *pkgContext) genEnumZero(w io.Writer, name string, enumType *schema.EnumType, unsetName string) {
+	zero := "0"
+	switch enumType.ElementType {
+	case schema.StringType:
+		zero = `""`
+	case schema.BoolType:
+		zero = "false"
+	}
+
+	if unsetName != "" {
+		fmt.Fprintf(w, "// %s is the zero value of %s. It is not one of the enum's declared values.\n", unsetName, name)
+		fmt.Fprintf(w, "const %s %s = %s\n\n", unsetName, name, zero)
+		zero = unsetName
+	}
+
+	fmt.Fprintf(w, "// IsZero reports whether e is the zero value of %s.\n", name)
+	fmt.Fprintf(w, "func (e %s) IsZero() bool {\n", name)
+	fmt.Fprintf(w, "\treturn e == %s\n", zero)
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// genEnumToWire generates a ToWire method that converts an enum to the representation declared by its schema.
+func (pkg *pkgContext) genEnumToWire(w io.Writer, name string, enumType *schema.EnumType) {
+	wireType := "string"
+	switch enumType.ElementType {
+	case schema.IntType:
+		wireType = "int"
+	case schema.NumberType:
+		wireType = "float64"
+	case schema.BoolType:
+		wireType = "bool"
+	}
+
+	fmt.Fprintf(w, "// ToWire returns e in the representation that the schema declares for %s, which is a %s.\n",
+		name, wireType)
+	fmt.Fprintf(w, "func (e %s) ToWire() interface{} {\n", name)
+	fmt.Fprintf(w, "\treturn %s(e)\n", wireType)
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// enumEpsilon returns the tolerance within which values of a number enum compare equal. This is a tenth of the
+// precision of the most precise of the enum's declared values, so that no two distinct declared values that differ
+// only in their final decimal place compare equal.
+func enumEpsilon(enumType *schema.EnumType) float64 {
+	places := 0
+	for _, e := range enumType.Elements {
+		lit := strconv.FormatFloat(e.Value.(float64), 'f', -1, 64)
+		if i := strings.IndexByte(lit, '.'); i >= 0 && len(lit)-i-1 > places {
+			places = len(lit) - i - 1
+		}
+	}
+	return math.Pow10(-(places + 1))
+}
+
+// genEnumEquals generates an Equals method for an enum. Number enums compare within the tolerance returned by
+// enumEpsilon, as their values may not survive a round trip through JSON exactly; all other enums compare exactly.
+func (pkg *pkgContext) genEnumEquals(w io.Writer, name string, enumType *schema.EnumType) {
+	if enumType.ElementType != schema.NumberType {
+		fmt.Fprintf(w, "// Equals reports whether e and other are the same value of %s.\n", name)
+		fmt.Fprintf(w, "func (e %s) Equals(other %s) bool {\n", name, name)
+		fmt.Fprintf(w, "\treturn e == other\n")
+		fmt.Fprintf(w, "}\n\n")
+		return
+	}
+
+	epsilon := strconv.FormatFloat(enumEpsilon(enumType), 'g', -1, 64)
+	fmt.Fprintf(w, "// Equals reports whether e and other are the same value of %s. The values are compared within %s,\n",
+		name, epsilon)
+	fmt.Fprintf(w, "// which is finer than the precision of the enum's declared values, so that values that have passed\n")
+	fmt.Fprintf(w, "// through JSON compare equal to the declared values they represent.\n")
+	fmt.Fprintf(w, "func (e %s) Equals(other %s) bool {\n", name, name)
+	fmt.Fprintf(w, "\td := float64(e - other)\n")
+	fmt.Fprintf(w, "\treturn d <= %[1]s && d >= -%[1]s\n", epsilon)
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// enumNumberLiteral returns the canonical Go literal for the value of a number enum. This is the shortest decimal
+// text that parses back to exactly the same float64. An error is returned if the value has no such literal.
+func enumNumberLiteral(v float64) (string, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return "", fmt.Errorf("%v is not a finite number", v)
+	}
+	lit := strconv.FormatFloat(v, 'g', -1, 64)
+	if parsed, err := strconv.ParseFloat(lit, 64); err != nil || parsed != v {
+		return "", fmt.Errorf("%v does not round-trip through its literal %q", v, lit)
+	}
+	return lit, nil
+}
+
+// enumLookupName returns the name of the unexported map that genEnumLookupMap generates for the given enum. The map is
+// named after the enum, e.g. sizeValues for Size. If that name is taken by another declaration in the package, such as
+// the unexported args of a resource, a number is appended to the name until it is free.
+func (pkg *pkgContext) enumLookupName(name string) string {
+	base := cgstrings.Camel(name) + "Values"
+	lookupName := base
+	for i := 2; pkg.names.Has(lookupName); i++ {
+		lookupName = fmt.Sprintf("%s%d", base, i)
+	}
+	return lookupName
+}
+
+// genEnumLookupMap generates an unexported map from the underlying values of an enum to its declared values and
+// returns the map's name, as returned by enumLookupName. Validation and parsing consult the map rather than scanning
+// the values, so that they take constant time for enums with many values. The map is built in an init function, which
+// also tolerates declared values that share an underlying value, and is never written afterwards, so it is safe to
+// read concurrently.
+func (pkg *pkgContext) genEnumLookupMap(w io.Writer, name string, enumType *schema.EnumType, keyType string) string {
+	lookupName := pkg.enumLookupName(name)
+	constants := make([]string, len(enumType.Elements))
+	for i, e := range enumType.Elements {
+		constants[i] = e.Name
+	}
+
+	fmt.Fprintf(w, "// %s maps the underlying values of %s to its declared values.\n", lookupName, name)
+	fmt.Fprintf(w, "// It is read-only after init, so it is safe to read concurrently.\n")
+	fmt.Fprintf(w, "var %s map[%s]%s\n\n", lookupName, keyType, name)
+	fmt.Fprintf(w, "func init() {\n")
+	fmt.Fprintf(w, "\t%s = make(map[%s]%s, %d)\n", lookupName, keyType, name, len(constants))
+	fmt.Fprintf(w, "\tfor _, v := range []%s{%s} {\n", name, strings.Join(constants, ", "))
+	fmt.Fprintf(w, "\t\t%s[%s(v)] = v\n", lookupName, keyType)
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "}\n\n")
+	return lookupName
+}
+
+// enumParseName returns the name of the function that parses a string as a value of the given enum. String enums also
+// have a loose parser, named with a Loose suffix. The constants are the names of the enum's declared constants, as
+// returned by enumConstantNames. The empty string is returned if either name is taken by one of the enum's constants
+// or by another declaration in the package, in which case no parse functions are generated.
+func (pkg *pkgContext) enumParseName(name string, enumType *schema.EnumType, constants []string) string {
+	parseName := "Parse" + name
+	names := []string{parseName}
+	if enumType.ElementType == schema.StringType {
+		names = append(names, parseName+"Loose")
+	}
+	for _, n := range names {
+		if pkg.names.Has(n) {
+			return ""
+		}
+		for _, c := range constants {
+			if c == n {
+				return ""
+			}
+		}
+	}
+	return parseName
+}
+
+// genEnumParseFuncs generates functions that parse a string as a value of a string enum. The strict parser requires
+// an exact match with one of the enum's values. The loose parser ignores surrounding whitespace and matches either
+// the enum's values or the names declared in the schema case-insensitively. The functions are named parseName and
+// parseName with a Loose suffix, as returned by enumParseName.
+func (pkg *pkgContext) genEnumParseFuncs(w io.Writer, name string, enumType *schema.EnumType, schemaNames []string,
+	parseName, lookupName string,
+) {
+	constants := make([]string, len(enumType.Elements))
+	for i, e := range enumType.Elements {
+		constants[i] = e.Name
+	}
+	values := strings.Join(constants, ", ")
+
+	fmt.Fprintf(w, "// %s parses s as a %s. s must exactly match one of the enum's values.\n", parseName, name)
+	fmt.Fprintf(w, "func %s(s string) (%s, error) {\n", parseName, name)
+	fmt.Fprintf(w, "\tif v, ok := %s[s]; ok {\n", lookupName)
+	fmt.Fprintf(w, "\t\treturn v, nil\n")
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn \"\", fmt.Errorf(\"invalid %s value %%q\", s)\n", name)
+	fmt.Fprintf(w, "}\n\n")
+
+	fmt.Fprintf(w, "// %sLoose parses s as a %s, ignoring surrounding whitespace and case.\n", parseName, name)
+	fmt.Fprintf(w, "// s may match either one of the enum's values or one of its declared names.\n")
+	fmt.Fprintf(w, "func %sLoose(s string) (%s, error) {\n", parseName, name)
+	fmt.Fprintf(w, "\ts = strings.TrimSpace(s)\n")
+	fmt.Fprintf(w, "\tif v, ok := %s[s]; ok {\n", lookupName)
+	fmt.Fprintf(w, "\t\treturn v, nil\n")
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\tfor _, v := range []%s{%s} {\n", name, values)
+	fmt.Fprintf(w, "\t\tif strings.EqualFold(string(v), s) {\n")
+	fmt.Fprintf(w, "\t\t\treturn v, nil\n")
+	fmt.Fprintf(w, "\t\t}\n")
+	fmt.Fprintf(w, "\t}\n")
+	for i, e := range enumType.Elements {
+		if schemaName := schemaNames[i]; schemaName != "" && !strings.EqualFold(schemaName, e.Value.(string)) {
+			fmt.Fprintf(w, "\tif strings.EqualFold(%q, s) {\n", schemaName)
+			fmt.Fprintf(w, "\t\treturn %s, nil\n", e.Name)
+			fmt.Fprintf(w, "\t}\n")
+		}
+	}
+	fmt.Fprintf(w, "\treturn \"\", fmt.Errorf(\"invalid %s value %%q\", s)\n", name)
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// genEnumFloatValidation generates validation and parse functions for a number enum. NaN and infinite values are
+// always rejected, as they can never compare equal to one of the enum's declared values. The parse function is named
+// parseName, as returned by enumParseName, and is not generated if parseName is empty.
+func (pkg *pkgContext) genEnumFloatValidation(w io.Writer, name, parseName, lookupName string) {
+	fmt.Fprintf(w, "// IsValid reports whether e is one of the enum's values. NaN and infinite values are never valid.\n")
+	fmt.Fprintf(w, "func (e %s) IsValid() bool {\n", name)
+	fmt.Fprintf(w, "\tif math.IsNaN(float64(e)) || math.IsInf(float64(e), 0) {\n")
+	fmt.Fprintf(w, "\t\treturn false\n")
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\t_, ok := %s[float64(e)]\n", lookupName)
+	fmt.Fprintf(w, "\treturn ok\n")
+	fmt.Fprintf(w, "}\n\n")
+
+	if parseName == "" {
+		return
+	}
+
+	fmt.Fprintf(w, "// %s parses s as a %s. s must be a finite number equal to one of its values.\n", parseName, name)
+	fmt.Fprintf(w, "func %s(s string) (%s, error) {\n", parseName, name)
+	fmt.Fprintf(w, "\tf, err := strconv.ParseFloat(s, 64)\n")
+	fmt.Fprintf(w, "\tif err != nil {\n")
+	fmt.Fprintf(w, "\t\treturn 0, fmt.Errorf(\"invalid %s value %%q: %%w\", s, err)\n", name)
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\tif math.IsNaN(f) || math.IsInf(f, 0) {\n")
+	fmt.Fprintf(w, "\t\treturn 0, fmt.Errorf(\"invalid %s value %%q: must be a finite number\", s)\n", name)
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\tif e := %s(f); e.IsValid() {\n", name)
+	fmt.Fprintf(w, "\t\treturn e, nil\n")
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn 0, fmt.Errorf(\"invalid %s value %%q\", s)\n", name)
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// enumAllName returns the name of the variable that lists every declared value of the given enum. The empty string is
+// returned if the name is taken by one of the enum's constants or by another declaration in the package.
+func (pkg *pkgContext) enumAllName(name string, enumType *schema.EnumType) string {
+	allName := name + "All"
+	for _, e := range enumType.Elements {
+		if e.Name == allName || e.Name == name+"Missing" {
+			return ""
+		}
+	}
+	if pkg.names.Has(allName) || pkg.names.Has(name+"Missing") {
+		return ""
+	}
+	return allName
+}
+
+// genEnumAll generates a variable named <Enum>All that lists every declared value of an enum in declaration order,
+// along with a function named <Enum>Missing that returns the declared values that are not among the given values.
+// Together they let tests check that a switch over the enum handles every value, so that code keeps up with values
+// that are added to the enum's schema.
+func (pkg *pkgContext) genEnumAll(w io.Writer, name string, enumType *schema.EnumType, allName string) {
+	constants := make([]string, len(enumType.Elements))
+	for i, e := range enumType.Elements {
+		constants[i] = e.Name
+	}
+
+	fmt.Fprintf(w, "// %s lists every declared value of %s, in declaration order.\n", allName, name)
+	fmt.Fprintf(w, "var %s = []%s{%s}\n\n", allName, name, strings.Join(constants, ", "))
+
+	fmt.Fprintf(w, "// %[1]sMissing returns the declared values of %[1]s that are not among handled, in\n", name)
+	fmt.Fprintf(w, "// declaration order. Tests can pass it the values that a switch over %s handles to check\n", name)
+	fmt.Fprintf(w, "// that the switch is exhaustive.\n")
+	fmt.Fprintf(w, "func %[1]sMissing(handled ...%[1]s) []%[1]s {\n", name)
+	fmt.Fprintf(w, "\tvar missing []%s\n", name)
+	fmt.Fprintf(w, "\tfor _, v := range %s {\n", allName)
+	fmt.Fprintf(w, "\t\tfound := false\n")
+	fmt.Fprintf(w, "\t\tfor _, h := range handled {\n")
+	fmt.Fprintf(w, "\t\t\tif h == v {\n")
+	fmt.Fprintf(w, "\t\t\t\tfound = true\n")
+	fmt.Fprintf(w, "\t\t\t\tbreak\n")
+	fmt.Fprintf(w, "\t\t\t}\n")
+	fmt.Fprintf(w, "\t\t}\n")
+	fmt.Fprintf(w, "\t\tif !found {\n")
+	fmt.Fprintf(w, "\t\t\tmissing = append(missing, v)\n")
+	fmt.Fprintf(w, "\t\t}\n")
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn missing\n")
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// genEnumAllTest generates a test named Test<Enum>All that fails if the variable generated by genEnumAll does not
+// list the values recorded in the enum's metadata, in the same order, or if <Enum>Missing reports any of them as
+// missing. The test is not generated if the enum has no list or no metadata.
+func (pkg *pkgContext) genEnumAllTest(w io.Writer, enumType *schema.EnumType) {
+	name := pkg.tokenToEnum(enumType.Token)
+	allName := pkg.enumAllName(name, enumType)
+	metaName := name + "Meta"
+	if allName == "" || pkg.names.Has(metaName) {
+		return
+	}
+
+	fmt.Fprintf(w, "func Test%s(t *testing.T) {\n", allName)
+	fmt.Fprintf(w, "\tif len(%s) != len(%s.Values) {\n", allName, metaName)
+	fmt.Fprintf(w, "\t\tt.Fatalf(\"%s has %%d values, %s has %%d\", len(%s), len(%s.Values))\n",
+		allName, metaName, allName, metaName)
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\thandled := make([]%s, len(%s.Values))\n", name, metaName)
+	fmt.Fprintf(w, "\tfor i, v := range %s.Values {\n", metaName)
+	fmt.Fprintf(w, "\t\tif %s[i] != v.Value {\n", allName)
+	fmt.Fprintf(w, "\t\t\tt.Errorf(\"%s[%%d] is %%v, expected %%s\", i, %s[i], v.Name)\n", allName, allName)
+	fmt.Fprintf(w, "\t\t}\n")
+	fmt.Fprintf(w, "\t\thandled[i] = v.Value.(%s)\n", name)
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\tif missing := %sMissing(handled...); len(missing) > 0 {\n", name)
+	fmt.Fprintf(w, "\t\tt.Errorf(\"%sMissing reported %%v as missing\", missing)\n", name)
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// genEnumTestFile returns the contents of a test file containing the given generated enum tests.
+func (pkg *pkgContext) genEnumTestFile(tests *bytes.Buffer) string {
+	buffer := &bytes.Buffer{}
+	pkg.genHeader(buffer, []string{"testing"}, map[string]string{}, false /* isUtil */)
+	buffer.Write(tests.Bytes())
+	return buffer.String()
+}
+
+// genEnumFuzzTests generates tests that round-trip each declared value of an enum through its serialized forms:
+// Test<Enum>JSONRoundTrip and Fuzz<Enum>JSONRoundTrip for its JSON form and, for string and number enums that have
+// generated parse functions, Test<Enum>TextRoundTrip for its text form. It returns true if the text round-trip of a
+// number enum was generated, which requires the strconv package.
+func (pkg *pkgContext) genEnumFuzzTests(w io.Writer, enumType *schema.EnumType, usingGenericTypes bool) bool {
+	name := pkg.tokenToEnum(enumType.Token)
+	constants := make([]string, len(enumType.Elements))
+	for i, e := range enumType.Elements {
+		constants[i] = e.Name
+	}
+	values := strings.Join(constants, ", ")
+
+	fmt.Fprintf(w, "func Test%sJSONRoundTrip(t *testing.T) {\n", name)
+	fmt.Fprintf(w, "\tfor _, v := range []%s{%s} {\n", name, values)
+	fmt.Fprintf(w, "\t\tdata, err := json.Marshal(v)\n")
+	fmt.Fprintf(w, "\t\tif err != nil {\n")
+	fmt.Fprintf(w, "\t\t\tt.Fatalf(\"marshaling %%v: %%v\", v, err)\n")
+	fmt.Fprintf(w, "\t\t}\n")
+	fmt.Fprintf(w, "\t\tvar got %s\n", name)
+	fmt.Fprintf(w, "\t\tif err := json.Unmarshal(data, &got); err != nil {\n")
+	fmt.Fprintf(w, "\t\t\tt.Fatalf(\"unmarshaling %%s: %%v\", data, err)\n")
+	fmt.Fprintf(w, "\t\t}\n")
+	fmt.Fprintf(w, "\t\tif got != v {\n")
+	fmt.Fprintf(w, "\t\t\tt.Errorf(\"%%v round-tripped through JSON as %%v\", v, got)\n")
+	fmt.Fprintf(w, "\t\t}\n")
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "}\n\n")
+
+	fmt.Fprintf(w, "func Fuzz%sJSONRoundTrip(f *testing.F) {\n", name)
+	fmt.Fprintf(w, "\tfor _, v := range []%s{%s} {\n", name, values)
+	fmt.Fprintf(w, "\t\tdata, err := json.Marshal(v)\n")
+	fmt.Fprintf(w, "\t\tif err != nil {\n")
+	fmt.Fprintf(w, "\t\t\tf.Fatalf(\"marshaling %%v: %%v\", v, err)\n")
+	fmt.Fprintf(w, "\t\t}\n")
+	fmt.Fprintf(w, "\t\tf.Add(data)\n")
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\tf.Fuzz(func(t *testing.T, data []byte) {\n")
+	fmt.Fprintf(w, "\t\tvar v %s\n", name)
+	fmt.Fprintf(w, "\t\tif err := json.Unmarshal(data, &v); err != nil {\n")
+	fmt.Fprintf(w, "\t\t\treturn\n")
+	fmt.Fprintf(w, "\t\t}\n")
+	fmt.Fprintf(w, "\t\tremarshaled, err := json.Marshal(v)\n")
+	fmt.Fprintf(w, "\t\tif err != nil {\n")
+	fmt.Fprintf(w, "\t\t\tt.Fatalf(\"marshaling %%v: %%v\", v, err)\n")
+	fmt.Fprintf(w, "\t\t}\n")
+	fmt.Fprintf(w, "\t\tvar got %s\n", name)
+	fmt.Fprintf(w, "\t\tif err := json.Unmarshal(remarshaled, &got); err != nil {\n")
+	fmt.Fprintf(w, "\t\t\tt.Fatalf(\"unmarshaling %%s: %%v\", remarshaled, err)\n")
+	fmt.Fprintf(w, "\t\t}\n")
+	fmt.Fprintf(w, "\t\tif got != v {\n")
+	fmt.Fprintf(w, "\t\t\tt.Errorf(\"%%v round-tripped through JSON as %%v\", v, got)\n")
+	fmt.Fprintf(w, "\t\t}\n")
+	fmt.Fprintf(w, "\t})\n")
+	fmt.Fprintf(w, "}\n\n")
+
+	// The parse functions are not generated for the generic variant, or if their names are taken.
+	parseName := pkg.enumParseName(name, enumType, constants)
+	var text string
+	switch {
+	case usingGenericTypes || parseName == "":
+		return false
+	case enumType.ElementType == schema.StringType:
+		text = "string(v)"
+	case enumType.ElementType == schema.NumberType:
+		text = "strconv.FormatFloat(float64(v), 'g', -1, 64)"
+	default:
+		return false
+	}
+
+	fmt.Fprintf(w, "func Test%sTextRoundTrip(t *testing.T) {\n", name)
+	fmt.Fprintf(w, "\tfor _, v := range []%s{%s} {\n", name, values)
+	fmt.Fprintf(w, "\t\tgot, err := %s(%s)\n", parseName, text)
+	fmt.Fprintf(w, "\t\tif err != nil {\n")
+	fmt.Fprintf(w, "\t\t\tt.Fatalf(\"parsing %%v: %%v\", v, err)\n")
+	fmt.Fprintf(w, "\t\t}\n")
+	fmt.Fprintf(w, "\t\tif got != v {\n")
+	fmt.Fprintf(w, "\t\t\tt.Errorf(\"%%v round-tripped through text as %%v\", v, got)\n")
+	fmt.Fprintf(w, "\t\t}\n")
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "}\n\n")
+	return enumType.ElementType == schema.NumberType
+}
+
+// genEnumFuzzTestFile returns the contents of a test file containing the given generated enum fuzz tests. The file is
+// only built with the enumfuzz build tag.
+func (pkg *pkgContext) genEnumFuzzTestFile(tests *bytes.Buffer, usesStrconv bool) string {
+	goImports := []string{"encoding/json", "testing"}
+	if usesStrconv {
+		goImports = []string{"encoding/json", "strconv", "testing"}
+	}
+
+	buffer := &bytes.Buffer{}
+	fmt.Fprintf(buffer, "//go:build enumfuzz\n\n")
+	pkg.genHeader(buffer, goImports, map[string]string{}, false /* isUtil */)
+	buffer.Write(tests.Bytes())
+	return buffer.String()
+}
+
+// enumSliceName returns the name of the function that converts values of the given enum's underlying type into a slice
+// of the enum. The constants are the names of the enum's declared constants, as returned by enumConstantNames. The
+// empty string is returned if the name is taken by one of the enum's constants or by another declaration in the
+// package.
+func (pkg *pkgContext) enumSliceName(name string, constants []string) string {
+	sliceName := name + "Slice"
+	for _, c := range constants {
+		if c == sliceName {
+			return ""
+		}
+	}
+	if pkg.names.Has(sliceName) {
+		return ""
+	}
+	return sliceName
+}
+
+// genEnumSliceFunc generates a function named sliceName, as returned by enumSliceName, that converts values of an
+// enum's underlying type into a slice of the enum, validating that each value is one of the enum's declared values by
+// looking it up in the map generated by genEnumLookupMap.
+func (pkg *pkgContext) genEnumSliceFunc(w io.Writer, name string, enumType *schema.EnumType, elementGoType string,
+	sliceName, lookupName string,
+) {
+	verb := "%v"
+	if enumType.ElementType == schema.StringType {
+		verb = "%q"
+	}
+
+	fmt.Fprintf(w, "// %s returns vals as a slice of %s.\n", sliceName, name)
+	fmt.Fprintf(w, "// Every value must be one of the enum's declared values; if any are not, the returned error\n")
+	fmt.Fprintf(w, "// lists all of them.\n")
+	fmt.Fprintf(w, "func %s(vals ...%s) ([]%s, error) {\n", sliceName, elementGoType, name)
+	fmt.Fprintf(w, "\tresult := make([]%s, len(vals))\n", name)
+	fmt.Fprintf(w, "\tvar invalid []string\n")
+	fmt.Fprintf(w, "\tfor i, v := range vals {\n")
+	fmt.Fprintf(w, "\t\tresult[i] = %s(v)\n", name)
+	fmt.Fprintf(w, "\t\tif _, ok := %s[v]; !ok {\n", lookupName)
+	fmt.Fprintf(w, "\t\t\tinvalid = append(invalid, fmt.Sprintf(\"%s at index %%d\", v, i))\n", verb)
+	fmt.Fprintf(w, "\t\t}\n")
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\tif len(invalid) > 0 {\n")
+	fmt.Fprintf(w, "\t\treturn nil, fmt.Errorf(\"invalid %s values: %%s\", strings.Join(invalid, \", \"))\n", name)
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn result, nil\n")
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// enumConstantNames returns the names of the constants that are generated for the elements of the given enum. Elements
+// without a name are named after their values.
+func enumConstantNames(name string, enumType *schema.EnumType) ([]string, error) {
+	constants := make([]string, len(enumType.Elements))
+	for i, e := range enumType.Elements {
+		elementName := e.Name
+		if e.Name == "" {
+			elementName = fmt.Sprintf("%v", e.Value)
+		}
+		enumName, err := makeSafeEnumName(elementName, name)
+		if err != nil {
+			return nil, err
+		}
+		constants[i] = enumName
+	}
+	return constants, nil
+}
+
 func (pkg *pkgContext) genEnum(w io.Writer, enumType *schema.EnumType, usingGenericTypes bool) error {
 	name := pkg.tokenToEnum(enumType.Token)
 
@@ -1402,7 +2110,30 @@ func (pkg *pkgContext) genEnum(w io.Writer, enumType *schema.EnumType, usingGene
 	modPkg, ok := pkg.packages[mod]
 	contract.Assertf(ok, "Context for module %q not found", mod)
 
-	printCommentWithDeprecationMessage(w, enumType.Comment, "", false)
+	constants, err := enumConstantNames(name, enumType)
+	if err != nil {
+		return err
+	}
+	schemaNames := make([]string, len(enumType.Elements))
+	for i, e := range enumType.Elements {
+		schemaNames[i] = e.Name
+		e.Name = constants[i]
+		contract.Assertf(!modPkg.names.Has(e.Name), "Name collision for enum constant: %s for %s",
+			e.Name, enumType.Token)
+	}
+	// The sentinel for the zero value is generated along with the enum's other helpers.
+	unsetName := ""
+	if pkg.generateEnumHelpers {
+		unsetName = pkg.enumUnsetName(name, enumType)
+	}
+
+	lines := printComment(w, enumType.Comment, false)
+	if unsetName != "" {
+		if lines > 0 {
+			fmt.Fprintf(w, "//\n")
+		}
+		printComment(w, fmt.Sprintf("The zero value of %s is not a declared value; see %s.", name, unsetName), false)
+	}
 
 	elementArgsType := pkg.argsTypeImpl(enumType.ElementType)
 	elementGoType := pkg.typeString(enumType.ElementType)
@@ -1414,32 +2145,60 @@ func (pkg *pkgContext) genEnum(w io.Writer, enumType *schema.EnumType, usingGene
 	for _, e := range enumType.Elements {
 		printCommentWithDeprecationMessage(w, e.Comment, e.DeprecationMessage, true)
 
-		elementName := e.Name
-		if e.Name == "" {
-			elementName = fmt.Sprintf("%v", e.Value)
-		}
-		enumName, err := makeSafeEnumName(elementName, name)
-		if err != nil {
-			return err
-		}
-		e.Name = enumName
-		contract.Assertf(!modPkg.names.Has(e.Name), "Name collision for enum constant: %s for %s",
-			e.Name, enumType.Token)
-
 		switch reflect.TypeOf(e.Value).Kind() {
 		case reflect.String:
 			fmt.Fprintf(w, "%s = %s(%q)\n", e.Name, name, e.Value)
+		case reflect.Float64:
+			if pkg.preciseEnumLiterals {
+				lit, err := enumNumberLiteral(e.Value.(float64))
+				if err != nil {
+					return fmt.Errorf("enum value %s of %s cannot be generated precisely: %w", e.Name, enumType.Token, err)
+				}
+				fmt.Fprintf(w, "%s = %s(%s)\n", e.Name, name, lit)
+				break
+			}
+			fmt.Fprintf(w, "%s = %s(%v)\n", e.Name, name, e.Value)
 		default:
 			fmt.Fprintf(w, "%s = %s(%v)\n", e.Name, name, e.Value)
 		}
 	}
 	fmt.Fprintln(w, ")")
 
+	if err := pkg.genEnumDefault(w, name, enumType, constants, usingGenericTypes); err != nil {
+		return err
+	}
+	if pkg.generateEnumHelpers {
+		pkg.genEnumZero(w, name, enumType, unsetName)
+		pkg.genEnumToWire(w, name, enumType)
+		pkg.genEnumEquals(w, name, enumType)
+	}
+	if allName := pkg.enumAllName(name, enumType); allName != "" {
+		pkg.genEnumAll(w, name, enumType, allName)
+	}
+
+	if pkg.generateEnumConstraint {
+		fmt.Fprintf(w, "// IsEnum marks %s as satisfying the %s constraint.\n", name, pkg.enumConstraintName())
+		fmt.Fprintf(w, "func (%s) IsEnum() {}\n\n", name)
+	}
+
 	if usingGenericTypes {
 		// no need to generate the rest of the enum output/input types
 		return nil
 	}
 
+	pkg.genEnumMeta(w, name, enumType)
+
+	var lookupName string
+	parseName := pkg.enumParseName(name, enumType, constants)
+	if enumType.ElementType == schema.StringType && parseName != "" {
+		lookupName = pkg.genEnumLookupMap(w, name, enumType, "string")
+		pkg.genEnumParseFuncs(w, name, enumType, schemaNames, parseName, lookupName)
+	}
+	if enumType.ElementType == schema.NumberType {
+		lookupName = pkg.genEnumLookupMap(w, name, enumType, "float64")
+		pkg.genEnumFloatValidation(w, name, parseName, lookupName)
+	}
+
 	details := pkg.detailsForType(enumType)
 	if details.input || details.ptrInput {
 		inputType := pkg.inputType(enumType)
@@ -1450,11 +2209,21 @@ func (pkg *pkgContext) genEnum(w io.Writer, enumType *schema.EnumType, usingGene
 		pkg.genEnumOutputTypes(w, name, elementArgsType, elementGoType, asFuncName)
 	}
 	if details.input || details.ptrInput {
-		pkg.genEnumInputTypes(w, name, enumType, elementGoType)
+		pkg.genEnumInputTypes(w, name, enumType, schemaNames, elementGoType)
+		if rawName := pkg.enumRawInputName(name, enumType); rawName != "" && (details.output || details.ptrOutput) {
+			pkg.genEnumRawInput(w, name, rawName, elementArgsType, elementGoType, asFuncName)
+			pkg.enumRawInputs[enumType] = rawName
+		}
 	}
 
 	// Generate the array input.
 	if details.arrayInput {
+		if sliceName := pkg.enumSliceName(name, constants); sliceName != "" {
+			if lookupName == "" {
+				lookupName = pkg.genEnumLookupMap(w, name, enumType, elementGoType)
+			}
+			pkg.genEnumSliceFunc(w, name, enumType, elementGoType, sliceName, lookupName)
+		}
 		pkg.genInputInterface(w, name+"Array")
 
 		fmt.Fprintf(w, "type %[1]sArray []%[1]s\n\n", name)
@@ -1487,6 +2256,11 @@ func (pkg *pkgContext) genEnum(w io.Writer, enumType *schema.EnumType, usingGene
 func (pkg *pkgContext) genEnumOutputTypes(w io.Writer, name, elementArgsType, elementGoType, asFuncName string) {
 	pkg.genOutputType(w, name, name, true, false)
 
+	fmt.Fprintf(w, "// Ptr converts the output to a %[1]sPtrOutput. It is shorthand for To%[1]sPtrOutput.\n", name)
+	fmt.Fprintf(w, "func (o %[1]sOutput) Ptr() %[1]sPtrOutput {\n", name)
+	fmt.Fprintf(w, "return o.To%sPtrOutput()\n", name)
+	fmt.Fprint(w, "}\n\n")
+
 	fmt.Fprintf(w, "func (o %[1]sOutput) To%[2]sOutput() %[3]sOutput {\n", name, asFuncName, elementArgsType)
 	fmt.Fprintf(w, "return o.To%sOutputWithContext(context.Background())\n", asFuncName)
 	fmt.Fprint(w, "}\n\n")
@@ -1510,6 +2284,19 @@ func (pkg *pkgContext) genEnumOutputTypes(w io.Writer, name, elementArgsType, el
 
 	pkg.genPtrOutput(w, name, name)
 
+	if pkg.generateEnumHelpers {
+		fmt.Fprintf(w, "// ElemOrDefault is like Elem, but returns the given default rather than the zero value when the "+
+			"pointer is nil.\n")
+		fmt.Fprintf(w, "func (o %[1]sPtrOutput) ElemOrDefault(def %[1]s) %[1]sOutput {\n", name)
+		fmt.Fprintf(w, "return o.ApplyT(func(v *%[1]s) %[1]s {\n", name)
+		fmt.Fprintf(w, "if v != nil {\n")
+		fmt.Fprintf(w, "return *v\n")
+		fmt.Fprintf(w, "}\n")
+		fmt.Fprintf(w, "return def\n")
+		fmt.Fprintf(w, "}).(%sOutput)\n", name)
+		fmt.Fprint(w, "}\n\n")
+	}
+
 	fmt.Fprintf(w, "func (o %[1]sPtrOutput) To%[2]sPtrOutput() %[3]sPtrOutput {\n", name, asFuncName, elementArgsType)
 	fmt.Fprintf(w, "return o.To%sPtrOutputWithContext(context.Background())\n", asFuncName)
 	fmt.Fprint(w, "}\n\n")
@@ -1523,9 +2310,49 @@ func (pkg *pkgContext) genEnumOutputTypes(w io.Writer, name, elementArgsType, el
 	fmt.Fprintf(w, "return &v\n")
 	fmt.Fprintf(w, "}).(%sPtrOutput)\n", elementArgsType)
 	fmt.Fprint(w, "}\n\n")
+
+	if pkg.generateEnumHelpers {
+		pkg.genEnumApplyFuncs(w, name+"Output", name)
+		pkg.genEnumApplyFuncs(w, name+"PtrOutput", "*"+name)
+		pkg.genEnumAnyOutputFuncs(w, name+"Output", name)
+		pkg.genEnumAnyOutputFuncs(w, name+"PtrOutput", "*"+name)
+	}
 }
 
-func (pkg *pkgContext) genEnumInputTypes(w io.Writer, name string, enumType *schema.EnumType, elementGoType string) {
+// genEnumApplyFuncs generates the Apply and ApplyWithContext convenience methods for an enum output type. Each takes
+// a function of the output's element type and returns a pulumi.AnyOutput, whose As*Output methods give typed results.
+func (pkg *pkgContext) genEnumApplyFuncs(w io.Writer, outputType, elementType string) {
+	fmt.Fprintf(w, "// Apply applies the given function to the value of the output, returning an output of the result.\n")
+	fmt.Fprintf(w, "func (o %s) Apply(applier func(%s) interface{}) pulumi.AnyOutput {\n", outputType, elementType)
+	fmt.Fprintf(w, "return o.ApplyT(applier).(pulumi.AnyOutput)\n")
+	fmt.Fprint(w, "}\n\n")
+
+	fmt.Fprintf(w, "// ApplyWithContext is like Apply, but also passes the given context to the function.\n")
+	fmt.Fprintf(w, "func (o %s) ApplyWithContext(ctx context.Context, "+
+		"applier func(context.Context, %s) interface{}) pulumi.AnyOutput {\n", outputType, elementType)
+	fmt.Fprintf(w, "return o.ApplyTWithContext(ctx, applier).(pulumi.AnyOutput)\n")
+	fmt.Fprint(w, "}\n\n")
+}
+
+// genEnumAnyOutputFuncs generates methods that convert an enum output type to a pulumi.AnyOutput whose value is the
+// output's element, so that enum outputs can be passed to APIs that accept any output.
+func (pkg *pkgContext) genEnumAnyOutputFuncs(w io.Writer, outputType, elementType string) {
+	fmt.Fprintf(w, "// ToAnyOutput converts the output to a pulumi.AnyOutput with the same value.\n")
+	fmt.Fprintf(w, "func (o %s) ToAnyOutput() pulumi.AnyOutput {\n", outputType)
+	fmt.Fprintf(w, "return o.ToAnyOutputWithContext(context.Background())\n")
+	fmt.Fprint(w, "}\n\n")
+
+	fmt.Fprintf(w, "// ToAnyOutputWithContext is like ToAnyOutput, but uses the given context.\n")
+	fmt.Fprintf(w, "func (o %s) ToAnyOutputWithContext(ctx context.Context) pulumi.AnyOutput {\n", outputType)
+	fmt.Fprintf(w, "return o.ApplyTWithContext(ctx, func(_ context.Context, v %s) interface{} {\n", elementType)
+	fmt.Fprintf(w, "return v\n")
+	fmt.Fprintf(w, "}).(pulumi.AnyOutput)\n")
+	fmt.Fprint(w, "}\n\n")
+}
+
+func (pkg *pkgContext) genEnumInputTypes(w io.Writer, name string, enumType *schema.EnumType, schemaNames []string,
+	elementGoType string,
+) {
 	pkg.genInputInterface(w, name)
 
 	typeName := cgstrings.Camel(name)
@@ -1547,6 +2374,40 @@ func (pkg *pkgContext) genEnumInputTypes(w io.Writer, name string, enumType *sch
 	fmt.Fprintf(w, "}\n")
 	fmt.Fprintln(w)
 
+	constants := codegen.NewStringSet()
+	for _, e := range enumType.Elements {
+		constants.Add(e.Name)
+	}
+
+	// Generate a constructor from a pointer to the enum.
+	if fromPtrName := pkg.enumPtrFromPtrName(name, enumType); fromPtrName != "" {
+		fmt.Fprintf(w, "// %s returns a %sPtrInput for the value that v points to. A nil pointer returns a nil input.\n",
+			fromPtrName, name)
+		fmt.Fprintf(w, "func %s(v *%s) %sPtrInput {\n", fromPtrName, name, name)
+		fmt.Fprintf(w, "if v == nil {\n")
+		fmt.Fprintf(w, "return nil\n")
+		fmt.Fprintf(w, "}\n")
+		fmt.Fprintf(w, "return %sPtr(%s(*v))\n", name, elementGoType)
+		fmt.Fprintf(w, "}\n")
+		fmt.Fprintln(w)
+	}
+
+	// Generate a named pointer constructor for each declared constant so that programs can refer to well-known
+	// values without repeating their literal values. Skip values that have no name in the schema, whose constants are
+	// named after their values, and any constructor whose name would collide with a constant.
+	for i, e := range enumType.Elements {
+		ctorName := e.Name + "Ptr"
+		if schemaNames[i] == "" || constants.Has(ctorName) {
+			continue
+		}
+		printCommentWithDeprecationMessage(w, fmt.Sprintf("%s returns a %sPtrInput for %s.", ctorName, name, e.Name),
+			e.DeprecationMessage, false)
+		fmt.Fprintf(w, "func %s() %sPtrInput {\n", ctorName, name)
+		fmt.Fprintf(w, "return %sPtr(%s(%s))\n", name, elementGoType, e.Name)
+		fmt.Fprintf(w, "}\n")
+		fmt.Fprintln(w)
+	}
+
 	fmt.Fprintf(w, "func (*%sPtr) ElementType() reflect.Type {\n", typeName)
 	fmt.Fprintf(w, "return %sPtrType\n", typeName)
 	fmt.Fprintf(w, "}\n")
@@ -1570,6 +2431,67 @@ func (pkg *pkgContext) genEnumInputTypes(w io.Writer, name string, enumType *sch
 	fmt.Fprintf(w, "}\n\n")
 }
 
+// enumPtrFromPtrName returns the name of the constructor that returns a pointer input of the given enum for a pointer
+// to the enum. The empty string is returned if the name is taken by one of the enum's constants, by the named pointer
+// constructor of one of its constants, or by another declaration in the package.
+func (pkg *pkgContext) enumPtrFromPtrName(name string, enumType *schema.EnumType) string {
+	fromPtrName := name + "PtrFromPtr"
+	for _, e := range enumType.Elements {
+		if e.Name == fromPtrName || e.Name+"Ptr" == fromPtrName {
+			return ""
+		}
+	}
+	if pkg.names.Has(fromPtrName) {
+		return ""
+	}
+	return fromPtrName
+}
+
+// enumRawInputName returns the name of the constructor that adapts an input of the given enum's underlying type to an
+// input of the enum. The empty string is returned if the name is taken by one of the enum's constants or by another
+// declaration in the package.
+func (pkg *pkgContext) enumRawInputName(name string, enumType *schema.EnumType) string {
+	rawName := name + "From" + strings.TrimPrefix(pkg.argsTypeImpl(enumType.ElementType), "pulumi.")
+	for _, e := range enumType.Elements {
+		if e.Name == rawName || e.Name == rawName+"Input" {
+			return ""
+		}
+	}
+	if pkg.names.Has(rawName) || pkg.names.Has(rawName+"Input") {
+		return ""
+	}
+	return rawName
+}
+
+// genEnumRawInput generates an input type that adapts an input of the enum's underlying type, e.g. pulumi.Float64(6)
+// or a pulumi.StringOutput, to an input of the enum, for callers that have a raw value rather than a constant, along
+// with the constructor named rawName that returns it. The value is not checked against the enum's declared values.
+func (pkg *pkgContext) genEnumRawInput(w io.Writer, name, rawName, elementArgsType, elementGoType, asFuncName string) {
+	typeName := cgstrings.Camel(rawName)
+
+	fmt.Fprintf(w, "// %sInput is an input of %s that is built from an input of the underlying %s type.\n",
+		rawName, name, elementGoType)
+	fmt.Fprintf(w, "// Construct one with %s.\n", rawName)
+	fmt.Fprintf(w, "type %sInput interface {\n", rawName)
+	fmt.Fprint(w, "pulumi.Input\n\n")
+	fmt.Fprintf(w, "To%[1]sOutput() %[1]sOutput\n", name)
+	fmt.Fprintf(w, "To%[1]sOutputWithContext(context.Context) %[1]sOutput\n", name)
+	fmt.Fprintf(w, "To%[1]sPtrOutput() %[1]sPtrOutput\n", name)
+	fmt.Fprintf(w, "To%[1]sPtrOutputWithContext(context.Context) %[1]sPtrOutput\n", name)
+	fmt.Fprintf(w, "}\n\n")
+
+	fmt.Fprintf(w, "type %s struct{ %sOutput }\n\n", typeName, name)
+
+	fmt.Fprintf(w, "// %s returns a %sInput for an input of the underlying %s type, such as a %s\n",
+		rawName, rawName, elementGoType, elementArgsType)
+	fmt.Fprintf(w, "// or a %sOutput. The value is not checked against the enum's declared values.\n", elementArgsType)
+	fmt.Fprintf(w, "func %[1]s(v %[2]sInput) %[1]sInput {\n", rawName, elementArgsType)
+	fmt.Fprintf(w, "return %s{v.To%sOutput().ApplyT(func(v %s) %s {\n", typeName, asFuncName, elementGoType, name)
+	fmt.Fprintf(w, "return %s(v)\n", name)
+	fmt.Fprintf(w, "}).(%sOutput)}\n", name)
+	fmt.Fprintf(w, "}\n\n")
+}
+
 func (pkg *pkgContext) genEnumInputFuncs(w io.Writer, typeName string, enum *schema.EnumType, elementArgsType, inputType, asFuncName string) {
 	fmt.Fprintln(w)
 	fmt.Fprintf(w, "func (%s) ElementType() reflect.Type {\n", typeName)
@@ -2007,6 +2929,14 @@ func (pkg *pkgContext) setDefaultValue(
 	contract.Requiref(dv.Value != nil || len(dv.Environment) > 0,
 		"dv", "must have either a value or an environment variable override")
 
+	// Enum values are converted to the enum's type once they have been chosen.
+	var enumType *schema.EnumType
+	enumName := ""
+	if e, ok := t.(*schema.EnumType); ok {
+		enumType = e
+		enumName = strings.TrimSuffix(pkg.typeString(codegen.UnwrapType(t)), "Input")
+	}
+
 	var val string
 	if dv.Value != nil {
 		v, err := goPrimitiveValue(dv.Value)
@@ -2014,16 +2944,14 @@ func (pkg *pkgContext) setDefaultValue(
 			return err
 		}
 		val = v
-		switch t.(type) {
-		case *schema.EnumType:
-			typeName := strings.TrimSuffix(pkg.typeString(codegen.UnwrapType(t)), "Input")
-			val = fmt.Sprintf("%s(%s)", typeName, val)
-		}
 	}
 
 	if len(dv.Environment) == 0 {
 		// If there's no environment variable override,
 		// assign and we're done.
+		if enumType != nil {
+			val = fmt.Sprintf("%s(%s)", enumName, val)
+		}
 		return assign(w, val)
 	}
 
@@ -2035,7 +2963,11 @@ func (pkg *pkgContext) setDefaultValue(
 	case *schema.ArrayType:
 		parser, typ = fmt.Sprintf("%s.ParseEnvStringArray", pkg.internalModuleName), "pulumi.StringArray"
 	}
-	switch t {
+	elementType := t
+	if enumType != nil {
+		elementType = enumType.ElementType
+	}
+	switch elementType {
 	case schema.BoolType:
 		parser, typ = fmt.Sprintf("%s.ParseEnvBool", pkg.internalModuleName), "bool"
 	case schema.IntType:
@@ -2066,7 +2998,11 @@ func (pkg *pkgContext) setDefaultValue(
 		fmt.Fprintf(w, ", %q", e)
 	}
 	fmt.Fprintf(w, "); d != nil {\n\t")
-	if err := assign(w, fmt.Sprintf("d.(%v)", typ)); err != nil {
+	value := fmt.Sprintf("d.(%v)", typ)
+	if enumType != nil {
+		value = fmt.Sprintf("%s(%s)", enumName, value)
+	}
+	if err := assign(w, value); err != nil {
 		return err
 	}
 	fmt.Fprintf(w, "}\n")
@@ -3453,6 +4389,11 @@ func (pkg *pkgContext) genEnumRegistrations(w io.Writer) {
 				fmt.Fprintf(w,
 					"\tpulumi.RegisterInputType(reflect.TypeOf((*%[1]sPtrInput)(nil)).Elem(), %[1]s(%[2]s))\n",
 					name, instance)
+				if rawName, ok := pkg.enumRawInputs[e]; ok {
+					fmt.Fprintf(w,
+						"\tpulumi.RegisterInputType(reflect.TypeOf((*%sInput)(nil)).Elem(), %s{})\n",
+						rawName, cgstrings.Camel(rawName))
+				}
 			}
 			if details.arrayInput {
 				fmt.Fprintf(w,
@@ -3940,6 +4881,7 @@ func generatePackageContextMap(tool string, pkg schema.PackageReference, goInfo
 				renamed:                       map[string]string{},
 				duplicateTokens:               map[string]bool{},
 				functionNames:                 map[*schema.Function]string{},
+				enumRawInputs:                 map[*schema.EnumType]string{},
 				tool:                          tool,
 				modToPkg:                      goInfo.ModuleToPackage,
 				pkgImportAliases:              goInfo.PackageImportAliases,
@@ -3947,6 +4889,11 @@ func generatePackageContextMap(tool string, pkg schema.PackageReference, goInfo
 				liftSingleValueMethodReturns:  goInfo.LiftSingleValueMethodReturns,
 				disableInputTypeRegistrations: goInfo.DisableInputTypeRegistrations,
 				disableObjectDefaults:         goInfo.DisableObjectDefaults,
+				generateEnumConstraint:        goInfo.GenerateEnumConstraint,
+				preciseEnumLiterals:           goInfo.PreciseEnumLiterals,
+				generateEnumFuzzTests:         goInfo.GenerateEnumFuzzTests,
+				generateEnumAllTests:          goInfo.GenerateEnumAllTests,
+				generateEnumHelpers:           goInfo.GenerateEnumHelpers,
 				internalModuleName:            internalModuleName,
 				externalPackages:              externalPkgs,
 			}
@@ -4095,6 +5042,12 @@ func generatePackageContextMap(tool string, pkg schema.PackageReference, goInfo
 				pkg := getPkgFromToken(typ.Token)
 				pkg.enums = append(pkg.enums, typ)
 
+				if goInfo.GenerateEnumCollectionTypes {
+					details := pkg.detailsForType(typ)
+					details.markArray(true, true)
+					details.markMap(true, true)
+				}
+
 				populateDetailsForTypes(seenMap, typ, false, false, false)
 			}
 		}
@@ -4663,10 +5616,31 @@ func GeneratePackage(tool string, pkg *schema.Package) (map[string][]byte, error
 
 		// Enums
 		if len(pkg.enums) > 0 {
-			hasOutputs, imports := false, map[string]string{}
+			hasOutputs, hasStrings, hasNumbers, hasSlices, imports := false, false, false, false, map[string]string{}
+			hasNumberParse := false
 			for _, e := range pkg.enums {
 				pkg.getImports(e, imports)
 				hasOutputs = hasOutputs || pkg.detailsForType(e).hasOutputs()
+
+				// The names of the enums' constants are computed as genEnum computes them, so that the checks for
+				// constants that are already named like the generated declarations agree with the generated code.
+				name := pkg.tokenToEnum(e.Token)
+				constants, err := enumConstantNames(name, e)
+				if err != nil {
+					return nil, err
+				}
+
+				// Parse and slice functions are only generated if their names are free.
+				hasSlices = hasSlices || pkg.detailsForType(e).arrayInput && pkg.enumSliceName(name, constants) != ""
+				hasParse := pkg.enumParseName(name, e, constants) != ""
+				hasStrings = hasStrings || e.ElementType == schema.StringType && hasParse
+				hasNumbers = hasNumbers || e.ElementType == schema.NumberType
+				hasNumberParse = hasNumberParse || e.ElementType == schema.NumberType && hasParse
+
+				// Enum defaults that are read from the environment use the package's internal utilities.
+				if dv, _, _ := pkg.enumDefault(name, e, constants); dv != nil && len(dv.Environment) > 0 {
+					imports[path.Join(pkg.importBasePath, pkg.internalModuleName)] = ""
+				}
 			}
 			var goImports []string
 			if hasOutputs {
@@ -4674,6 +5648,22 @@ func GeneratePackage(tool string, pkg *schema.Package) (map[string][]byte, error
 				imports["github.com/pulumi/pulumi/sdk/v3/go/pulumi"] = ""
 				imports["github.com/pulumi/pulumi/sdk/v3/go/pulumix"] = ""
 			}
+			if hasStrings || hasSlices {
+				// String enums have generated parse functions, and enums with array inputs have generated slice
+				// functions.
+				goImports = append(goImports, "fmt", "strings")
+			}
+			if hasNumbers {
+				// Number enums have generated validation and parse functions.
+				goImports = append(goImports, "math")
+				if hasNumberParse {
+					if !hasStrings && !hasSlices {
+						goImports = append(goImports, "fmt")
+					}
+					goImports = append(goImports, "strconv")
+				}
+			}
+			sort.Strings(goImports)
 
 			buffer := &bytes.Buffer{}
 			genericVariantBuffer := &bytes.Buffer{}
@@ -4681,21 +5671,51 @@ func GeneratePackage(tool string, pkg *schema.Package) (map[string][]byte, error
 			// we do not need any imports for the generic variant
 			pkg.genHeader(genericVariantBuffer, []string{}, map[string]string{}, false /* isUtil */)
 
+			if pkg.generateEnumConstraint {
+				pkg.genEnumConstraint(buffer)
+				pkg.genEnumConstraint(genericVariantBuffer)
+			}
+
+			// Each enum's list of declared values can be checked by a generated test. The generic variant has no enum
+			// metadata to check against, so the tests are only generated for the legacy variant.
+			testsBuffer := &bytes.Buffer{}
+			fuzzBuffer, genericVariantFuzzBuffer := &bytes.Buffer{}, &bytes.Buffer{}
+			fuzzUsesStrconv := false
 			for _, e := range pkg.enums {
 				// generate enums for legacy variant
 				if err := pkg.genEnum(buffer, e, false); err != nil {
 					return nil, err
 				}
+				if pkg.generateEnumAllTests {
+					pkg.genEnumAllTest(testsBuffer, e)
+				}
+				if pkg.generateEnumFuzzTests && pkg.genEnumFuzzTests(fuzzBuffer, e, false) {
+					fuzzUsesStrconv = true
+				}
 
 				// generate enums for generic variant
 				if err := pkg.genEnum(genericVariantBuffer, e, true); err != nil {
 					return nil, err
 				}
+				if pkg.generateEnumFuzzTests {
+					pkg.genEnumFuzzTests(genericVariantFuzzBuffer, e, true)
+				}
 				delete(knownTypes, e)
 			}
 			pkg.genEnumRegistrations(buffer)
 			setFile(path.Join(mod, "pulumiEnums.go"), buffer.String())
 			setGenericVariantFile(path.Join(mod, "pulumiEnums.go"), genericVariantBuffer.String())
+			if testsBuffer.Len() > 0 {
+				setFile(path.Join(mod, "pulumiEnums_test.go"), pkg.genEnumTestFile(testsBuffer))
+			}
+			if fuzzBuffer.Len() > 0 {
+				setFile(path.Join(mod, "pulumiEnums_enum_fuzz_test.go"),
+					pkg.genEnumFuzzTestFile(fuzzBuffer, fuzzUsesStrconv))
+			}
+			if genericVariantFuzzBuffer.Len() > 0 {
+				setGenericVariantFile(path.Join(mod, "pulumiEnums_enum_fuzz_test.go"),
+					pkg.genEnumFuzzTestFile(genericVariantFuzzBuffer, false))
+			}
 		}
 
 		// Types
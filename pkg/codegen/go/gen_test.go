@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -14,6 +15,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/pulumi/pulumi/pkg/v3/codegen"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/testing/test"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/testing/utils"
@@ -452,6 +454,679 @@ func TestTitle(t *testing.T) {
 	assert.Equal("WaldoThud_Fred", Title("waldo-thud_Fred"))
 }
 
+func TestEnumNumberLiteral(t *testing.T) {
+	t.Parallel()
+
+	for v, expected := range map[float64]string{
+		1e-07:   "1e-07",
+		1.0 / 3: "0.3333333333333333",
+		2.0 / 3: "0.6666666666666666",
+		-1.5:    "-1.5",
+		42:      "42",
+	} {
+		lit, err := enumNumberLiteral(v)
+		require.NoError(t, err)
+		assert.Equal(t, expected, lit)
+	}
+
+	for _, v := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		_, err := enumNumberLiteral(v)
+		assert.ErrorContains(t, err, "is not a finite number")
+	}
+}
+
+func TestPrintCommentSanitizes(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	lines := printComment(&buf, "First\r\nSecond\rThird\x00\n\n\uFEFFFourth \xff\n", true)
+	assert.Equal(t, 5, lines)
+	assert.Equal(t, "\t// First\n\t// Second\n\t// Third\uFFFD\n\t//\n\t// \uFFFDFourth \uFFFD\n", buf.String())
+}
+
+func TestEnumDefaultMustMatchConstant(t *testing.T) {
+	t.Parallel()
+
+	pkgSpec := schema.PackageSpec{
+		Name:    "test",
+		Version: "0.0.1",
+		Types: map[string]schema.ComplexTypeSpec{
+			"test:index:MyEnum": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "number"},
+				Enum: []schema.EnumValueSpec{
+					{Name: "Pi", Value: 3.14159},
+					{Name: "E", Value: 2.71828},
+				},
+			},
+		},
+		Resources: map[string]schema.ResourceSpec{
+			"test:index:Res": {
+				InputProperties: map[string]schema.PropertySpec{
+					"ratio": {
+						TypeSpec: schema.TypeSpec{Ref: "#/types/test:index:MyEnum"},
+						Default:  1.5,
+					},
+				},
+			},
+		},
+	}
+
+	loader := schema.NewPluginLoader(utils.NewHost(testdataPath))
+	pkg, diags, err := schema.BindSpec(pkgSpec, loader)
+	require.NoError(t, err)
+	require.False(t, diags.HasErrors(), diags.Error())
+
+	_, err = GeneratePackage("tests", pkg)
+	assert.EqualError(t, err, "default value 1.5 of test:index:MyEnum does not match any of its declared values")
+}
+
+func TestEnumPtrFromPtrNameCollisions(t *testing.T) {
+	t.Parallel()
+
+	enumSpec := func(names ...string) schema.ComplexTypeSpec {
+		spec := schema.ComplexTypeSpec{ObjectTypeSpec: schema.ObjectTypeSpec{Type: "string"}}
+		for _, name := range names {
+			spec.Enum = append(spec.Enum, schema.EnumValueSpec{Name: name, Value: strings.ToLower(name)})
+		}
+		return spec
+	}
+	pkgSpec := schema.PackageSpec{
+		Name:    "test",
+		Version: "0.0.1",
+		Types: map[string]schema.ComplexTypeSpec{
+			"test:index:Plain":        enumSpec("Small", "Large"),
+			"test:index:Constant":     enumSpec("Small", "PtrFromPtr"),
+			"test:index:ConstantCtor": enumSpec("Small", "PtrFrom"),
+			"test:index:Declared":     enumSpec("Small", "Large"),
+		},
+		Resources: map[string]schema.ResourceSpec{
+			"test:index:Res": {
+				InputProperties: map[string]schema.PropertySpec{
+					"plain":        {TypeSpec: schema.TypeSpec{Ref: "#/types/test:index:Plain"}},
+					"constant":     {TypeSpec: schema.TypeSpec{Ref: "#/types/test:index:Constant"}},
+					"constantCtor": {TypeSpec: schema.TypeSpec{Ref: "#/types/test:index:ConstantCtor"}},
+					"declared":     {TypeSpec: schema.TypeSpec{Ref: "#/types/test:index:Declared"}},
+				},
+			},
+			"test:index:DeclaredPtrFromPtr": {},
+		},
+	}
+
+	loader := schema.NewPluginLoader(utils.NewHost(testdataPath))
+	pkg, diags, err := schema.BindSpec(pkgSpec, loader)
+	require.NoError(t, err)
+	require.False(t, diags.HasErrors(), diags.Error())
+
+	fs, err := GeneratePackage("tests", pkg)
+	require.NoError(t, err)
+	enums := string(fs["test/pulumiEnums.go"])
+
+	assert.Contains(t, enums, "func PlainPtrFromPtr(v *Plain) PlainPtrInput {")
+	// The constant's name is taken by the helper.
+	assert.Contains(t, enums, "ConstantPtrFromPtr = Constant(\"ptrfromptr\")")
+	assert.NotContains(t, enums, "func ConstantPtrFromPtr(")
+	// The helper's name is taken by the constant's named pointer constructor.
+	assert.Contains(t, enums, "func ConstantCtorPtrFromPtr() ConstantCtorPtrInput {")
+	assert.NotContains(t, enums, "func ConstantCtorPtrFromPtr(v")
+	// The helper's name is taken by a resource.
+	assert.Contains(t, enums, "func DeclaredSmallPtr() DeclaredPtrInput {")
+	assert.NotContains(t, enums, "func DeclaredPtrFromPtr(")
+}
+
+func TestEnumNamedPtrConstructors(t *testing.T) {
+	t.Parallel()
+
+	pkgSpec := schema.PackageSpec{
+		Name:    "test",
+		Version: "0.0.1",
+		Types: map[string]schema.ComplexTypeSpec{
+			"test:index:Size": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "string"},
+				Enum: []schema.EnumValueSpec{
+					{Name: "Small", Value: "small"},
+					// Values without a name get constants named after their values, but no constructors.
+					{Value: "extra large"},
+				},
+			},
+		},
+		Resources: map[string]schema.ResourceSpec{
+			"test:index:Res": {
+				InputProperties: map[string]schema.PropertySpec{
+					"size": {TypeSpec: schema.TypeSpec{Ref: "#/types/test:index:Size"}},
+				},
+			},
+		},
+	}
+
+	loader := schema.NewPluginLoader(utils.NewHost(testdataPath))
+	pkg, diags, err := schema.BindSpec(pkgSpec, loader)
+	require.NoError(t, err)
+	require.False(t, diags.HasErrors(), diags.Error())
+
+	fs, err := GeneratePackage("tests", pkg)
+	require.NoError(t, err)
+	enums := string(fs["test/pulumiEnums.go"])
+
+	assert.Contains(t, enums, "func SizeSmallPtr() SizePtrInput {")
+	assert.Contains(t, enums, "Size_Extra_large = Size(\"extra large\")")
+	assert.NotContains(t, enums, "func Size_Extra_largePtr(")
+}
+
+func TestEnumRawInput(t *testing.T) {
+	t.Parallel()
+
+	pkgSpec := schema.PackageSpec{
+		Name:    "test",
+		Version: "0.0.1",
+		Types: map[string]schema.ComplexTypeSpec{
+			"test:index:Size": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "string"},
+				Enum:           []schema.EnumValueSpec{{Name: "Small", Value: "small"}},
+			},
+			"test:index:Ratio": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "number"},
+				Enum:           []schema.EnumValueSpec{{Name: "Half", Value: 0.5}},
+			},
+			"test:index:Count": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "integer"},
+				Enum:           []schema.EnumValueSpec{{Name: "One", Value: 1}},
+			},
+			// The function's name is taken by a constant.
+			"test:index:Mode": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "string"},
+				Enum:           []schema.EnumValueSpec{{Name: "FromString", Value: "from-string"}},
+			},
+			// The function's name is taken by a resource.
+			"test:index:Speed": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "string"},
+				Enum:           []schema.EnumValueSpec{{Name: "Fast", Value: "fast"}},
+			},
+		},
+		Resources: map[string]schema.ResourceSpec{
+			"test:index:Res": {
+				InputProperties: map[string]schema.PropertySpec{
+					"size":  {TypeSpec: schema.TypeSpec{Ref: "#/types/test:index:Size"}},
+					"ratio": {TypeSpec: schema.TypeSpec{Ref: "#/types/test:index:Ratio"}},
+					"count": {TypeSpec: schema.TypeSpec{Ref: "#/types/test:index:Count"}},
+					"mode":  {TypeSpec: schema.TypeSpec{Ref: "#/types/test:index:Mode"}},
+					"speed": {TypeSpec: schema.TypeSpec{Ref: "#/types/test:index:Speed"}},
+				},
+			},
+			"test:index:SpeedFromString": {},
+		},
+	}
+
+	loader := schema.NewPluginLoader(utils.NewHost(testdataPath))
+	pkg, diags, err := schema.BindSpec(pkgSpec, loader)
+	require.NoError(t, err)
+	require.False(t, diags.HasErrors(), diags.Error())
+
+	fs, err := GeneratePackage("tests", pkg)
+	require.NoError(t, err)
+	enums := string(fs["test/pulumiEnums.go"])
+
+	assert.Contains(t, enums, "func SizeFromString(v pulumi.StringInput) SizeFromStringInput {\n"+
+		"\treturn sizeFromString{v.ToStringOutput().ApplyT(func(v string) Size {\n")
+	assert.Contains(t, enums, "func RatioFromFloat64(v pulumi.Float64Input) RatioFromFloat64Input {\n"+
+		"\treturn ratioFromFloat64{v.ToFloat64Output().ApplyT(func(v float64) Ratio {\n")
+	assert.Contains(t, enums, "func CountFromInt(v pulumi.IntInput) CountFromIntInput {\n")
+	assert.Contains(t, enums,
+		"pulumi.RegisterInputType(reflect.TypeOf((*SizeFromStringInput)(nil)).Elem(), sizeFromString{})\n")
+	assert.Regexp(t, `ModeFromString\s+= Mode\("from-string"\)`, enums)
+	assert.NotContains(t, enums, "func ModeFromString(")
+	assert.NotContains(t, enums, "ModeFromStringInput")
+	assert.NotContains(t, enums, "func SpeedFromString(")
+	assert.NotContains(t, enums, "SpeedFromStringInput)(nil)")
+}
+
+func TestEnumToWire(t *testing.T) {
+	t.Parallel()
+
+	enumSpec := func(typ string, values ...interface{}) schema.ComplexTypeSpec {
+		spec := schema.ComplexTypeSpec{ObjectTypeSpec: schema.ObjectTypeSpec{Type: typ}}
+		for i, value := range values {
+			spec.Enum = append(spec.Enum, schema.EnumValueSpec{Name: fmt.Sprintf("Value%d", i), Value: value})
+		}
+		return spec
+	}
+	myEnum := enumSpec("number", 3.1415, 2.72)
+	myEnum.Enum[0].Name = "Pi"
+	pkgSpec := schema.PackageSpec{
+		Name:    "test",
+		Version: "0.0.1",
+		Types: map[string]schema.ComplexTypeSpec{
+			"test:index:MyEnum":     myEnum,
+			"test:index:StringEnum": enumSpec("string", "a", "b"),
+			"test:index:IntEnum":    enumSpec("integer", 1, 2),
+			"test:index:BoolEnum":   enumSpec("boolean", true, false),
+		},
+		Language: map[string]schema.RawMessage{"go": schema.RawMessage(`{"generateEnumHelpers":true}`)},
+	}
+
+	pkg, err := schema.ImportSpec(pkgSpec, map[string]schema.Language{"go": Importer})
+	require.NoError(t, err)
+
+	fs, err := GeneratePackage("tests", pkg)
+	require.NoError(t, err)
+	enums := string(fs["test/pulumiEnums.go"])
+
+	assert.Regexp(t, `MyEnumPi\s+= MyEnum\(3\.1415\)`, enums)
+	for name, wireType := range map[string]string{
+		"MyEnum":     "float64",
+		"StringEnum": "string",
+		"IntEnum":    "int",
+		"BoolEnum":   "bool",
+	} {
+		assert.Contains(t, enums, fmt.Sprintf("func (e %s) ToWire() interface{} {\n\treturn %s(e)\n}", name, wireType))
+	}
+}
+
+func TestEnumEquals(t *testing.T) {
+	t.Parallel()
+
+	pkgSpec := schema.PackageSpec{
+		Name:    "test",
+		Version: "0.0.1",
+		Types: map[string]schema.ComplexTypeSpec{
+			"test:index:Tolerance": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "number"},
+				Enum: []schema.EnumValueSpec{
+					{Name: "Fine", Value: 1e-07},
+					{Name: "Finer", Value: 2.5e-07},
+				},
+			},
+			"test:index:Size": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "integer"},
+				Enum:           []schema.EnumValueSpec{{Name: "Small", Value: 1}, {Name: "Large", Value: 2}},
+			},
+		},
+		Language: map[string]schema.RawMessage{"go": schema.RawMessage(`{"generateEnumHelpers":true}`)},
+	}
+
+	pkg, err := schema.ImportSpec(pkgSpec, map[string]schema.Language{"go": Importer})
+	require.NoError(t, err)
+
+	fs, err := GeneratePackage("tests", pkg)
+	require.NoError(t, err)
+	enums := string(fs["test/pulumiEnums.go"])
+
+	// 2.5e-07 has eight decimal places, so values are compared to within a tenth of that.
+	assert.Contains(t, enums, "func (e Tolerance) Equals(other Tolerance) bool {\n"+
+		"\td := float64(e - other)\n\treturn d <= 1e-09 && d >= -1e-09\n}")
+	assert.Contains(t, enums, "func (e Size) Equals(other Size) bool {\n\treturn e == other\n}")
+}
+
+func TestEnumHelpers(t *testing.T) {
+	t.Parallel()
+
+	pkgSpec := schema.PackageSpec{
+		Name:    "test",
+		Version: "0.0.1",
+		Types: map[string]schema.ComplexTypeSpec{
+			"test:index:Size": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "string"},
+				Enum:           []schema.EnumValueSpec{{Name: "Small", Value: "small"}},
+			},
+		},
+		Resources: map[string]schema.ResourceSpec{
+			"test:index:Res": {
+				InputProperties: map[string]schema.PropertySpec{
+					"size": {TypeSpec: schema.TypeSpec{Ref: "#/types/test:index:Size"}},
+				},
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Properties: map[string]schema.PropertySpec{
+						"size": {TypeSpec: schema.TypeSpec{Ref: "#/types/test:index:Size"}},
+					},
+				},
+			},
+		},
+	}
+	helpers := []string{
+		"const SizeUnset Size = \"\"",
+		"func (e Size) IsZero() bool {",
+		"func (e Size) ToWire() interface{} {",
+		"func (e Size) Equals(other Size) bool {",
+		"func (o SizePtrOutput) ElemOrDefault(def Size) SizeOutput {",
+		"func (o SizeOutput) Apply(applier func(Size) interface{}) pulumi.AnyOutput {",
+		"func (o SizePtrOutput) Apply(applier func(*Size) interface{}) pulumi.AnyOutput {",
+		"func (o SizeOutput) ToAnyOutput() pulumi.AnyOutput {",
+		"func (o SizePtrOutput) ToAnyOutput() pulumi.AnyOutput {",
+	}
+
+	generate := func(t *testing.T, language string) string {
+		pkgSpec.Language = map[string]schema.RawMessage{"go": schema.RawMessage(language)}
+		pkg, err := schema.ImportSpec(pkgSpec, map[string]schema.Language{"go": Importer})
+		require.NoError(t, err)
+
+		fs, err := GeneratePackage("tests", pkg)
+		require.NoError(t, err)
+		return string(fs["test/pulumiEnums.go"])
+	}
+
+	enums := generate(t, `{"generateEnumHelpers":true}`)
+	for _, helper := range helpers {
+		assert.Contains(t, enums, helper)
+	}
+
+	enums = generate(t, `{}`)
+	for _, helper := range helpers {
+		assert.NotContains(t, enums, helper)
+	}
+	assert.NotContains(t, enums, "see SizeUnset")
+}
+
+func TestEnumAll(t *testing.T) {
+	t.Parallel()
+
+	pkgSpec := schema.PackageSpec{
+		Name:    "test",
+		Version: "0.0.1",
+		Types: map[string]schema.ComplexTypeSpec{
+			"test:index:MyEnum": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "number"},
+				Enum:           []schema.EnumValueSpec{{Name: "Pi", Value: 3.1415}, {Name: "Small", Value: 1e-07}},
+			},
+			// The constant for this enum's "All" value takes the name of the list, so no list is generated.
+			"test:index:Scope": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "string"},
+				Enum:           []schema.EnumValueSpec{{Value: "All"}, {Value: "None"}},
+			},
+		},
+		Language: map[string]schema.RawMessage{"go": schema.RawMessage(`{"generateEnumAllTests":true}`)},
+	}
+
+	pkg, err := schema.ImportSpec(pkgSpec, map[string]schema.Language{"go": Importer})
+	require.NoError(t, err)
+
+	fs, err := GeneratePackage("tests", pkg)
+	require.NoError(t, err)
+	enums := string(fs["test/pulumiEnums.go"])
+	tests := string(fs["test/pulumiEnums_test.go"])
+
+	assert.Contains(t, enums, "var MyEnumAll = []MyEnum{MyEnumPi, MyEnumSmall}\n")
+	assert.Contains(t, enums, "func MyEnumMissing(handled ...MyEnum) []MyEnum {\n")
+	assert.Contains(t, tests, "func TestMyEnumAll(t *testing.T) {\n"+
+		"\tif len(MyEnumAll) != len(MyEnumMeta.Values) {\n")
+	assert.Contains(t, tests, "\t\tif MyEnumAll[i] != v.Value {\n")
+	assert.Contains(t, tests, "\tif missing := MyEnumMissing(handled...); len(missing) > 0 {\n")
+
+	assert.Regexp(t, `ScopeAll\s+= Scope\("All"\)`, enums)
+	assert.NotContains(t, enums, "func ScopeMissing(")
+	assert.NotContains(t, tests, "TestScopeAll")
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		pkgSpec.Language = nil
+		pkg, err := schema.ImportSpec(pkgSpec, map[string]schema.Language{"go": Importer})
+		require.NoError(t, err)
+
+		fs, err := GeneratePackage("tests", pkg)
+		require.NoError(t, err)
+		assert.Contains(t, string(fs["test/pulumiEnums.go"]), "var MyEnumAll = []MyEnum{MyEnumPi, MyEnumSmall}\n")
+		assert.NotContains(t, fs, "test/pulumiEnums_test.go")
+	})
+}
+
+func TestEnumLookupMap(t *testing.T) {
+	t.Parallel()
+
+	pkgSpec := schema.PackageSpec{
+		Name:    "test",
+		Version: "0.0.1",
+		Types: map[string]schema.ComplexTypeSpec{
+			"test:index:Size": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "string"},
+				Enum:           []schema.EnumValueSpec{{Name: "Small", Value: "small"}, {Name: "Large", Value: "large"}},
+			},
+			"test:index:Ratio": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "number"},
+				Enum:           []schema.EnumValueSpec{{Name: "Half", Value: 0.5}, {Name: "Whole", Value: 1.0}},
+			},
+		},
+	}
+
+	loader := schema.NewPluginLoader(utils.NewHost(testdataPath))
+	pkg, diags, err := schema.BindSpec(pkgSpec, loader)
+	require.NoError(t, err)
+	require.False(t, diags.HasErrors(), diags.Error())
+
+	fs, err := GeneratePackage("tests", pkg)
+	require.NoError(t, err)
+	enums := string(fs["test/pulumiEnums.go"])
+
+	assert.Contains(t, enums, "var sizeValues map[string]Size\n\nfunc init() {\n"+
+		"\tsizeValues = make(map[string]Size, 2)\n"+
+		"\tfor _, v := range []Size{SizeSmall, SizeLarge} {\n\t\tsizeValues[string(v)] = v\n")
+	assert.Contains(t, enums, "func ParseSize(s string) (Size, error) {\n\tif v, ok := sizeValues[s]; ok {\n")
+
+	assert.Contains(t, enums, "var ratioValues map[float64]Ratio\n")
+	assert.Contains(t, enums, "\t_, ok := ratioValues[float64(e)]\n\treturn ok\n")
+}
+
+func TestEnumSliceNameCollisions(t *testing.T) {
+	t.Parallel()
+
+	enumSpec := schema.ComplexTypeSpec{
+		ObjectTypeSpec: schema.ObjectTypeSpec{Type: "integer"},
+		Enum:           []schema.EnumValueSpec{{Name: "One", Value: 1}},
+	}
+	arrayOf := func(token string) schema.PropertySpec {
+		return schema.PropertySpec{TypeSpec: schema.TypeSpec{
+			Type:  "array",
+			Items: &schema.TypeSpec{Ref: "#/types/" + token},
+		}}
+	}
+	pkgSpec := schema.PackageSpec{
+		Name:    "test",
+		Version: "0.0.1",
+		Types: map[string]schema.ComplexTypeSpec{
+			"test:index:Count": enumSpec,
+			// The slice function's name is taken by a resource.
+			"test:index:Level": enumSpec,
+		},
+		Resources: map[string]schema.ResourceSpec{
+			"test:index:Res": {
+				InputProperties: map[string]schema.PropertySpec{
+					"counts": arrayOf("test:index:Count"),
+					"levels": arrayOf("test:index:Level"),
+				},
+			},
+			"test:index:LevelSlice": {},
+		},
+	}
+
+	loader := schema.NewPluginLoader(utils.NewHost(testdataPath))
+	pkg, diags, err := schema.BindSpec(pkgSpec, loader)
+	require.NoError(t, err)
+	require.False(t, diags.HasErrors(), diags.Error())
+
+	fs, err := GeneratePackage("tests", pkg)
+	require.NoError(t, err)
+	enums := string(fs["test/pulumiEnums.go"])
+
+	assert.Contains(t, enums, "func CountSlice(vals ...int) ([]Count, error) {")
+	assert.Contains(t, enums, "var countValues map[int]Count\n")
+	assert.Contains(t, enums, "type LevelArray []Level\n")
+	assert.NotContains(t, enums, "func LevelSlice(")
+	assert.NotContains(t, enums, "levelValues")
+}
+
+func TestEnumLookupName(t *testing.T) {
+	t.Parallel()
+
+	pkg := &pkgContext{names: codegen.NewStringSet("sizeValues", "ratioValues", "ratioValues2")}
+	assert.Equal(t, "countValues", pkg.enumLookupName("Count"))
+	assert.Equal(t, "sizeValues2", pkg.enumLookupName("Size"))
+	assert.Equal(t, "ratioValues3", pkg.enumLookupName("Ratio"))
+}
+
+func TestEnumParseNameCollisions(t *testing.T) {
+	t.Parallel()
+
+	pkgSpec := schema.PackageSpec{
+		Name:    "test",
+		Version: "0.0.1",
+		Types: map[string]schema.ComplexTypeSpec{
+			"test:index:Size": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "string"},
+				Enum:           []schema.EnumValueSpec{{Name: "Small", Value: "small"}},
+			},
+			// The parse function's name is taken by a resource.
+			"test:index:Mode": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "string"},
+				Enum:           []schema.EnumValueSpec{{Name: "Fast", Value: "fast"}},
+			},
+			// The loose parse function's name is taken by a resource.
+			"test:index:Speed": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "string"},
+				Enum:           []schema.EnumValueSpec{{Name: "Slow", Value: "slow"}},
+			},
+			// The parse function's name is taken by a resource. The enum is still validated.
+			"test:index:Ratio": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "number"},
+				Enum:           []schema.EnumValueSpec{{Name: "Half", Value: 0.5}},
+			},
+			// The parse function's name is taken by a constant.
+			"test:index:Parse": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "string"},
+				Enum:           []schema.EnumValueSpec{{Name: "Parse", Value: "parse"}},
+			},
+		},
+		Resources: map[string]schema.ResourceSpec{
+			"test:index:ParseMode":       {},
+			"test:index:ParseSpeedLoose": {},
+			"test:index:ParseRatio":      {},
+		},
+	}
+
+	loader := schema.NewPluginLoader(utils.NewHost(testdataPath))
+	pkg, diags, err := schema.BindSpec(pkgSpec, loader)
+	require.NoError(t, err)
+	require.False(t, diags.HasErrors(), diags.Error())
+
+	fs, err := GeneratePackage("tests", pkg)
+	require.NoError(t, err)
+	enums := string(fs["test/pulumiEnums.go"])
+
+	assert.Contains(t, enums, "func ParseSize(s string) (Size, error) {")
+	assert.Contains(t, enums, "func ParseSizeLoose(s string) (Size, error) {")
+
+	assert.NotContains(t, enums, "func ParseMode(")
+	assert.NotContains(t, enums, "func ParseModeLoose(")
+	assert.NotContains(t, enums, "func ParseSpeed(")
+	assert.NotContains(t, enums, "func ParseSpeedLoose(")
+
+	assert.Contains(t, enums, "func (e Ratio) IsValid() bool {")
+	assert.NotContains(t, enums, "func ParseRatio(")
+	assert.NotContains(t, enums, "\"strconv\"")
+
+	assert.Regexp(t, `ParseParse\s+= Parse\("parse"\)`, enums)
+	assert.NotContains(t, enums, "func ParseParse(")
+	assert.NotContains(t, enums, "func ParseParseLoose(")
+}
+
+func TestEnumFuzzTests(t *testing.T) {
+	t.Parallel()
+
+	generate := func(t *testing.T, language string) map[string][]byte {
+		pkgSpec := schema.PackageSpec{
+			Name:    "test",
+			Version: "0.0.1",
+			Types: map[string]schema.ComplexTypeSpec{
+				"test:index:Size": {
+					ObjectTypeSpec: schema.ObjectTypeSpec{Type: "string"},
+					Enum:           []schema.EnumValueSpec{{Name: "Small", Value: "small"}},
+				},
+				"test:index:Ratio": {
+					ObjectTypeSpec: schema.ObjectTypeSpec{Type: "number"},
+					Enum:           []schema.EnumValueSpec{{Name: "Half", Value: 0.5}},
+				},
+				"test:index:Count": {
+					ObjectTypeSpec: schema.ObjectTypeSpec{Type: "integer"},
+					Enum:           []schema.EnumValueSpec{{Name: "One", Value: 1}},
+				},
+			},
+			Language: map[string]schema.RawMessage{"go": schema.RawMessage(language)},
+		}
+		pkg, err := schema.ImportSpec(pkgSpec, map[string]schema.Language{"go": Importer})
+		require.NoError(t, err)
+
+		fs, err := GeneratePackage("tests", pkg)
+		require.NoError(t, err)
+		return fs
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		fs := generate(t, `{"generateEnumFuzzTests":true}`)
+		require.Contains(t, fs, "test/pulumiEnums_enum_fuzz_test.go")
+		tests := string(fs["test/pulumiEnums_enum_fuzz_test.go"])
+
+		assert.True(t, strings.HasPrefix(tests, "//go:build enumfuzz\n\n"))
+		assert.Contains(t, tests, "\t\"encoding/json\"\n\t\"strconv\"\n\t\"testing\"\n")
+		for _, name := range []string{"Size", "Ratio", "Count"} {
+			assert.Contains(t, tests, "func Test"+name+"JSONRoundTrip(t *testing.T) {\n")
+			assert.Contains(t, tests, "func Fuzz"+name+"JSONRoundTrip(f *testing.F) {\n")
+		}
+		assert.Contains(t, tests, "func TestSizeTextRoundTrip(t *testing.T) {\n")
+		assert.Contains(t, tests, "\t\tgot, err := ParseRatio(strconv.FormatFloat(float64(v), 'g', -1, 64))\n")
+		// Integer enums have no generated parse function.
+		assert.NotContains(t, tests, "TestCountTextRoundTrip")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		fs := generate(t, `{}`)
+		assert.NotContains(t, fs, "test/pulumiEnums_enum_fuzz_test.go")
+	})
+}
+
+func TestEnumDefaultNameTaken(t *testing.T) {
+	t.Parallel()
+
+	// The Go name of the "Default" constant is MyEnumDefault, so no default is generated for the enum, and its file
+	// must not import the internal utilities that reading the default from the environment would use.
+	pkgSpec := schema.PackageSpec{
+		Name:    "test",
+		Version: "0.0.1",
+		Types: map[string]schema.ComplexTypeSpec{
+			"test:index:MyEnum": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "string"},
+				Enum: []schema.EnumValueSpec{
+					{Name: "Default", Value: "default"},
+					{Name: "Other", Value: "other"},
+				},
+			},
+		},
+		Resources: map[string]schema.ResourceSpec{
+			"test:index:Res": {
+				InputProperties: map[string]schema.PropertySpec{
+					"kind": {
+						TypeSpec:    schema.TypeSpec{Ref: "#/types/test:index:MyEnum"},
+						Default:     "other",
+						DefaultInfo: &schema.DefaultSpec{Environment: []string{"TEST_KIND"}},
+					},
+				},
+			},
+		},
+	}
+
+	loader := schema.NewPluginLoader(utils.NewHost(testdataPath))
+	pkg, diags, err := schema.BindSpec(pkgSpec, loader)
+	require.NoError(t, err)
+	require.False(t, diags.HasErrors(), diags.Error())
+
+	files, err := GeneratePackage("tests", pkg)
+	require.NoError(t, err)
+	enums := string(files["test/pulumiEnums.go"])
+	assert.Contains(t, enums, "MyEnumDefault = MyEnum(\"default\")")
+	assert.NotContains(t, enums, "func MyEnumDefault()")
+	assert.NotContains(t, enums, "internal\"")
+}
+
 func TestRegressTypeDuplicatesInChunking(t *testing.T) {
 	t.Parallel()
 	pkgSpec := schema.PackageSpec{
@@ -558,3 +1233,53 @@ func TestRegressTypeDuplicatesInChunking(t *testing.T) {
 		assert.NotContains(t, typedefs1, typ)
 	}
 }
+
+func TestExternalEnumsAreNotDuplicated(t *testing.T) {
+	t.Parallel()
+
+	schemaBytes, err := os.ReadFile(filepath.Join(testdataPath, "external-enum", "schema.json"))
+	require.NoError(t, err)
+	var pkgSpec schema.PackageSpec
+	require.NoError(t, json.Unmarshal(schemaBytes, &pkgSpec))
+
+	loader := schema.NewPluginLoader(utils.NewHost(testdataPath))
+	pkg, diags, err := schema.BindSpec(pkgSpec, loader)
+	require.NoError(t, err)
+	require.False(t, diags.HasErrors(), diags.Error())
+
+	fs, err := GeneratePackage("tests", pkg)
+	require.NoError(t, err)
+
+	typeDecl := regexp.MustCompile(`(?m)^type (\w+)`)
+	registration := regexp.MustCompile(`(?m)^\s*pulumi\.Register(?:Input|Output)Type\(.*\)$`)
+
+	declared := map[string][]string{}
+	registered := map[string][]string{}
+	for name, contents := range fs {
+		if !strings.HasSuffix(name, ".go") {
+			continue
+		}
+		for _, m := range typeDecl.FindAllStringSubmatch(string(contents), -1) {
+			declared[m[1]] = append(declared[m[1]], name)
+		}
+		for _, m := range registration.FindAllString(string(contents), -1) {
+			m = strings.TrimSpace(m)
+			registered[m] = append(registered[m], name)
+		}
+	}
+
+	// Enums from other packages are referenced via their own SDK rather than being redeclared.
+	assert.NotContains(t, declared, "DevicePolicyAllowedDeviceManagementLevelsItem")
+	assert.Contains(t, string(fs["example/component.go"]),
+		"accesscontextmanager.DevicePolicyAllowedDeviceManagementLevelsItemPtrInput")
+
+	// Enums from other modules of the same package are declared only in their own module and referenced elsewhere.
+	assert.Equal(t, []string{"example/local/pulumiEnums.go"}, declared["MyEnum"])
+	assert.Contains(t, string(fs["example/component.go"]), "local.MyEnumPtrInput")
+
+	// Each input and output type is registered exactly once.
+	assert.NotEmpty(t, registered)
+	for call, files := range registered {
+		assert.Len(t, files, 1, "%s is registered in %v", call, files)
+	}
+}
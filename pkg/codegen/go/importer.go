@@ -98,6 +98,40 @@ type GoPackageInfo struct {
 	// - "side-by-side": generate a side-by-side generics variant of the SDK under the x subdirectory
 	// - "only-generics": generate a generics variant of the SDK only
 	Generics string `json:"generics,omitempty"`
+
+	// GenerateEnumConstraint determines whether the code generator emits an `Enum` constraint interface in each
+	// package that has enums, along with an `IsEnum` marker method on each enum type. This allows generic functions
+	// to operate over any enum in the package.
+	GenerateEnumConstraint bool `json:"generateEnumConstraint,omitempty"`
+
+	// PreciseEnumLiterals determines whether number enum constants are emitted from canonical literals. Each literal
+	// is the shortest decimal text that parses back to exactly the schema's value, and generation fails if a value has
+	// no such literal. The literal text is also recorded in the enum's metadata.
+	PreciseEnumLiterals bool `json:"preciseEnumLiterals,omitempty"`
+
+	// GenerateEnumFuzzTests determines whether the code generator emits a pulumiEnums_enum_fuzz_test.go file in each
+	// package that has enums. The file is only built with the enumfuzz build tag. For each enum it contains
+	// Test<Enum>JSONRoundTrip, which round-trips each declared value through encoding/json, and
+	// Fuzz<Enum>JSONRoundTrip, a fuzz test seeded with the declared values. String and number enums that have
+	// generated parse functions also get Test<Enum>TextRoundTrip, which round-trips each declared value through its
+	// text form and Parse<Enum>.
+	GenerateEnumFuzzTests bool `json:"generateEnumFuzzTests,omitempty"`
+
+	// GenerateEnumAllTests determines whether the code generator emits a pulumiEnums_test.go file in each package that
+	// has enums. For each enum with a generated <Enum>All list it contains Test<Enum>All, which checks the list and
+	// <Enum>Missing against the values recorded in <Enum>Meta.
+	GenerateEnumAllTests bool `json:"generateEnumAllTests,omitempty"`
+
+	// GenerateEnumCollectionTypes determines whether the code generator emits the array and map input and output types
+	// for every enum, so that programs can build typed collections of enum values even if the schema itself never
+	// uses one. By default these types are only emitted for enums that the schema uses in an array or a map.
+	GenerateEnumCollectionTypes bool `json:"generateEnumCollectionTypes,omitempty"`
+
+	// GenerateEnumHelpers determines whether the code generator emits convenience methods on enums and their outputs:
+	// IsZero, ToWire and Equals on each enum, along with an <Enum>Unset constant for enums whose zero value is not a
+	// declared value, ElemOrDefault on enum pointer outputs, and Apply, ApplyWithContext, ToAnyOutput and
+	// ToAnyOutputWithContext on enum outputs and pointer outputs.
+	GenerateEnumHelpers bool `json:"generateEnumHelpers,omitempty"`
 }
 
 // Importer implements schema.Language for Go.
@@ -262,6 +262,46 @@ var PulumiPulumiSDKTests = []*SDKTest{
 		Description: "Regress pulumi/pulumi#12971 affecting Go",
 		Skip:        allLanguages.Except("go/any"),
 	},
+	{
+		Directory:   "go-enum-constraint",
+		Description: "Go enums that satisfy a generated Enum constraint",
+		Skip:        allLanguages.Except("go/any"),
+	},
+	{
+		Directory:   "go-enum-meta",
+		Description: "Go enums with generated metadata variables",
+		Skip:        allLanguages.Except("go/any"),
+	},
+	{
+		Directory:   "go-enum-precise-literals",
+		Description: "Go number enums generated from precise literals",
+		Skip:        allLanguages.Except("go/any"),
+	},
+	{
+		Directory:   "go-enum-fuzz-tests",
+		Description: "Go enums with generated round-trip fuzz tests",
+		Skip:        allLanguages.Except("go/any"),
+	},
+	{
+		Directory:   "go-enum-descriptions",
+		Description: "Go enums documented by their schema descriptions",
+		Skip:        allLanguages.Except("go/any"),
+	},
+	{
+		Directory:   "go-enum-defaults",
+		Description: "Go enums with defaults declared by their properties",
+		Skip:        allLanguages.Except("go/any"),
+	},
+	{
+		Directory:   "go-enum-zero",
+		Description: "Go enums whose declared values do and do not include the zero value",
+		Skip:        allLanguages.Except("go/any"),
+	},
+	{
+		Directory:   "go-enum-large",
+		Description: "Go enums with hundreds of declared values",
+		Skip:        allLanguages.Except("go/any"),
+	},
 	{
 		Directory:   "regress-py-12546",
 		Description: "Regress pulumi/pulumi#12546 affecting Python",
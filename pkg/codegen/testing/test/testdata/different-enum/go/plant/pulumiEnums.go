@@ -5,7 +5,11 @@ package plant
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
@@ -25,6 +29,84 @@ const (
 	CloudAuditOptionsLogNameSynthetic = CloudAuditOptionsLogName("SYNTHETIC")
 )
 
+// CloudAuditOptionsLogNameAll lists every declared value of CloudAuditOptionsLogName, in declaration order.
+var CloudAuditOptionsLogNameAll = []CloudAuditOptionsLogName{CloudAuditOptionsLogNameUnspecifiedLogName, CloudAuditOptionsLogNameAdminActivity, CloudAuditOptionsLogNameDataAccess, CloudAuditOptionsLogNameSynthetic}
+
+// CloudAuditOptionsLogNameMissing returns the declared values of CloudAuditOptionsLogName that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over CloudAuditOptionsLogName handles to check
+// that the switch is exhaustive.
+func CloudAuditOptionsLogNameMissing(handled ...CloudAuditOptionsLogName) []CloudAuditOptionsLogName {
+	var missing []CloudAuditOptionsLogName
+	for _, v := range CloudAuditOptionsLogNameAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// CloudAuditOptionsLogNameMeta describes the CloudAuditOptionsLogName enum and its values.
+var CloudAuditOptionsLogNameMeta = pulumi.EnumMeta{
+	Name: "CloudAuditOptionsLogName",
+	Type: "plant::CloudAuditOptionsLogName",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "CloudAuditOptionsLogNameUnspecifiedLogName", Value: CloudAuditOptionsLogNameUnspecifiedLogName},
+		{Name: "CloudAuditOptionsLogNameAdminActivity", Value: CloudAuditOptionsLogNameAdminActivity},
+		{Name: "CloudAuditOptionsLogNameDataAccess", Value: CloudAuditOptionsLogNameDataAccess},
+		{Name: "CloudAuditOptionsLogNameSynthetic", Value: CloudAuditOptionsLogNameSynthetic},
+	},
+}
+
+// cloudAuditOptionsLogNameValues maps the underlying values of CloudAuditOptionsLogName to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var cloudAuditOptionsLogNameValues map[string]CloudAuditOptionsLogName
+
+func init() {
+	cloudAuditOptionsLogNameValues = make(map[string]CloudAuditOptionsLogName, 4)
+	for _, v := range []CloudAuditOptionsLogName{CloudAuditOptionsLogNameUnspecifiedLogName, CloudAuditOptionsLogNameAdminActivity, CloudAuditOptionsLogNameDataAccess, CloudAuditOptionsLogNameSynthetic} {
+		cloudAuditOptionsLogNameValues[string(v)] = v
+	}
+}
+
+// ParseCloudAuditOptionsLogName parses s as a CloudAuditOptionsLogName. s must exactly match one of the enum's values.
+func ParseCloudAuditOptionsLogName(s string) (CloudAuditOptionsLogName, error) {
+	if v, ok := cloudAuditOptionsLogNameValues[s]; ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid CloudAuditOptionsLogName value %q", s)
+}
+
+// ParseCloudAuditOptionsLogNameLoose parses s as a CloudAuditOptionsLogName, ignoring surrounding whitespace and case.
+// s may match either one of the enum's values or one of its declared names.
+func ParseCloudAuditOptionsLogNameLoose(s string) (CloudAuditOptionsLogName, error) {
+	s = strings.TrimSpace(s)
+	if v, ok := cloudAuditOptionsLogNameValues[s]; ok {
+		return v, nil
+	}
+	for _, v := range []CloudAuditOptionsLogName{CloudAuditOptionsLogNameUnspecifiedLogName, CloudAuditOptionsLogNameAdminActivity, CloudAuditOptionsLogNameDataAccess, CloudAuditOptionsLogNameSynthetic} {
+		if strings.EqualFold(string(v), s) {
+			return v, nil
+		}
+	}
+	if strings.EqualFold("UnspecifiedLogName", s) {
+		return CloudAuditOptionsLogNameUnspecifiedLogName, nil
+	}
+	if strings.EqualFold("AdminActivity", s) {
+		return CloudAuditOptionsLogNameAdminActivity, nil
+	}
+	if strings.EqualFold("DataAccess", s) {
+		return CloudAuditOptionsLogNameDataAccess, nil
+	}
+	return "", fmt.Errorf("invalid CloudAuditOptionsLogName value %q", s)
+}
+
 type ContainerBrightness float64
 
 const (
@@ -32,6 +114,77 @@ const (
 	ContainerBrightnessOne          = ContainerBrightness(1)
 )
 
+// ContainerBrightnessDefault is the default value of properties of type ContainerBrightness.
+const ContainerBrightnessDefault = ContainerBrightnessOne
+
+// ContainerBrightnessAll lists every declared value of ContainerBrightness, in declaration order.
+var ContainerBrightnessAll = []ContainerBrightness{ContainerBrightnessZeroPointOne, ContainerBrightnessOne}
+
+// ContainerBrightnessMissing returns the declared values of ContainerBrightness that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over ContainerBrightness handles to check
+// that the switch is exhaustive.
+func ContainerBrightnessMissing(handled ...ContainerBrightness) []ContainerBrightness {
+	var missing []ContainerBrightness
+	for _, v := range ContainerBrightnessAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// ContainerBrightnessMeta describes the ContainerBrightness enum and its values.
+var ContainerBrightnessMeta = pulumi.EnumMeta{
+	Name: "ContainerBrightness",
+	Type: "plant::ContainerBrightness",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "ContainerBrightnessZeroPointOne", Value: ContainerBrightnessZeroPointOne},
+		{Name: "ContainerBrightnessOne", Value: ContainerBrightnessOne},
+	},
+}
+
+// containerBrightnessValues maps the underlying values of ContainerBrightness to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var containerBrightnessValues map[float64]ContainerBrightness
+
+func init() {
+	containerBrightnessValues = make(map[float64]ContainerBrightness, 2)
+	for _, v := range []ContainerBrightness{ContainerBrightnessZeroPointOne, ContainerBrightnessOne} {
+		containerBrightnessValues[float64(v)] = v
+	}
+}
+
+// IsValid reports whether e is one of the enum's values. NaN and infinite values are never valid.
+func (e ContainerBrightness) IsValid() bool {
+	if math.IsNaN(float64(e)) || math.IsInf(float64(e), 0) {
+		return false
+	}
+	_, ok := containerBrightnessValues[float64(e)]
+	return ok
+}
+
+// ParseContainerBrightness parses s as a ContainerBrightness. s must be a finite number equal to one of its values.
+func ParseContainerBrightness(s string) (ContainerBrightness, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ContainerBrightness value %q: %w", s, err)
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("invalid ContainerBrightness value %q: must be a finite number", s)
+	}
+	if e := ContainerBrightness(f); e.IsValid() {
+		return e, nil
+	}
+	return 0, fmt.Errorf("invalid ContainerBrightness value %q", s)
+}
+
 func (ContainerBrightness) ElementType() reflect.Type {
 	return reflect.TypeOf((*ContainerBrightness)(nil)).Elem()
 }
@@ -92,6 +245,11 @@ func (o ContainerBrightnessOutput) ToContainerBrightnessPtrOutputWithContext(ctx
 	}).(ContainerBrightnessPtrOutput)
 }
 
+// Ptr converts the output to a ContainerBrightnessPtrOutput. It is shorthand for ToContainerBrightnessPtrOutput.
+func (o ContainerBrightnessOutput) Ptr() ContainerBrightnessPtrOutput {
+	return o.ToContainerBrightnessPtrOutput()
+}
+
 func (o ContainerBrightnessOutput) ToFloat64Output() pulumi.Float64Output {
 	return o.ToFloat64OutputWithContext(context.Background())
 }
@@ -177,6 +335,24 @@ func ContainerBrightnessPtr(v float64) ContainerBrightnessPtrInput {
 	return (*containerBrightnessPtr)(&v)
 }
 
+// ContainerBrightnessPtrFromPtr returns a ContainerBrightnessPtrInput for the value that v points to. A nil pointer returns a nil input.
+func ContainerBrightnessPtrFromPtr(v *ContainerBrightness) ContainerBrightnessPtrInput {
+	if v == nil {
+		return nil
+	}
+	return ContainerBrightnessPtr(float64(*v))
+}
+
+// ContainerBrightnessZeroPointOnePtr returns a ContainerBrightnessPtrInput for ContainerBrightnessZeroPointOne.
+func ContainerBrightnessZeroPointOnePtr() ContainerBrightnessPtrInput {
+	return ContainerBrightnessPtr(float64(ContainerBrightnessZeroPointOne))
+}
+
+// ContainerBrightnessOnePtr returns a ContainerBrightnessPtrInput for ContainerBrightnessOne.
+func ContainerBrightnessOnePtr() ContainerBrightnessPtrInput {
+	return ContainerBrightnessPtr(float64(ContainerBrightnessOne))
+}
+
 func (*containerBrightnessPtr) ElementType() reflect.Type {
 	return containerBrightnessPtrType
 }
@@ -195,6 +371,27 @@ func (in *containerBrightnessPtr) ToOutput(ctx context.Context) pulumix.Output[*
 	}
 }
 
+// ContainerBrightnessFromFloat64Input is an input of ContainerBrightness that is built from an input of the underlying float64 type.
+// Construct one with ContainerBrightnessFromFloat64.
+type ContainerBrightnessFromFloat64Input interface {
+	pulumi.Input
+
+	ToContainerBrightnessOutput() ContainerBrightnessOutput
+	ToContainerBrightnessOutputWithContext(context.Context) ContainerBrightnessOutput
+	ToContainerBrightnessPtrOutput() ContainerBrightnessPtrOutput
+	ToContainerBrightnessPtrOutputWithContext(context.Context) ContainerBrightnessPtrOutput
+}
+
+type containerBrightnessFromFloat64 struct{ ContainerBrightnessOutput }
+
+// ContainerBrightnessFromFloat64 returns a ContainerBrightnessFromFloat64Input for an input of the underlying float64 type, such as a pulumi.Float64
+// or a pulumi.Float64Output. The value is not checked against the enum's declared values.
+func ContainerBrightnessFromFloat64(v pulumi.Float64Input) ContainerBrightnessFromFloat64Input {
+	return containerBrightnessFromFloat64{v.ToFloat64Output().ApplyT(func(v float64) ContainerBrightness {
+		return ContainerBrightness(v)
+	}).(ContainerBrightnessOutput)}
+}
+
 // plant container colors
 type ContainerColor string
 
@@ -204,6 +401,74 @@ const (
 	ContainerColorYellow = ContainerColor("yellow")
 )
 
+// ContainerColorAll lists every declared value of ContainerColor, in declaration order.
+var ContainerColorAll = []ContainerColor{ContainerColorRed, ContainerColorBlue, ContainerColorYellow}
+
+// ContainerColorMissing returns the declared values of ContainerColor that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over ContainerColor handles to check
+// that the switch is exhaustive.
+func ContainerColorMissing(handled ...ContainerColor) []ContainerColor {
+	var missing []ContainerColor
+	for _, v := range ContainerColorAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// ContainerColorMeta describes the ContainerColor enum and its values.
+var ContainerColorMeta = pulumi.EnumMeta{
+	Name: "ContainerColor",
+	Type: "plant::ContainerColor",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "ContainerColorRed", Value: ContainerColorRed},
+		{Name: "ContainerColorBlue", Value: ContainerColorBlue},
+		{Name: "ContainerColorYellow", Value: ContainerColorYellow},
+	},
+}
+
+// containerColorValues maps the underlying values of ContainerColor to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var containerColorValues map[string]ContainerColor
+
+func init() {
+	containerColorValues = make(map[string]ContainerColor, 3)
+	for _, v := range []ContainerColor{ContainerColorRed, ContainerColorBlue, ContainerColorYellow} {
+		containerColorValues[string(v)] = v
+	}
+}
+
+// ParseContainerColor parses s as a ContainerColor. s must exactly match one of the enum's values.
+func ParseContainerColor(s string) (ContainerColor, error) {
+	if v, ok := containerColorValues[s]; ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid ContainerColor value %q", s)
+}
+
+// ParseContainerColorLoose parses s as a ContainerColor, ignoring surrounding whitespace and case.
+// s may match either one of the enum's values or one of its declared names.
+func ParseContainerColorLoose(s string) (ContainerColor, error) {
+	s = strings.TrimSpace(s)
+	if v, ok := containerColorValues[s]; ok {
+		return v, nil
+	}
+	for _, v := range []ContainerColor{ContainerColorRed, ContainerColorBlue, ContainerColorYellow} {
+		if strings.EqualFold(string(v), s) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ContainerColor value %q", s)
+}
+
 // plant container sizes
 type ContainerSize int
 
@@ -214,6 +479,40 @@ const (
 	ContainerSizeEightInch = ContainerSize(8)
 )
 
+// ContainerSizeAll lists every declared value of ContainerSize, in declaration order.
+var ContainerSizeAll = []ContainerSize{ContainerSizeFourInch, ContainerSizeSixInch, ContainerSizeEightInch}
+
+// ContainerSizeMissing returns the declared values of ContainerSize that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over ContainerSize handles to check
+// that the switch is exhaustive.
+func ContainerSizeMissing(handled ...ContainerSize) []ContainerSize {
+	var missing []ContainerSize
+	for _, v := range ContainerSizeAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// ContainerSizeMeta describes the ContainerSize enum and its values.
+var ContainerSizeMeta = pulumi.EnumMeta{
+	Name: "ContainerSize",
+	Type: "plant::ContainerSize",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "ContainerSizeFourInch", Value: ContainerSizeFourInch},
+		{Name: "ContainerSizeSixInch", Value: ContainerSizeSixInch},
+		{Name: "ContainerSizeEightInch", Value: ContainerSizeEightInch, Deprecated: "Eight inch pots are no longer supported."},
+	},
+}
+
 func (ContainerSize) ElementType() reflect.Type {
 	return reflect.TypeOf((*ContainerSize)(nil)).Elem()
 }
@@ -274,6 +573,11 @@ func (o ContainerSizeOutput) ToContainerSizePtrOutputWithContext(ctx context.Con
 	}).(ContainerSizePtrOutput)
 }
 
+// Ptr converts the output to a ContainerSizePtrOutput. It is shorthand for ToContainerSizePtrOutput.
+func (o ContainerSizeOutput) Ptr() ContainerSizePtrOutput {
+	return o.ToContainerSizePtrOutput()
+}
+
 func (o ContainerSizeOutput) ToIntOutput() pulumi.IntOutput {
 	return o.ToIntOutputWithContext(context.Background())
 }
@@ -359,6 +663,31 @@ func ContainerSizePtr(v int) ContainerSizePtrInput {
 	return (*containerSizePtr)(&v)
 }
 
+// ContainerSizePtrFromPtr returns a ContainerSizePtrInput for the value that v points to. A nil pointer returns a nil input.
+func ContainerSizePtrFromPtr(v *ContainerSize) ContainerSizePtrInput {
+	if v == nil {
+		return nil
+	}
+	return ContainerSizePtr(int(*v))
+}
+
+// ContainerSizeFourInchPtr returns a ContainerSizePtrInput for ContainerSizeFourInch.
+func ContainerSizeFourInchPtr() ContainerSizePtrInput {
+	return ContainerSizePtr(int(ContainerSizeFourInch))
+}
+
+// ContainerSizeSixInchPtr returns a ContainerSizePtrInput for ContainerSizeSixInch.
+func ContainerSizeSixInchPtr() ContainerSizePtrInput {
+	return ContainerSizePtr(int(ContainerSizeSixInch))
+}
+
+// ContainerSizeEightInchPtr returns a ContainerSizePtrInput for ContainerSizeEightInch.
+//
+// Deprecated: Eight inch pots are no longer supported.
+func ContainerSizeEightInchPtr() ContainerSizePtrInput {
+	return ContainerSizePtr(int(ContainerSizeEightInch))
+}
+
 func (*containerSizePtr) ElementType() reflect.Type {
 	return containerSizePtrType
 }
@@ -377,11 +706,34 @@ func (in *containerSizePtr) ToOutput(ctx context.Context) pulumix.Output[*Contai
 	}
 }
 
+// ContainerSizeFromIntInput is an input of ContainerSize that is built from an input of the underlying int type.
+// Construct one with ContainerSizeFromInt.
+type ContainerSizeFromIntInput interface {
+	pulumi.Input
+
+	ToContainerSizeOutput() ContainerSizeOutput
+	ToContainerSizeOutputWithContext(context.Context) ContainerSizeOutput
+	ToContainerSizePtrOutput() ContainerSizePtrOutput
+	ToContainerSizePtrOutputWithContext(context.Context) ContainerSizePtrOutput
+}
+
+type containerSizeFromInt struct{ ContainerSizeOutput }
+
+// ContainerSizeFromInt returns a ContainerSizeFromIntInput for an input of the underlying int type, such as a pulumi.Int
+// or a pulumi.IntOutput. The value is not checked against the enum's declared values.
+func ContainerSizeFromInt(v pulumi.IntInput) ContainerSizeFromIntInput {
+	return containerSizeFromInt{v.ToIntOutput().ApplyT(func(v int) ContainerSize {
+		return ContainerSize(v)
+	}).(ContainerSizeOutput)}
+}
+
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*ContainerBrightnessInput)(nil)).Elem(), ContainerBrightness(0.1))
 	pulumi.RegisterInputType(reflect.TypeOf((*ContainerBrightnessPtrInput)(nil)).Elem(), ContainerBrightness(0.1))
+	pulumi.RegisterInputType(reflect.TypeOf((*ContainerBrightnessFromFloat64Input)(nil)).Elem(), containerBrightnessFromFloat64{})
 	pulumi.RegisterInputType(reflect.TypeOf((*ContainerSizeInput)(nil)).Elem(), ContainerSize(4))
 	pulumi.RegisterInputType(reflect.TypeOf((*ContainerSizePtrInput)(nil)).Elem(), ContainerSize(4))
+	pulumi.RegisterInputType(reflect.TypeOf((*ContainerSizeFromIntInput)(nil)).Elem(), containerSizeFromInt{})
 	pulumi.RegisterOutputType(ContainerBrightnessOutput{})
 	pulumi.RegisterOutputType(ContainerBrightnessPtrOutput{})
 	pulumi.RegisterOutputType(ContainerSizeOutput{})
@@ -5,7 +5,11 @@ package v1
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
@@ -18,6 +22,77 @@ const (
 	DiameterTwelveinch = Diameter(12)
 )
 
+// DiameterDefault is the default value of properties of type Diameter.
+const DiameterDefault = DiameterSixinch
+
+// DiameterAll lists every declared value of Diameter, in declaration order.
+var DiameterAll = []Diameter{DiameterSixinch, DiameterTwelveinch}
+
+// DiameterMissing returns the declared values of Diameter that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over Diameter handles to check
+// that the switch is exhaustive.
+func DiameterMissing(handled ...Diameter) []Diameter {
+	var missing []Diameter
+	for _, v := range DiameterAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// DiameterMeta describes the Diameter enum and its values.
+var DiameterMeta = pulumi.EnumMeta{
+	Name: "Diameter",
+	Type: "other:tree/v1:Diameter",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "DiameterSixinch", Value: DiameterSixinch},
+		{Name: "DiameterTwelveinch", Value: DiameterTwelveinch},
+	},
+}
+
+// diameterValues maps the underlying values of Diameter to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var diameterValues map[float64]Diameter
+
+func init() {
+	diameterValues = make(map[float64]Diameter, 2)
+	for _, v := range []Diameter{DiameterSixinch, DiameterTwelveinch} {
+		diameterValues[float64(v)] = v
+	}
+}
+
+// IsValid reports whether e is one of the enum's values. NaN and infinite values are never valid.
+func (e Diameter) IsValid() bool {
+	if math.IsNaN(float64(e)) || math.IsInf(float64(e), 0) {
+		return false
+	}
+	_, ok := diameterValues[float64(e)]
+	return ok
+}
+
+// ParseDiameter parses s as a Diameter. s must be a finite number equal to one of its values.
+func ParseDiameter(s string) (Diameter, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid Diameter value %q: %w", s, err)
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("invalid Diameter value %q: must be a finite number", s)
+	}
+	if e := Diameter(f); e.IsValid() {
+		return e, nil
+	}
+	return 0, fmt.Errorf("invalid Diameter value %q", s)
+}
+
 func (Diameter) ElementType() reflect.Type {
 	return reflect.TypeOf((*Diameter)(nil)).Elem()
 }
@@ -78,6 +153,11 @@ func (o DiameterOutput) ToDiameterPtrOutputWithContext(ctx context.Context) Diam
 	}).(DiameterPtrOutput)
 }
 
+// Ptr converts the output to a DiameterPtrOutput. It is shorthand for ToDiameterPtrOutput.
+func (o DiameterOutput) Ptr() DiameterPtrOutput {
+	return o.ToDiameterPtrOutput()
+}
+
 func (o DiameterOutput) ToFloat64Output() pulumi.Float64Output {
 	return o.ToFloat64OutputWithContext(context.Background())
 }
@@ -163,6 +243,24 @@ func DiameterPtr(v float64) DiameterPtrInput {
 	return (*diameterPtr)(&v)
 }
 
+// DiameterPtrFromPtr returns a DiameterPtrInput for the value that v points to. A nil pointer returns a nil input.
+func DiameterPtrFromPtr(v *Diameter) DiameterPtrInput {
+	if v == nil {
+		return nil
+	}
+	return DiameterPtr(float64(*v))
+}
+
+// DiameterSixinchPtr returns a DiameterPtrInput for DiameterSixinch.
+func DiameterSixinchPtr() DiameterPtrInput {
+	return DiameterPtr(float64(DiameterSixinch))
+}
+
+// DiameterTwelveinchPtr returns a DiameterPtrInput for DiameterTwelveinch.
+func DiameterTwelveinchPtr() DiameterPtrInput {
+	return DiameterPtr(float64(DiameterTwelveinch))
+}
+
 func (*diameterPtr) ElementType() reflect.Type {
 	return diameterPtrType
 }
@@ -181,6 +279,27 @@ func (in *diameterPtr) ToOutput(ctx context.Context) pulumix.Output[*Diameter] {
 	}
 }
 
+// DiameterFromFloat64Input is an input of Diameter that is built from an input of the underlying float64 type.
+// Construct one with DiameterFromFloat64.
+type DiameterFromFloat64Input interface {
+	pulumi.Input
+
+	ToDiameterOutput() DiameterOutput
+	ToDiameterOutputWithContext(context.Context) DiameterOutput
+	ToDiameterPtrOutput() DiameterPtrOutput
+	ToDiameterPtrOutputWithContext(context.Context) DiameterPtrOutput
+}
+
+type diameterFromFloat64 struct{ DiameterOutput }
+
+// DiameterFromFloat64 returns a DiameterFromFloat64Input for an input of the underlying float64 type, such as a pulumi.Float64
+// or a pulumi.Float64Output. The value is not checked against the enum's declared values.
+func DiameterFromFloat64(v pulumi.Float64Input) DiameterFromFloat64Input {
+	return diameterFromFloat64{v.ToFloat64Output().ApplyT(func(v float64) Diameter {
+		return Diameter(v)
+	}).(DiameterOutput)}
+}
+
 type Farm string
 
 const (
@@ -188,6 +307,73 @@ const (
 	Farm_Plants_R_Us          = Farm("Plants'R'Us")
 )
 
+// FarmAll lists every declared value of Farm, in declaration order.
+var FarmAll = []Farm{Farm_Pulumi_Planters_Inc_, Farm_Plants_R_Us}
+
+// FarmMissing returns the declared values of Farm that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over Farm handles to check
+// that the switch is exhaustive.
+func FarmMissing(handled ...Farm) []Farm {
+	var missing []Farm
+	for _, v := range FarmAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// FarmMeta describes the Farm enum and its values.
+var FarmMeta = pulumi.EnumMeta{
+	Name: "Farm",
+	Type: "plant:tree/v1:Farm",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "Farm_Pulumi_Planters_Inc_", Value: Farm_Pulumi_Planters_Inc_},
+		{Name: "Farm_Plants_R_Us", Value: Farm_Plants_R_Us},
+	},
+}
+
+// farmValues maps the underlying values of Farm to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var farmValues map[string]Farm
+
+func init() {
+	farmValues = make(map[string]Farm, 2)
+	for _, v := range []Farm{Farm_Pulumi_Planters_Inc_, Farm_Plants_R_Us} {
+		farmValues[string(v)] = v
+	}
+}
+
+// ParseFarm parses s as a Farm. s must exactly match one of the enum's values.
+func ParseFarm(s string) (Farm, error) {
+	if v, ok := farmValues[s]; ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid Farm value %q", s)
+}
+
+// ParseFarmLoose parses s as a Farm, ignoring surrounding whitespace and case.
+// s may match either one of the enum's values or one of its declared names.
+func ParseFarmLoose(s string) (Farm, error) {
+	s = strings.TrimSpace(s)
+	if v, ok := farmValues[s]; ok {
+		return v, nil
+	}
+	for _, v := range []Farm{Farm_Pulumi_Planters_Inc_, Farm_Plants_R_Us} {
+		if strings.EqualFold(string(v), s) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid Farm value %q", s)
+}
+
 // types of rubber trees
 type RubberTreeVariety string
 
@@ -200,6 +386,77 @@ const (
 	RubberTreeVarietyTineke = RubberTreeVariety("Tineke")
 )
 
+// RubberTreeVarietyDefault is the default value of properties of type RubberTreeVariety.
+const RubberTreeVarietyDefault = RubberTreeVarietyBurgundy
+
+// RubberTreeVarietyAll lists every declared value of RubberTreeVariety, in declaration order.
+var RubberTreeVarietyAll = []RubberTreeVariety{RubberTreeVarietyBurgundy, RubberTreeVarietyRuby, RubberTreeVarietyTineke}
+
+// RubberTreeVarietyMissing returns the declared values of RubberTreeVariety that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over RubberTreeVariety handles to check
+// that the switch is exhaustive.
+func RubberTreeVarietyMissing(handled ...RubberTreeVariety) []RubberTreeVariety {
+	var missing []RubberTreeVariety
+	for _, v := range RubberTreeVarietyAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// RubberTreeVarietyMeta describes the RubberTreeVariety enum and its values.
+var RubberTreeVarietyMeta = pulumi.EnumMeta{
+	Name: "RubberTreeVariety",
+	Type: "plant:tree/v1:RubberTreeVariety",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "RubberTreeVarietyBurgundy", Value: RubberTreeVarietyBurgundy},
+		{Name: "RubberTreeVarietyRuby", Value: RubberTreeVarietyRuby},
+		{Name: "RubberTreeVarietyTineke", Value: RubberTreeVarietyTineke},
+	},
+}
+
+// rubberTreeVarietyValues maps the underlying values of RubberTreeVariety to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var rubberTreeVarietyValues map[string]RubberTreeVariety
+
+func init() {
+	rubberTreeVarietyValues = make(map[string]RubberTreeVariety, 3)
+	for _, v := range []RubberTreeVariety{RubberTreeVarietyBurgundy, RubberTreeVarietyRuby, RubberTreeVarietyTineke} {
+		rubberTreeVarietyValues[string(v)] = v
+	}
+}
+
+// ParseRubberTreeVariety parses s as a RubberTreeVariety. s must exactly match one of the enum's values.
+func ParseRubberTreeVariety(s string) (RubberTreeVariety, error) {
+	if v, ok := rubberTreeVarietyValues[s]; ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid RubberTreeVariety value %q", s)
+}
+
+// ParseRubberTreeVarietyLoose parses s as a RubberTreeVariety, ignoring surrounding whitespace and case.
+// s may match either one of the enum's values or one of its declared names.
+func ParseRubberTreeVarietyLoose(s string) (RubberTreeVariety, error) {
+	s = strings.TrimSpace(s)
+	if v, ok := rubberTreeVarietyValues[s]; ok {
+		return v, nil
+	}
+	for _, v := range []RubberTreeVariety{RubberTreeVarietyBurgundy, RubberTreeVarietyRuby, RubberTreeVarietyTineke} {
+		if strings.EqualFold(string(v), s) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid RubberTreeVariety value %q", s)
+}
+
 func (RubberTreeVariety) ElementType() reflect.Type {
 	return reflect.TypeOf((*RubberTreeVariety)(nil)).Elem()
 }
@@ -260,6 +517,11 @@ func (o RubberTreeVarietyOutput) ToRubberTreeVarietyPtrOutputWithContext(ctx con
 	}).(RubberTreeVarietyPtrOutput)
 }
 
+// Ptr converts the output to a RubberTreeVarietyPtrOutput. It is shorthand for ToRubberTreeVarietyPtrOutput.
+func (o RubberTreeVarietyOutput) Ptr() RubberTreeVarietyPtrOutput {
+	return o.ToRubberTreeVarietyPtrOutput()
+}
+
 func (o RubberTreeVarietyOutput) ToStringOutput() pulumi.StringOutput {
 	return o.ToStringOutputWithContext(context.Background())
 }
@@ -345,6 +607,14 @@ func RubberTreeVarietyPtr(v string) RubberTreeVarietyPtrInput {
 	return (*rubberTreeVarietyPtr)(&v)
 }
 
+// RubberTreeVarietyPtrFromPtr returns a RubberTreeVarietyPtrInput for the value that v points to. A nil pointer returns a nil input.
+func RubberTreeVarietyPtrFromPtr(v *RubberTreeVariety) RubberTreeVarietyPtrInput {
+	if v == nil {
+		return nil
+	}
+	return RubberTreeVarietyPtr(string(*v))
+}
+
 func (*rubberTreeVarietyPtr) ElementType() reflect.Type {
 	return rubberTreeVarietyPtrType
 }
@@ -363,6 +633,45 @@ func (in *rubberTreeVarietyPtr) ToOutput(ctx context.Context) pulumix.Output[*Ru
 	}
 }
 
+// RubberTreeVarietyFromStringInput is an input of RubberTreeVariety that is built from an input of the underlying string type.
+// Construct one with RubberTreeVarietyFromString.
+type RubberTreeVarietyFromStringInput interface {
+	pulumi.Input
+
+	ToRubberTreeVarietyOutput() RubberTreeVarietyOutput
+	ToRubberTreeVarietyOutputWithContext(context.Context) RubberTreeVarietyOutput
+	ToRubberTreeVarietyPtrOutput() RubberTreeVarietyPtrOutput
+	ToRubberTreeVarietyPtrOutputWithContext(context.Context) RubberTreeVarietyPtrOutput
+}
+
+type rubberTreeVarietyFromString struct{ RubberTreeVarietyOutput }
+
+// RubberTreeVarietyFromString returns a RubberTreeVarietyFromStringInput for an input of the underlying string type, such as a pulumi.String
+// or a pulumi.StringOutput. The value is not checked against the enum's declared values.
+func RubberTreeVarietyFromString(v pulumi.StringInput) RubberTreeVarietyFromStringInput {
+	return rubberTreeVarietyFromString{v.ToStringOutput().ApplyT(func(v string) RubberTreeVariety {
+		return RubberTreeVariety(v)
+	}).(RubberTreeVarietyOutput)}
+}
+
+// RubberTreeVarietySlice returns vals as a slice of RubberTreeVariety.
+// Every value must be one of the enum's declared values; if any are not, the returned error
+// lists all of them.
+func RubberTreeVarietySlice(vals ...string) ([]RubberTreeVariety, error) {
+	result := make([]RubberTreeVariety, len(vals))
+	var invalid []string
+	for i, v := range vals {
+		result[i] = RubberTreeVariety(v)
+		if _, ok := rubberTreeVarietyValues[v]; !ok {
+			invalid = append(invalid, fmt.Sprintf("%q at index %d", v, i))
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid RubberTreeVariety values: %s", strings.Join(invalid, ", "))
+	}
+	return result, nil
+}
+
 // RubberTreeVarietyArrayInput is an input type that accepts RubberTreeVarietyArray and RubberTreeVarietyArrayOutput values.
 // You can construct a concrete instance of `RubberTreeVarietyArrayInput` via:
 //
@@ -416,6 +725,77 @@ const (
 	TreeSizeLarge  = TreeSize("large")
 )
 
+// TreeSizeDefault is the default value of properties of type TreeSize.
+const TreeSizeDefault = TreeSizeMedium
+
+// TreeSizeAll lists every declared value of TreeSize, in declaration order.
+var TreeSizeAll = []TreeSize{TreeSizeSmall, TreeSizeMedium, TreeSizeLarge}
+
+// TreeSizeMissing returns the declared values of TreeSize that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over TreeSize handles to check
+// that the switch is exhaustive.
+func TreeSizeMissing(handled ...TreeSize) []TreeSize {
+	var missing []TreeSize
+	for _, v := range TreeSizeAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// TreeSizeMeta describes the TreeSize enum and its values.
+var TreeSizeMeta = pulumi.EnumMeta{
+	Name: "TreeSize",
+	Type: "plant:tree/v1:TreeSize",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "TreeSizeSmall", Value: TreeSizeSmall},
+		{Name: "TreeSizeMedium", Value: TreeSizeMedium},
+		{Name: "TreeSizeLarge", Value: TreeSizeLarge},
+	},
+}
+
+// treeSizeValues maps the underlying values of TreeSize to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var treeSizeValues map[string]TreeSize
+
+func init() {
+	treeSizeValues = make(map[string]TreeSize, 3)
+	for _, v := range []TreeSize{TreeSizeSmall, TreeSizeMedium, TreeSizeLarge} {
+		treeSizeValues[string(v)] = v
+	}
+}
+
+// ParseTreeSize parses s as a TreeSize. s must exactly match one of the enum's values.
+func ParseTreeSize(s string) (TreeSize, error) {
+	if v, ok := treeSizeValues[s]; ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid TreeSize value %q", s)
+}
+
+// ParseTreeSizeLoose parses s as a TreeSize, ignoring surrounding whitespace and case.
+// s may match either one of the enum's values or one of its declared names.
+func ParseTreeSizeLoose(s string) (TreeSize, error) {
+	s = strings.TrimSpace(s)
+	if v, ok := treeSizeValues[s]; ok {
+		return v, nil
+	}
+	for _, v := range []TreeSize{TreeSizeSmall, TreeSizeMedium, TreeSizeLarge} {
+		if strings.EqualFold(string(v), s) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid TreeSize value %q", s)
+}
+
 func (TreeSize) ElementType() reflect.Type {
 	return reflect.TypeOf((*TreeSize)(nil)).Elem()
 }
@@ -476,6 +856,11 @@ func (o TreeSizeOutput) ToTreeSizePtrOutputWithContext(ctx context.Context) Tree
 	}).(TreeSizePtrOutput)
 }
 
+// Ptr converts the output to a TreeSizePtrOutput. It is shorthand for ToTreeSizePtrOutput.
+func (o TreeSizeOutput) Ptr() TreeSizePtrOutput {
+	return o.ToTreeSizePtrOutput()
+}
+
 func (o TreeSizeOutput) ToStringOutput() pulumi.StringOutput {
 	return o.ToStringOutputWithContext(context.Background())
 }
@@ -561,6 +946,14 @@ func TreeSizePtr(v string) TreeSizePtrInput {
 	return (*treeSizePtr)(&v)
 }
 
+// TreeSizePtrFromPtr returns a TreeSizePtrInput for the value that v points to. A nil pointer returns a nil input.
+func TreeSizePtrFromPtr(v *TreeSize) TreeSizePtrInput {
+	if v == nil {
+		return nil
+	}
+	return TreeSizePtr(string(*v))
+}
+
 func (*treeSizePtr) ElementType() reflect.Type {
 	return treeSizePtrType
 }
@@ -579,6 +972,27 @@ func (in *treeSizePtr) ToOutput(ctx context.Context) pulumix.Output[*TreeSize] {
 	}
 }
 
+// TreeSizeFromStringInput is an input of TreeSize that is built from an input of the underlying string type.
+// Construct one with TreeSizeFromString.
+type TreeSizeFromStringInput interface {
+	pulumi.Input
+
+	ToTreeSizeOutput() TreeSizeOutput
+	ToTreeSizeOutputWithContext(context.Context) TreeSizeOutput
+	ToTreeSizePtrOutput() TreeSizePtrOutput
+	ToTreeSizePtrOutputWithContext(context.Context) TreeSizePtrOutput
+}
+
+type treeSizeFromString struct{ TreeSizeOutput }
+
+// TreeSizeFromString returns a TreeSizeFromStringInput for an input of the underlying string type, such as a pulumi.String
+// or a pulumi.StringOutput. The value is not checked against the enum's declared values.
+func TreeSizeFromString(v pulumi.StringInput) TreeSizeFromStringInput {
+	return treeSizeFromString{v.ToStringOutput().ApplyT(func(v string) TreeSize {
+		return TreeSize(v)
+	}).(TreeSizeOutput)}
+}
+
 // TreeSizeMapInput is an input type that accepts TreeSizeMap and TreeSizeMapOutput values.
 // You can construct a concrete instance of `TreeSizeMapInput` via:
 //
@@ -627,11 +1041,14 @@ func (o TreeSizeMapOutput) MapIndex(k pulumi.StringInput) TreeSizeOutput {
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*DiameterInput)(nil)).Elem(), Diameter(6))
 	pulumi.RegisterInputType(reflect.TypeOf((*DiameterPtrInput)(nil)).Elem(), Diameter(6))
+	pulumi.RegisterInputType(reflect.TypeOf((*DiameterFromFloat64Input)(nil)).Elem(), diameterFromFloat64{})
 	pulumi.RegisterInputType(reflect.TypeOf((*RubberTreeVarietyInput)(nil)).Elem(), RubberTreeVariety("Burgundy"))
 	pulumi.RegisterInputType(reflect.TypeOf((*RubberTreeVarietyPtrInput)(nil)).Elem(), RubberTreeVariety("Burgundy"))
+	pulumi.RegisterInputType(reflect.TypeOf((*RubberTreeVarietyFromStringInput)(nil)).Elem(), rubberTreeVarietyFromString{})
 	pulumi.RegisterInputType(reflect.TypeOf((*RubberTreeVarietyArrayInput)(nil)).Elem(), RubberTreeVarietyArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*TreeSizeInput)(nil)).Elem(), TreeSize("small"))
 	pulumi.RegisterInputType(reflect.TypeOf((*TreeSizePtrInput)(nil)).Elem(), TreeSize("small"))
+	pulumi.RegisterInputType(reflect.TypeOf((*TreeSizeFromStringInput)(nil)).Elem(), treeSizeFromString{})
 	pulumi.RegisterInputType(reflect.TypeOf((*TreeSizeMapInput)(nil)).Elem(), TreeSizeMap{})
 	pulumi.RegisterOutputType(DiameterOutput{})
 	pulumi.RegisterOutputType(DiameterPtrOutput{})
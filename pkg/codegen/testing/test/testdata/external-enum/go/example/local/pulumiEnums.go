@@ -5,7 +5,10 @@ package local
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"reflect"
+	"strconv"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
@@ -14,10 +17,79 @@ import (
 type MyEnum float64
 
 const (
-	MyEnumPi    = MyEnum(3.1415)
+	MyEnumPi = MyEnum(3.1415)
+	// Deprecated: Use pi instead.
 	MyEnumSmall = MyEnum(1e-07)
 )
 
+// MyEnumAll lists every declared value of MyEnum, in declaration order.
+var MyEnumAll = []MyEnum{MyEnumPi, MyEnumSmall}
+
+// MyEnumMissing returns the declared values of MyEnum that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over MyEnum handles to check
+// that the switch is exhaustive.
+func MyEnumMissing(handled ...MyEnum) []MyEnum {
+	var missing []MyEnum
+	for _, v := range MyEnumAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// MyEnumMeta describes the MyEnum enum and its values.
+var MyEnumMeta = pulumi.EnumMeta{
+	Name: "MyEnum",
+	Type: "example:local:MyEnum",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "MyEnumPi", Value: MyEnumPi},
+		{Name: "MyEnumSmall", Value: MyEnumSmall, Deprecated: "Use pi instead."},
+	},
+}
+
+// myEnumValues maps the underlying values of MyEnum to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var myEnumValues map[float64]MyEnum
+
+func init() {
+	myEnumValues = make(map[float64]MyEnum, 2)
+	for _, v := range []MyEnum{MyEnumPi, MyEnumSmall} {
+		myEnumValues[float64(v)] = v
+	}
+}
+
+// IsValid reports whether e is one of the enum's values. NaN and infinite values are never valid.
+func (e MyEnum) IsValid() bool {
+	if math.IsNaN(float64(e)) || math.IsInf(float64(e), 0) {
+		return false
+	}
+	_, ok := myEnumValues[float64(e)]
+	return ok
+}
+
+// ParseMyEnum parses s as a MyEnum. s must be a finite number equal to one of its values.
+func ParseMyEnum(s string) (MyEnum, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid MyEnum value %q: %w", s, err)
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("invalid MyEnum value %q: must be a finite number", s)
+	}
+	if e := MyEnum(f); e.IsValid() {
+		return e, nil
+	}
+	return 0, fmt.Errorf("invalid MyEnum value %q", s)
+}
+
 func (MyEnum) ElementType() reflect.Type {
 	return reflect.TypeOf((*MyEnum)(nil)).Elem()
 }
@@ -78,6 +150,11 @@ func (o MyEnumOutput) ToMyEnumPtrOutputWithContext(ctx context.Context) MyEnumPt
 	}).(MyEnumPtrOutput)
 }
 
+// Ptr converts the output to a MyEnumPtrOutput. It is shorthand for ToMyEnumPtrOutput.
+func (o MyEnumOutput) Ptr() MyEnumPtrOutput {
+	return o.ToMyEnumPtrOutput()
+}
+
 func (o MyEnumOutput) ToFloat64Output() pulumi.Float64Output {
 	return o.ToFloat64OutputWithContext(context.Background())
 }
@@ -163,6 +240,26 @@ func MyEnumPtr(v float64) MyEnumPtrInput {
 	return (*myEnumPtr)(&v)
 }
 
+// MyEnumPtrFromPtr returns a MyEnumPtrInput for the value that v points to. A nil pointer returns a nil input.
+func MyEnumPtrFromPtr(v *MyEnum) MyEnumPtrInput {
+	if v == nil {
+		return nil
+	}
+	return MyEnumPtr(float64(*v))
+}
+
+// MyEnumPiPtr returns a MyEnumPtrInput for MyEnumPi.
+func MyEnumPiPtr() MyEnumPtrInput {
+	return MyEnumPtr(float64(MyEnumPi))
+}
+
+// MyEnumSmallPtr returns a MyEnumPtrInput for MyEnumSmall.
+//
+// Deprecated: Use pi instead.
+func MyEnumSmallPtr() MyEnumPtrInput {
+	return MyEnumPtr(float64(MyEnumSmall))
+}
+
 func (*myEnumPtr) ElementType() reflect.Type {
 	return myEnumPtrType
 }
@@ -181,9 +278,31 @@ func (in *myEnumPtr) ToOutput(ctx context.Context) pulumix.Output[*MyEnum] {
 	}
 }
 
+// MyEnumFromFloat64Input is an input of MyEnum that is built from an input of the underlying float64 type.
+// Construct one with MyEnumFromFloat64.
+type MyEnumFromFloat64Input interface {
+	pulumi.Input
+
+	ToMyEnumOutput() MyEnumOutput
+	ToMyEnumOutputWithContext(context.Context) MyEnumOutput
+	ToMyEnumPtrOutput() MyEnumPtrOutput
+	ToMyEnumPtrOutputWithContext(context.Context) MyEnumPtrOutput
+}
+
+type myEnumFromFloat64 struct{ MyEnumOutput }
+
+// MyEnumFromFloat64 returns a MyEnumFromFloat64Input for an input of the underlying float64 type, such as a pulumi.Float64
+// or a pulumi.Float64Output. The value is not checked against the enum's declared values.
+func MyEnumFromFloat64(v pulumi.Float64Input) MyEnumFromFloat64Input {
+	return myEnumFromFloat64{v.ToFloat64Output().ApplyT(func(v float64) MyEnum {
+		return MyEnum(v)
+	}).(MyEnumOutput)}
+}
+
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*MyEnumInput)(nil)).Elem(), MyEnum(3.1415))
 	pulumi.RegisterInputType(reflect.TypeOf((*MyEnumPtrInput)(nil)).Elem(), MyEnum(3.1415))
+	pulumi.RegisterInputType(reflect.TypeOf((*MyEnumFromFloat64Input)(nil)).Elem(), myEnumFromFloat64{})
 	pulumi.RegisterOutputType(MyEnumOutput{})
 	pulumi.RegisterOutputType(MyEnumPtrOutput{})
 }
@@ -4,6 +4,9 @@
 
 export const MyEnum = {
     Pi: 3.1415,
+    /**
+     * @deprecated Use pi instead.
+     */
     Small: 1e-07,
 } as const;
 
@@ -0,0 +1,43 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go-enum-constraint/garden"
+)
+
+// describe is a generic helper that accepts any enum in the garden package.
+func describe[E garden.Enum](values ...E) []string {
+	var out []string
+	for _, v := range values {
+		out = append(out, fmt.Sprintf("%T(%v)", v, v))
+	}
+	return out
+}
+
+func TestEnumConstraint(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"garden.Soil(clay)", "garden.Soil(sand)"},
+		describe(garden.SoilClay, garden.SoilSand))
+	assert.Equal(t, []string{"garden.RowCount(2)"}, describe(garden.RowCountTwo))
+	assert.Equal(t, []string{"garden.Depth(0.5)", "garden.Depth(1.5)"},
+		describe(garden.DepthShallow, garden.DepthDeep))
+}
@@ -0,0 +1,2 @@
+// Package garden exports types, functions, subpackages for provisioning garden resources.
+package garden
@@ -0,0 +1,2 @@
+// Package defaults exports types, functions, subpackages for provisioning defaults resources.
+package defaults
@@ -0,0 +1,2 @@
+// Package descriptions exports types, functions, subpackages for provisioning descriptions resources.
+package descriptions
@@ -0,0 +1,2 @@
+// Package fuzz exports types, functions, subpackages for provisioning fuzz resources.
+package fuzz
@@ -0,0 +1,83 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-enum-large/large"
+)
+
+func TestLargeEnumLookup(t *testing.T) {
+	t.Parallel()
+
+	require.Len(t, large.RegionAll, 300)
+	for _, v := range large.RegionAll {
+		parsed, err := large.ParseRegion(string(v))
+		require.NoError(t, err)
+		assert.Equal(t, v, parsed)
+	}
+	_, err := large.ParseRegion("region-300")
+	assert.EqualError(t, err, `invalid Region value "region-300"`)
+
+	require.Len(t, large.WeightAll, 300)
+	for _, v := range large.WeightAll {
+		assert.True(t, v.IsValid(), v)
+		parsed, err := large.ParseWeight(fmt.Sprint(float64(v)))
+		require.NoError(t, err)
+		assert.Equal(t, v, parsed)
+	}
+	assert.False(t, large.Weight(300.5).IsValid())
+}
+
+// The benchmarks look up the first, the last, and an undeclared value of each enum. Declared values are found in the
+// same time regardless of their position, where scanning the enum's values would take time proportional to it.
+
+func BenchmarkParseRegion(b *testing.B) {
+	for _, s := range []string{"region-0", "region-299", "region-300"} {
+		s := s
+		b.Run(s, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				_, _ = large.ParseRegion(s)
+			}
+		})
+	}
+}
+
+func BenchmarkWeightIsValid(b *testing.B) {
+	for _, v := range []large.Weight{large.WeightValue0, large.WeightValue299, 300.5} {
+		v := v
+		b.Run(fmt.Sprint(float64(v)), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				_ = v.IsValid()
+			}
+		})
+	}
+}
+
+func BenchmarkParseWeight(b *testing.B) {
+	for _, s := range []string{"0.5", "299.5", "300.5"} {
+		s := s
+		b.Run(s, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				_, _ = large.ParseWeight(s)
+			}
+		})
+	}
+}
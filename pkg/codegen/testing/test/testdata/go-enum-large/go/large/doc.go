@@ -0,0 +1,2 @@
+// Package large exports types, functions, subpackages for provisioning large resources.
+package large
@@ -0,0 +1,74 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+
+	"go-enum-meta/meta"
+)
+
+func TestEnumMeta(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, pulumi.EnumMeta{
+		Name: "MyEnum",
+		Type: "meta::MyEnum",
+		Values: []pulumi.EnumValueMeta{
+			{Name: "MyEnumSmall", Value: meta.MyEnumSmall},
+			{Name: "MyEnumLarge", Value: meta.MyEnumLarge},
+			{Name: "MyEnumHuge", Value: meta.MyEnumHuge, Deprecated: "Huge widgets are no longer supported."},
+		},
+	}, meta.MyEnumMeta)
+}
+
+func TestEnumToAnyOutput(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, pulumi.RunErr(func(ctx *pulumi.Context) error {
+		// These assignments check the exact result types of the generated methods.
+		var value pulumi.AnyOutput = meta.MyEnumLarge.ToMyEnumOutput().ToAnyOutput()
+		var ptr pulumi.AnyOutput = meta.MyEnumSmall.ToMyEnumPtrOutput().ToAnyOutputWithContext(ctx.Context())
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		pulumi.All(value, ptr).ApplyT(func(all []interface{}) error {
+			defer wg.Done()
+			assert.Equal(t, meta.MyEnumLarge, all[0])
+			small := meta.MyEnumSmall
+			assert.Equal(t, &small, all[1])
+			return nil
+		})
+		wg.Wait()
+		return nil
+	}, pulumi.WithMocks("project", "stack", mocks(0))))
+}
+
+type mocks int
+
+func (mocks) NewResource(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {
+	return args.Name + "_id", args.Inputs, nil
+}
+
+func (mocks) Call(args pulumi.MockCallArgs) (resource.PropertyMap, error) {
+	return args.Args, nil
+}
@@ -0,0 +1,2 @@
+// Package meta exports types, functions, subpackages for provisioning meta resources.
+package meta
@@ -0,0 +1,41 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-enum-precise-literals/precise"
+)
+
+func TestPreciseEnumLiterals(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, precise.Scale(1e-07), precise.ScaleMicro)
+	assert.Equal(t, precise.Scale(1.0/3), precise.ScaleThird)
+
+	for _, v := range precise.ScaleMeta.Values {
+		require.NotEmpty(t, v.Literal, v.Name)
+		parsed, err := strconv.ParseFloat(v.Literal, 64)
+		require.NoError(t, err)
+		assert.Equal(t, v.Value, precise.Scale(parsed), v.Name)
+	}
+	assert.Equal(t, "1e-07", precise.ScaleMeta.Values[0].Literal)
+	assert.Equal(t, "0.3333333333333333", precise.ScaleMeta.Values[1].Literal)
+}
@@ -0,0 +1,2 @@
+// Package precise exports types, functions, subpackages for provisioning precise resources.
+package precise
@@ -0,0 +1,2 @@
+// Package zero exports types, functions, subpackages for provisioning zero resources.
+package zero
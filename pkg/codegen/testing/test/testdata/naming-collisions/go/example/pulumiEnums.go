@@ -5,7 +5,9 @@ package example
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
@@ -18,6 +20,73 @@ const (
 	ExampleEnumTwo = ExampleEnum("two")
 )
 
+// ExampleEnumAll lists every declared value of ExampleEnum, in declaration order.
+var ExampleEnumAll = []ExampleEnum{ExampleEnumOne, ExampleEnumTwo}
+
+// ExampleEnumMissing returns the declared values of ExampleEnum that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over ExampleEnum handles to check
+// that the switch is exhaustive.
+func ExampleEnumMissing(handled ...ExampleEnum) []ExampleEnum {
+	var missing []ExampleEnum
+	for _, v := range ExampleEnumAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// ExampleEnumMeta describes the ExampleEnum enum and its values.
+var ExampleEnumMeta = pulumi.EnumMeta{
+	Name: "ExampleEnum",
+	Type: "example::ExampleEnum",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "ExampleEnumOne", Value: ExampleEnumOne},
+		{Name: "ExampleEnumTwo", Value: ExampleEnumTwo},
+	},
+}
+
+// exampleEnumValues maps the underlying values of ExampleEnum to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var exampleEnumValues map[string]ExampleEnum
+
+func init() {
+	exampleEnumValues = make(map[string]ExampleEnum, 2)
+	for _, v := range []ExampleEnum{ExampleEnumOne, ExampleEnumTwo} {
+		exampleEnumValues[string(v)] = v
+	}
+}
+
+// ParseExampleEnum parses s as a ExampleEnum. s must exactly match one of the enum's values.
+func ParseExampleEnum(s string) (ExampleEnum, error) {
+	if v, ok := exampleEnumValues[s]; ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid ExampleEnum value %q", s)
+}
+
+// ParseExampleEnumLoose parses s as a ExampleEnum, ignoring surrounding whitespace and case.
+// s may match either one of the enum's values or one of its declared names.
+func ParseExampleEnumLoose(s string) (ExampleEnum, error) {
+	s = strings.TrimSpace(s)
+	if v, ok := exampleEnumValues[s]; ok {
+		return v, nil
+	}
+	for _, v := range []ExampleEnum{ExampleEnumOne, ExampleEnumTwo} {
+		if strings.EqualFold(string(v), s) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ExampleEnum value %q", s)
+}
+
 func (ExampleEnum) ElementType() reflect.Type {
 	return reflect.TypeOf((*ExampleEnum)(nil)).Elem()
 }
@@ -78,6 +147,11 @@ func (o ExampleEnumOutput) ToExampleEnumPtrOutputWithContext(ctx context.Context
 	}).(ExampleEnumPtrOutput)
 }
 
+// Ptr converts the output to a ExampleEnumPtrOutput. It is shorthand for ToExampleEnumPtrOutput.
+func (o ExampleEnumOutput) Ptr() ExampleEnumPtrOutput {
+	return o.ToExampleEnumPtrOutput()
+}
+
 func (o ExampleEnumOutput) ToStringOutput() pulumi.StringOutput {
 	return o.ToStringOutputWithContext(context.Background())
 }
@@ -163,6 +237,14 @@ func ExampleEnumPtr(v string) ExampleEnumPtrInput {
 	return (*exampleEnumPtr)(&v)
 }
 
+// ExampleEnumPtrFromPtr returns a ExampleEnumPtrInput for the value that v points to. A nil pointer returns a nil input.
+func ExampleEnumPtrFromPtr(v *ExampleEnum) ExampleEnumPtrInput {
+	if v == nil {
+		return nil
+	}
+	return ExampleEnumPtr(string(*v))
+}
+
 func (*exampleEnumPtr) ElementType() reflect.Type {
 	return exampleEnumPtrType
 }
@@ -181,6 +263,27 @@ func (in *exampleEnumPtr) ToOutput(ctx context.Context) pulumix.Output[*ExampleE
 	}
 }
 
+// ExampleEnumFromStringInput is an input of ExampleEnum that is built from an input of the underlying string type.
+// Construct one with ExampleEnumFromString.
+type ExampleEnumFromStringInput interface {
+	pulumi.Input
+
+	ToExampleEnumOutput() ExampleEnumOutput
+	ToExampleEnumOutputWithContext(context.Context) ExampleEnumOutput
+	ToExampleEnumPtrOutput() ExampleEnumPtrOutput
+	ToExampleEnumPtrOutputWithContext(context.Context) ExampleEnumPtrOutput
+}
+
+type exampleEnumFromString struct{ ExampleEnumOutput }
+
+// ExampleEnumFromString returns a ExampleEnumFromStringInput for an input of the underlying string type, such as a pulumi.String
+// or a pulumi.StringOutput. The value is not checked against the enum's declared values.
+func ExampleEnumFromString(v pulumi.StringInput) ExampleEnumFromStringInput {
+	return exampleEnumFromString{v.ToStringOutput().ApplyT(func(v string) ExampleEnum {
+		return ExampleEnum(v)
+	}).(ExampleEnumOutput)}
+}
+
 type ExampleEnumInputEnum string
 
 const (
@@ -188,6 +291,73 @@ const (
 	ExampleEnumInputEnumTwo = ExampleEnumInputEnum("two")
 )
 
+// ExampleEnumInputEnumAll lists every declared value of ExampleEnumInputEnum, in declaration order.
+var ExampleEnumInputEnumAll = []ExampleEnumInputEnum{ExampleEnumInputEnumOne, ExampleEnumInputEnumTwo}
+
+// ExampleEnumInputEnumMissing returns the declared values of ExampleEnumInputEnum that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over ExampleEnumInputEnum handles to check
+// that the switch is exhaustive.
+func ExampleEnumInputEnumMissing(handled ...ExampleEnumInputEnum) []ExampleEnumInputEnum {
+	var missing []ExampleEnumInputEnum
+	for _, v := range ExampleEnumInputEnumAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// ExampleEnumInputEnumMeta describes the ExampleEnumInputEnum enum and its values.
+var ExampleEnumInputEnumMeta = pulumi.EnumMeta{
+	Name: "ExampleEnumInputEnum",
+	Type: "example::ExampleEnumInput",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "ExampleEnumInputEnumOne", Value: ExampleEnumInputEnumOne},
+		{Name: "ExampleEnumInputEnumTwo", Value: ExampleEnumInputEnumTwo},
+	},
+}
+
+// exampleEnumInputEnumValues maps the underlying values of ExampleEnumInputEnum to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var exampleEnumInputEnumValues map[string]ExampleEnumInputEnum
+
+func init() {
+	exampleEnumInputEnumValues = make(map[string]ExampleEnumInputEnum, 2)
+	for _, v := range []ExampleEnumInputEnum{ExampleEnumInputEnumOne, ExampleEnumInputEnumTwo} {
+		exampleEnumInputEnumValues[string(v)] = v
+	}
+}
+
+// ParseExampleEnumInputEnum parses s as a ExampleEnumInputEnum. s must exactly match one of the enum's values.
+func ParseExampleEnumInputEnum(s string) (ExampleEnumInputEnum, error) {
+	if v, ok := exampleEnumInputEnumValues[s]; ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid ExampleEnumInputEnum value %q", s)
+}
+
+// ParseExampleEnumInputEnumLoose parses s as a ExampleEnumInputEnum, ignoring surrounding whitespace and case.
+// s may match either one of the enum's values or one of its declared names.
+func ParseExampleEnumInputEnumLoose(s string) (ExampleEnumInputEnum, error) {
+	s = strings.TrimSpace(s)
+	if v, ok := exampleEnumInputEnumValues[s]; ok {
+		return v, nil
+	}
+	for _, v := range []ExampleEnumInputEnum{ExampleEnumInputEnumOne, ExampleEnumInputEnumTwo} {
+		if strings.EqualFold(string(v), s) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ExampleEnumInputEnum value %q", s)
+}
+
 func (ExampleEnumInputEnum) ElementType() reflect.Type {
 	return reflect.TypeOf((*ExampleEnumInputEnum)(nil)).Elem()
 }
@@ -248,6 +418,11 @@ func (o ExampleEnumInputEnumOutput) ToExampleEnumInputEnumPtrOutputWithContext(c
 	}).(ExampleEnumInputEnumPtrOutput)
 }
 
+// Ptr converts the output to a ExampleEnumInputEnumPtrOutput. It is shorthand for ToExampleEnumInputEnumPtrOutput.
+func (o ExampleEnumInputEnumOutput) Ptr() ExampleEnumInputEnumPtrOutput {
+	return o.ToExampleEnumInputEnumPtrOutput()
+}
+
 func (o ExampleEnumInputEnumOutput) ToStringOutput() pulumi.StringOutput {
 	return o.ToStringOutputWithContext(context.Background())
 }
@@ -333,6 +508,14 @@ func ExampleEnumInputEnumPtr(v string) ExampleEnumInputEnumPtrInput {
 	return (*exampleEnumInputEnumPtr)(&v)
 }
 
+// ExampleEnumInputEnumPtrFromPtr returns a ExampleEnumInputEnumPtrInput for the value that v points to. A nil pointer returns a nil input.
+func ExampleEnumInputEnumPtrFromPtr(v *ExampleEnumInputEnum) ExampleEnumInputEnumPtrInput {
+	if v == nil {
+		return nil
+	}
+	return ExampleEnumInputEnumPtr(string(*v))
+}
+
 func (*exampleEnumInputEnumPtr) ElementType() reflect.Type {
 	return exampleEnumInputEnumPtrType
 }
@@ -351,6 +534,27 @@ func (in *exampleEnumInputEnumPtr) ToOutput(ctx context.Context) pulumix.Output[
 	}
 }
 
+// ExampleEnumInputEnumFromStringInput is an input of ExampleEnumInputEnum that is built from an input of the underlying string type.
+// Construct one with ExampleEnumInputEnumFromString.
+type ExampleEnumInputEnumFromStringInput interface {
+	pulumi.Input
+
+	ToExampleEnumInputEnumOutput() ExampleEnumInputEnumOutput
+	ToExampleEnumInputEnumOutputWithContext(context.Context) ExampleEnumInputEnumOutput
+	ToExampleEnumInputEnumPtrOutput() ExampleEnumInputEnumPtrOutput
+	ToExampleEnumInputEnumPtrOutputWithContext(context.Context) ExampleEnumInputEnumPtrOutput
+}
+
+type exampleEnumInputEnumFromString struct{ ExampleEnumInputEnumOutput }
+
+// ExampleEnumInputEnumFromString returns a ExampleEnumInputEnumFromStringInput for an input of the underlying string type, such as a pulumi.String
+// or a pulumi.StringOutput. The value is not checked against the enum's declared values.
+func ExampleEnumInputEnumFromString(v pulumi.StringInput) ExampleEnumInputEnumFromStringInput {
+	return exampleEnumInputEnumFromString{v.ToStringOutput().ApplyT(func(v string) ExampleEnumInputEnum {
+		return ExampleEnumInputEnum(v)
+	}).(ExampleEnumInputEnumOutput)}
+}
+
 type ResourceTypeEnum string
 
 const (
@@ -358,6 +562,73 @@ const (
 	ResourceTypeEnumBusiness = ResourceTypeEnum("business")
 )
 
+// ResourceTypeEnumAll lists every declared value of ResourceTypeEnum, in declaration order.
+var ResourceTypeEnumAll = []ResourceTypeEnum{ResourceTypeEnumHaha, ResourceTypeEnumBusiness}
+
+// ResourceTypeEnumMissing returns the declared values of ResourceTypeEnum that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over ResourceTypeEnum handles to check
+// that the switch is exhaustive.
+func ResourceTypeEnumMissing(handled ...ResourceTypeEnum) []ResourceTypeEnum {
+	var missing []ResourceTypeEnum
+	for _, v := range ResourceTypeEnumAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// ResourceTypeEnumMeta describes the ResourceTypeEnum enum and its values.
+var ResourceTypeEnumMeta = pulumi.EnumMeta{
+	Name: "ResourceTypeEnum",
+	Type: "example::ResourceType",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "ResourceTypeEnumHaha", Value: ResourceTypeEnumHaha},
+		{Name: "ResourceTypeEnumBusiness", Value: ResourceTypeEnumBusiness},
+	},
+}
+
+// resourceTypeEnumValues maps the underlying values of ResourceTypeEnum to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var resourceTypeEnumValues map[string]ResourceTypeEnum
+
+func init() {
+	resourceTypeEnumValues = make(map[string]ResourceTypeEnum, 2)
+	for _, v := range []ResourceTypeEnum{ResourceTypeEnumHaha, ResourceTypeEnumBusiness} {
+		resourceTypeEnumValues[string(v)] = v
+	}
+}
+
+// ParseResourceTypeEnum parses s as a ResourceTypeEnum. s must exactly match one of the enum's values.
+func ParseResourceTypeEnum(s string) (ResourceTypeEnum, error) {
+	if v, ok := resourceTypeEnumValues[s]; ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid ResourceTypeEnum value %q", s)
+}
+
+// ParseResourceTypeEnumLoose parses s as a ResourceTypeEnum, ignoring surrounding whitespace and case.
+// s may match either one of the enum's values or one of its declared names.
+func ParseResourceTypeEnumLoose(s string) (ResourceTypeEnum, error) {
+	s = strings.TrimSpace(s)
+	if v, ok := resourceTypeEnumValues[s]; ok {
+		return v, nil
+	}
+	for _, v := range []ResourceTypeEnum{ResourceTypeEnumHaha, ResourceTypeEnumBusiness} {
+		if strings.EqualFold(string(v), s) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ResourceTypeEnum value %q", s)
+}
+
 func (ResourceTypeEnum) ElementType() reflect.Type {
 	return reflect.TypeOf((*ResourceTypeEnum)(nil)).Elem()
 }
@@ -418,6 +689,11 @@ func (o ResourceTypeEnumOutput) ToResourceTypeEnumPtrOutputWithContext(ctx conte
 	}).(ResourceTypeEnumPtrOutput)
 }
 
+// Ptr converts the output to a ResourceTypeEnumPtrOutput. It is shorthand for ToResourceTypeEnumPtrOutput.
+func (o ResourceTypeEnumOutput) Ptr() ResourceTypeEnumPtrOutput {
+	return o.ToResourceTypeEnumPtrOutput()
+}
+
 func (o ResourceTypeEnumOutput) ToStringOutput() pulumi.StringOutput {
 	return o.ToStringOutputWithContext(context.Background())
 }
@@ -503,6 +779,14 @@ func ResourceTypeEnumPtr(v string) ResourceTypeEnumPtrInput {
 	return (*resourceTypeEnumPtr)(&v)
 }
 
+// ResourceTypeEnumPtrFromPtr returns a ResourceTypeEnumPtrInput for the value that v points to. A nil pointer returns a nil input.
+func ResourceTypeEnumPtrFromPtr(v *ResourceTypeEnum) ResourceTypeEnumPtrInput {
+	if v == nil {
+		return nil
+	}
+	return ResourceTypeEnumPtr(string(*v))
+}
+
 func (*resourceTypeEnumPtr) ElementType() reflect.Type {
 	return resourceTypeEnumPtrType
 }
@@ -521,13 +805,37 @@ func (in *resourceTypeEnumPtr) ToOutput(ctx context.Context) pulumix.Output[*Res
 	}
 }
 
+// ResourceTypeEnumFromStringInput is an input of ResourceTypeEnum that is built from an input of the underlying string type.
+// Construct one with ResourceTypeEnumFromString.
+type ResourceTypeEnumFromStringInput interface {
+	pulumi.Input
+
+	ToResourceTypeEnumOutput() ResourceTypeEnumOutput
+	ToResourceTypeEnumOutputWithContext(context.Context) ResourceTypeEnumOutput
+	ToResourceTypeEnumPtrOutput() ResourceTypeEnumPtrOutput
+	ToResourceTypeEnumPtrOutputWithContext(context.Context) ResourceTypeEnumPtrOutput
+}
+
+type resourceTypeEnumFromString struct{ ResourceTypeEnumOutput }
+
+// ResourceTypeEnumFromString returns a ResourceTypeEnumFromStringInput for an input of the underlying string type, such as a pulumi.String
+// or a pulumi.StringOutput. The value is not checked against the enum's declared values.
+func ResourceTypeEnumFromString(v pulumi.StringInput) ResourceTypeEnumFromStringInput {
+	return resourceTypeEnumFromString{v.ToStringOutput().ApplyT(func(v string) ResourceTypeEnum {
+		return ResourceTypeEnum(v)
+	}).(ResourceTypeEnumOutput)}
+}
+
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*ExampleEnumInput)(nil)).Elem(), ExampleEnum("one"))
 	pulumi.RegisterInputType(reflect.TypeOf((*ExampleEnumPtrInput)(nil)).Elem(), ExampleEnum("one"))
+	pulumi.RegisterInputType(reflect.TypeOf((*ExampleEnumFromStringInput)(nil)).Elem(), exampleEnumFromString{})
 	pulumi.RegisterInputType(reflect.TypeOf((*ExampleEnumInputEnumInput)(nil)).Elem(), ExampleEnumInputEnum("one"))
 	pulumi.RegisterInputType(reflect.TypeOf((*ExampleEnumInputEnumPtrInput)(nil)).Elem(), ExampleEnumInputEnum("one"))
+	pulumi.RegisterInputType(reflect.TypeOf((*ExampleEnumInputEnumFromStringInput)(nil)).Elem(), exampleEnumInputEnumFromString{})
 	pulumi.RegisterInputType(reflect.TypeOf((*ResourceTypeEnumInput)(nil)).Elem(), ResourceTypeEnum("haha"))
 	pulumi.RegisterInputType(reflect.TypeOf((*ResourceTypeEnumPtrInput)(nil)).Elem(), ResourceTypeEnum("haha"))
+	pulumi.RegisterInputType(reflect.TypeOf((*ResourceTypeEnumFromStringInput)(nil)).Elem(), resourceTypeEnumFromString{})
 	pulumi.RegisterOutputType(ExampleEnumOutput{})
 	pulumi.RegisterOutputType(ExampleEnumPtrOutput{})
 	pulumi.RegisterOutputType(ExampleEnumInputEnumOutput{})
@@ -5,7 +5,9 @@ package myedgeorder
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
@@ -21,6 +23,73 @@ const (
 	SupportedFilterTypesDoubleEncryptionStatus = SupportedFilterTypes("DoubleEncryptionStatus")
 )
 
+// SupportedFilterTypesAll lists every declared value of SupportedFilterTypes, in declaration order.
+var SupportedFilterTypesAll = []SupportedFilterTypes{SupportedFilterTypesShipToCountries, SupportedFilterTypesDoubleEncryptionStatus}
+
+// SupportedFilterTypesMissing returns the declared values of SupportedFilterTypes that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over SupportedFilterTypes handles to check
+// that the switch is exhaustive.
+func SupportedFilterTypesMissing(handled ...SupportedFilterTypes) []SupportedFilterTypes {
+	var missing []SupportedFilterTypes
+	for _, v := range SupportedFilterTypesAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// SupportedFilterTypesMeta describes the SupportedFilterTypes enum and its values.
+var SupportedFilterTypesMeta = pulumi.EnumMeta{
+	Name: "SupportedFilterTypes",
+	Type: "myedgeorder::SupportedFilterTypes",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "SupportedFilterTypesShipToCountries", Value: SupportedFilterTypesShipToCountries},
+		{Name: "SupportedFilterTypesDoubleEncryptionStatus", Value: SupportedFilterTypesDoubleEncryptionStatus},
+	},
+}
+
+// supportedFilterTypesValues maps the underlying values of SupportedFilterTypes to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var supportedFilterTypesValues map[string]SupportedFilterTypes
+
+func init() {
+	supportedFilterTypesValues = make(map[string]SupportedFilterTypes, 2)
+	for _, v := range []SupportedFilterTypes{SupportedFilterTypesShipToCountries, SupportedFilterTypesDoubleEncryptionStatus} {
+		supportedFilterTypesValues[string(v)] = v
+	}
+}
+
+// ParseSupportedFilterTypes parses s as a SupportedFilterTypes. s must exactly match one of the enum's values.
+func ParseSupportedFilterTypes(s string) (SupportedFilterTypes, error) {
+	if v, ok := supportedFilterTypesValues[s]; ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid SupportedFilterTypes value %q", s)
+}
+
+// ParseSupportedFilterTypesLoose parses s as a SupportedFilterTypes, ignoring surrounding whitespace and case.
+// s may match either one of the enum's values or one of its declared names.
+func ParseSupportedFilterTypesLoose(s string) (SupportedFilterTypes, error) {
+	s = strings.TrimSpace(s)
+	if v, ok := supportedFilterTypesValues[s]; ok {
+		return v, nil
+	}
+	for _, v := range []SupportedFilterTypes{SupportedFilterTypesShipToCountries, SupportedFilterTypesDoubleEncryptionStatus} {
+		if strings.EqualFold(string(v), s) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SupportedFilterTypes value %q", s)
+}
+
 func (SupportedFilterTypes) ElementType() reflect.Type {
 	return reflect.TypeOf((*SupportedFilterTypes)(nil)).Elem()
 }
@@ -81,6 +150,11 @@ func (o SupportedFilterTypesOutput) ToSupportedFilterTypesPtrOutputWithContext(c
 	}).(SupportedFilterTypesPtrOutput)
 }
 
+// Ptr converts the output to a SupportedFilterTypesPtrOutput. It is shorthand for ToSupportedFilterTypesPtrOutput.
+func (o SupportedFilterTypesOutput) Ptr() SupportedFilterTypesPtrOutput {
+	return o.ToSupportedFilterTypesPtrOutput()
+}
+
 func (o SupportedFilterTypesOutput) ToStringOutput() pulumi.StringOutput {
 	return o.ToStringOutputWithContext(context.Background())
 }
@@ -166,6 +240,14 @@ func SupportedFilterTypesPtr(v string) SupportedFilterTypesPtrInput {
 	return (*supportedFilterTypesPtr)(&v)
 }
 
+// SupportedFilterTypesPtrFromPtr returns a SupportedFilterTypesPtrInput for the value that v points to. A nil pointer returns a nil input.
+func SupportedFilterTypesPtrFromPtr(v *SupportedFilterTypes) SupportedFilterTypesPtrInput {
+	if v == nil {
+		return nil
+	}
+	return SupportedFilterTypesPtr(string(*v))
+}
+
 func (*supportedFilterTypesPtr) ElementType() reflect.Type {
 	return supportedFilterTypesPtrType
 }
@@ -184,9 +266,31 @@ func (in *supportedFilterTypesPtr) ToOutput(ctx context.Context) pulumix.Output[
 	}
 }
 
+// SupportedFilterTypesFromStringInput is an input of SupportedFilterTypes that is built from an input of the underlying string type.
+// Construct one with SupportedFilterTypesFromString.
+type SupportedFilterTypesFromStringInput interface {
+	pulumi.Input
+
+	ToSupportedFilterTypesOutput() SupportedFilterTypesOutput
+	ToSupportedFilterTypesOutputWithContext(context.Context) SupportedFilterTypesOutput
+	ToSupportedFilterTypesPtrOutput() SupportedFilterTypesPtrOutput
+	ToSupportedFilterTypesPtrOutputWithContext(context.Context) SupportedFilterTypesPtrOutput
+}
+
+type supportedFilterTypesFromString struct{ SupportedFilterTypesOutput }
+
+// SupportedFilterTypesFromString returns a SupportedFilterTypesFromStringInput for an input of the underlying string type, such as a pulumi.String
+// or a pulumi.StringOutput. The value is not checked against the enum's declared values.
+func SupportedFilterTypesFromString(v pulumi.StringInput) SupportedFilterTypesFromStringInput {
+	return supportedFilterTypesFromString{v.ToStringOutput().ApplyT(func(v string) SupportedFilterTypes {
+		return SupportedFilterTypes(v)
+	}).(SupportedFilterTypesOutput)}
+}
+
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*SupportedFilterTypesInput)(nil)).Elem(), SupportedFilterTypes("ShipToCountries"))
 	pulumi.RegisterInputType(reflect.TypeOf((*SupportedFilterTypesPtrInput)(nil)).Elem(), SupportedFilterTypes("ShipToCountries"))
+	pulumi.RegisterInputType(reflect.TypeOf((*SupportedFilterTypesFromStringInput)(nil)).Elem(), supportedFilterTypesFromString{})
 	pulumi.RegisterOutputType(SupportedFilterTypesOutput{})
 	pulumi.RegisterOutputType(SupportedFilterTypesPtrOutput{})
 }
@@ -19,6 +19,40 @@ const (
 	EnumThingEight = EnumThing(8)
 )
 
+// EnumThingAll lists every declared value of EnumThing, in declaration order.
+var EnumThingAll = []EnumThing{EnumThingFour, EnumThingSix, EnumThingEight}
+
+// EnumThingMissing returns the declared values of EnumThing that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over EnumThing handles to check
+// that the switch is exhaustive.
+func EnumThingMissing(handled ...EnumThing) []EnumThing {
+	var missing []EnumThing
+	for _, v := range EnumThingAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// EnumThingMeta describes the EnumThing enum and its values.
+var EnumThingMeta = pulumi.EnumMeta{
+	Name: "EnumThing",
+	Type: "foobar::EnumThing",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "EnumThingFour", Value: EnumThingFour},
+		{Name: "EnumThingSix", Value: EnumThingSix},
+		{Name: "EnumThingEight", Value: EnumThingEight},
+	},
+}
+
 func (EnumThing) ElementType() reflect.Type {
 	return reflect.TypeOf((*EnumThing)(nil)).Elem()
 }
@@ -85,6 +119,11 @@ func (o EnumThingOutput) ToOutput(ctx context.Context) pulumix.Output[EnumThing]
 	}
 }
 
+// Ptr converts the output to a EnumThingPtrOutput. It is shorthand for ToEnumThingPtrOutput.
+func (o EnumThingOutput) Ptr() EnumThingPtrOutput {
+	return o.ToEnumThingPtrOutput()
+}
+
 func (o EnumThingOutput) ToIntOutput() pulumi.IntOutput {
 	return o.ToIntOutputWithContext(context.Background())
 }
@@ -176,6 +215,29 @@ func EnumThingPtr(v int) EnumThingPtrInput {
 	return (*enumThingPtr)(&v)
 }
 
+// EnumThingPtrFromPtr returns a EnumThingPtrInput for the value that v points to. A nil pointer returns a nil input.
+func EnumThingPtrFromPtr(v *EnumThing) EnumThingPtrInput {
+	if v == nil {
+		return nil
+	}
+	return EnumThingPtr(int(*v))
+}
+
+// EnumThingFourPtr returns a EnumThingPtrInput for EnumThingFour.
+func EnumThingFourPtr() EnumThingPtrInput {
+	return EnumThingPtr(int(EnumThingFour))
+}
+
+// EnumThingSixPtr returns a EnumThingPtrInput for EnumThingSix.
+func EnumThingSixPtr() EnumThingPtrInput {
+	return EnumThingPtr(int(EnumThingSix))
+}
+
+// EnumThingEightPtr returns a EnumThingPtrInput for EnumThingEight.
+func EnumThingEightPtr() EnumThingPtrInput {
+	return EnumThingPtr(int(EnumThingEight))
+}
+
 func (*enumThingPtr) ElementType() reflect.Type {
 	return enumThingPtrType
 }
@@ -194,9 +256,31 @@ func (in *enumThingPtr) ToOutput(ctx context.Context) pulumix.Output[*EnumThing]
 	}
 }
 
+// EnumThingFromIntInput is an input of EnumThing that is built from an input of the underlying int type.
+// Construct one with EnumThingFromInt.
+type EnumThingFromIntInput interface {
+	pulumi.Input
+
+	ToEnumThingOutput() EnumThingOutput
+	ToEnumThingOutputWithContext(context.Context) EnumThingOutput
+	ToEnumThingPtrOutput() EnumThingPtrOutput
+	ToEnumThingPtrOutputWithContext(context.Context) EnumThingPtrOutput
+}
+
+type enumThingFromInt struct{ EnumThingOutput }
+
+// EnumThingFromInt returns a EnumThingFromIntInput for an input of the underlying int type, such as a pulumi.Int
+// or a pulumi.IntOutput. The value is not checked against the enum's declared values.
+func EnumThingFromInt(v pulumi.IntInput) EnumThingFromIntInput {
+	return enumThingFromInt{v.ToIntOutput().ApplyT(func(v int) EnumThing {
+		return EnumThing(v)
+	}).(EnumThingOutput)}
+}
+
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*EnumThingInput)(nil)).Elem(), EnumThing(4))
 	pulumi.RegisterInputType(reflect.TypeOf((*EnumThingPtrInput)(nil)).Elem(), EnumThing(4))
+	pulumi.RegisterInputType(reflect.TypeOf((*EnumThingFromIntInput)(nil)).Elem(), enumThingFromInt{})
 	pulumi.RegisterOutputType(EnumThingOutput{})
 	pulumi.RegisterOutputType(EnumThingPtrOutput{})
 }
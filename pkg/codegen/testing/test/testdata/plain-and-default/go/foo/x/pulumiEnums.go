@@ -10,3 +10,26 @@ const (
 	EnumThingEnumThingSix   = EnumThing(6)
 	EnumThingEnumThingEight = EnumThing(8)
 )
+
+// EnumThingAll lists every declared value of EnumThing, in declaration order.
+var EnumThingAll = []EnumThing{EnumThingEnumThingFour, EnumThingEnumThingSix, EnumThingEnumThingEight}
+
+// EnumThingMissing returns the declared values of EnumThing that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over EnumThing handles to check
+// that the switch is exhaustive.
+func EnumThingMissing(handled ...EnumThing) []EnumThing {
+	var missing []EnumThing
+	for _, v := range EnumThingAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
@@ -5,7 +5,9 @@ package configstation
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
@@ -18,6 +20,73 @@ const (
 	ColorRed  = Color("red")
 )
 
+// ColorAll lists every declared value of Color, in declaration order.
+var ColorAll = []Color{ColorBlue, ColorRed}
+
+// ColorMissing returns the declared values of Color that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over Color handles to check
+// that the switch is exhaustive.
+func ColorMissing(handled ...Color) []Color {
+	var missing []Color
+	for _, v := range ColorAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// ColorMeta describes the Color enum and its values.
+var ColorMeta = pulumi.EnumMeta{
+	Name: "Color",
+	Type: "configstation:index:color",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "ColorBlue", Value: ColorBlue},
+		{Name: "ColorRed", Value: ColorRed},
+	},
+}
+
+// colorValues maps the underlying values of Color to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var colorValues map[string]Color
+
+func init() {
+	colorValues = make(map[string]Color, 2)
+	for _, v := range []Color{ColorBlue, ColorRed} {
+		colorValues[string(v)] = v
+	}
+}
+
+// ParseColor parses s as a Color. s must exactly match one of the enum's values.
+func ParseColor(s string) (Color, error) {
+	if v, ok := colorValues[s]; ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid Color value %q", s)
+}
+
+// ParseColorLoose parses s as a Color, ignoring surrounding whitespace and case.
+// s may match either one of the enum's values or one of its declared names.
+func ParseColorLoose(s string) (Color, error) {
+	s = strings.TrimSpace(s)
+	if v, ok := colorValues[s]; ok {
+		return v, nil
+	}
+	for _, v := range []Color{ColorBlue, ColorRed} {
+		if strings.EqualFold(string(v), s) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid Color value %q", s)
+}
+
 func (Color) ElementType() reflect.Type {
 	return reflect.TypeOf((*Color)(nil)).Elem()
 }
@@ -78,6 +147,11 @@ func (o ColorOutput) ToColorPtrOutputWithContext(ctx context.Context) ColorPtrOu
 	}).(ColorPtrOutput)
 }
 
+// Ptr converts the output to a ColorPtrOutput. It is shorthand for ToColorPtrOutput.
+func (o ColorOutput) Ptr() ColorPtrOutput {
+	return o.ToColorPtrOutput()
+}
+
 func (o ColorOutput) ToStringOutput() pulumi.StringOutput {
 	return o.ToStringOutputWithContext(context.Background())
 }
@@ -163,6 +237,14 @@ func ColorPtr(v string) ColorPtrInput {
 	return (*colorPtr)(&v)
 }
 
+// ColorPtrFromPtr returns a ColorPtrInput for the value that v points to. A nil pointer returns a nil input.
+func ColorPtrFromPtr(v *Color) ColorPtrInput {
+	if v == nil {
+		return nil
+	}
+	return ColorPtr(string(*v))
+}
+
 func (*colorPtr) ElementType() reflect.Type {
 	return colorPtrType
 }
@@ -181,9 +263,31 @@ func (in *colorPtr) ToOutput(ctx context.Context) pulumix.Output[*Color] {
 	}
 }
 
+// ColorFromStringInput is an input of Color that is built from an input of the underlying string type.
+// Construct one with ColorFromString.
+type ColorFromStringInput interface {
+	pulumi.Input
+
+	ToColorOutput() ColorOutput
+	ToColorOutputWithContext(context.Context) ColorOutput
+	ToColorPtrOutput() ColorPtrOutput
+	ToColorPtrOutputWithContext(context.Context) ColorPtrOutput
+}
+
+type colorFromString struct{ ColorOutput }
+
+// ColorFromString returns a ColorFromStringInput for an input of the underlying string type, such as a pulumi.String
+// or a pulumi.StringOutput. The value is not checked against the enum's declared values.
+func ColorFromString(v pulumi.StringInput) ColorFromStringInput {
+	return colorFromString{v.ToStringOutput().ApplyT(func(v string) Color {
+		return Color(v)
+	}).(ColorOutput)}
+}
+
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*ColorInput)(nil)).Elem(), Color("blue"))
 	pulumi.RegisterInputType(reflect.TypeOf((*ColorPtrInput)(nil)).Elem(), Color("blue"))
+	pulumi.RegisterInputType(reflect.TypeOf((*ColorFromStringInput)(nil)).Elem(), colorFromString{})
 	pulumi.RegisterOutputType(ColorOutput{})
 	pulumi.RegisterOutputType(ColorPtrOutput{})
 }
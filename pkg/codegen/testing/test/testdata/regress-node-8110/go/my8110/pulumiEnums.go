@@ -5,7 +5,9 @@ package my8110
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
@@ -18,6 +20,73 @@ const (
 	MyEnumTwo = MyEnum("two")
 )
 
+// MyEnumAll lists every declared value of MyEnum, in declaration order.
+var MyEnumAll = []MyEnum{MyEnumOne, MyEnumTwo}
+
+// MyEnumMissing returns the declared values of MyEnum that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over MyEnum handles to check
+// that the switch is exhaustive.
+func MyEnumMissing(handled ...MyEnum) []MyEnum {
+	var missing []MyEnum
+	for _, v := range MyEnumAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// MyEnumMeta describes the MyEnum enum and its values.
+var MyEnumMeta = pulumi.EnumMeta{
+	Name: "MyEnum",
+	Type: "my8110::MyEnum",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "MyEnumOne", Value: MyEnumOne},
+		{Name: "MyEnumTwo", Value: MyEnumTwo},
+	},
+}
+
+// myEnumValues maps the underlying values of MyEnum to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var myEnumValues map[string]MyEnum
+
+func init() {
+	myEnumValues = make(map[string]MyEnum, 2)
+	for _, v := range []MyEnum{MyEnumOne, MyEnumTwo} {
+		myEnumValues[string(v)] = v
+	}
+}
+
+// ParseMyEnum parses s as a MyEnum. s must exactly match one of the enum's values.
+func ParseMyEnum(s string) (MyEnum, error) {
+	if v, ok := myEnumValues[s]; ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid MyEnum value %q", s)
+}
+
+// ParseMyEnumLoose parses s as a MyEnum, ignoring surrounding whitespace and case.
+// s may match either one of the enum's values or one of its declared names.
+func ParseMyEnumLoose(s string) (MyEnum, error) {
+	s = strings.TrimSpace(s)
+	if v, ok := myEnumValues[s]; ok {
+		return v, nil
+	}
+	for _, v := range []MyEnum{MyEnumOne, MyEnumTwo} {
+		if strings.EqualFold(string(v), s) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid MyEnum value %q", s)
+}
+
 func (MyEnum) ElementType() reflect.Type {
 	return reflect.TypeOf((*MyEnum)(nil)).Elem()
 }
@@ -78,6 +147,11 @@ func (o MyEnumOutput) ToMyEnumPtrOutputWithContext(ctx context.Context) MyEnumPt
 	}).(MyEnumPtrOutput)
 }
 
+// Ptr converts the output to a MyEnumPtrOutput. It is shorthand for ToMyEnumPtrOutput.
+func (o MyEnumOutput) Ptr() MyEnumPtrOutput {
+	return o.ToMyEnumPtrOutput()
+}
+
 func (o MyEnumOutput) ToStringOutput() pulumi.StringOutput {
 	return o.ToStringOutputWithContext(context.Background())
 }
@@ -163,6 +237,14 @@ func MyEnumPtr(v string) MyEnumPtrInput {
 	return (*myEnumPtr)(&v)
 }
 
+// MyEnumPtrFromPtr returns a MyEnumPtrInput for the value that v points to. A nil pointer returns a nil input.
+func MyEnumPtrFromPtr(v *MyEnum) MyEnumPtrInput {
+	if v == nil {
+		return nil
+	}
+	return MyEnumPtr(string(*v))
+}
+
 func (*myEnumPtr) ElementType() reflect.Type {
 	return myEnumPtrType
 }
@@ -181,9 +263,31 @@ func (in *myEnumPtr) ToOutput(ctx context.Context) pulumix.Output[*MyEnum] {
 	}
 }
 
+// MyEnumFromStringInput is an input of MyEnum that is built from an input of the underlying string type.
+// Construct one with MyEnumFromString.
+type MyEnumFromStringInput interface {
+	pulumi.Input
+
+	ToMyEnumOutput() MyEnumOutput
+	ToMyEnumOutputWithContext(context.Context) MyEnumOutput
+	ToMyEnumPtrOutput() MyEnumPtrOutput
+	ToMyEnumPtrOutputWithContext(context.Context) MyEnumPtrOutput
+}
+
+type myEnumFromString struct{ MyEnumOutput }
+
+// MyEnumFromString returns a MyEnumFromStringInput for an input of the underlying string type, such as a pulumi.String
+// or a pulumi.StringOutput. The value is not checked against the enum's declared values.
+func MyEnumFromString(v pulumi.StringInput) MyEnumFromStringInput {
+	return myEnumFromString{v.ToStringOutput().ApplyT(func(v string) MyEnum {
+		return MyEnum(v)
+	}).(MyEnumOutput)}
+}
+
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*MyEnumInput)(nil)).Elem(), MyEnum("one"))
 	pulumi.RegisterInputType(reflect.TypeOf((*MyEnumPtrInput)(nil)).Elem(), MyEnum("one"))
+	pulumi.RegisterInputType(reflect.TypeOf((*MyEnumFromStringInput)(nil)).Elem(), myEnumFromString{})
 	pulumi.RegisterOutputType(MyEnumOutput{})
 	pulumi.RegisterOutputType(MyEnumPtrOutput{})
 }
@@ -18,6 +18,29 @@ const (
 	CloudAuditOptionsLogName_CloudAuditOptionsLogName_NO_NAME = CloudAuditOptionsLogName("_NO_NAME")
 )
 
+// CloudAuditOptionsLogNameAll lists every declared value of CloudAuditOptionsLogName, in declaration order.
+var CloudAuditOptionsLogNameAll = []CloudAuditOptionsLogName{CloudAuditOptionsLogNameCloudAuditOptionsLogNameUnspecifiedLogName, CloudAuditOptionsLogNameCloudAuditOptionsLogNameAdminActivity, CloudAuditOptionsLogNameCloudAuditOptionsLogNameDataAccess, CloudAuditOptionsLogNameCloudAuditOptionsLogNameSynthetic, CloudAuditOptionsLogName_CloudAuditOptionsLogName_NO_NAME}
+
+// CloudAuditOptionsLogNameMissing returns the declared values of CloudAuditOptionsLogName that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over CloudAuditOptionsLogName handles to check
+// that the switch is exhaustive.
+func CloudAuditOptionsLogNameMissing(handled ...CloudAuditOptionsLogName) []CloudAuditOptionsLogName {
+	var missing []CloudAuditOptionsLogName
+	for _, v := range CloudAuditOptionsLogNameAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
 type ContainerBrightness float64
 
 const (
@@ -25,6 +48,32 @@ const (
 	ContainerBrightnessContainerBrightnessOne          = ContainerBrightness(1)
 )
 
+// ContainerBrightnessDefault is the default value of properties of type ContainerBrightness.
+const ContainerBrightnessDefault = ContainerBrightnessContainerBrightnessOne
+
+// ContainerBrightnessAll lists every declared value of ContainerBrightness, in declaration order.
+var ContainerBrightnessAll = []ContainerBrightness{ContainerBrightnessContainerBrightnessZeroPointOne, ContainerBrightnessContainerBrightnessOne}
+
+// ContainerBrightnessMissing returns the declared values of ContainerBrightness that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over ContainerBrightness handles to check
+// that the switch is exhaustive.
+func ContainerBrightnessMissing(handled ...ContainerBrightness) []ContainerBrightness {
+	var missing []ContainerBrightness
+	for _, v := range ContainerBrightnessAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
 // plant container colors
 type ContainerColor string
 
@@ -34,6 +83,29 @@ const (
 	ContainerColorContainerColorYellow = ContainerColor("yellow")
 )
 
+// ContainerColorAll lists every declared value of ContainerColor, in declaration order.
+var ContainerColorAll = []ContainerColor{ContainerColorContainerColorRed, ContainerColorContainerColorBlue, ContainerColorContainerColorYellow}
+
+// ContainerColorMissing returns the declared values of ContainerColor that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over ContainerColor handles to check
+// that the switch is exhaustive.
+func ContainerColorMissing(handled ...ContainerColor) []ContainerColor {
+	var missing []ContainerColor
+	for _, v := range ContainerColorAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
 // plant container sizes
 type ContainerSize int
 
@@ -43,3 +115,26 @@ const (
 	// Deprecated: Eight inch pots are no longer supported.
 	ContainerSizeContainerSizeEightInch = ContainerSize(8)
 )
+
+// ContainerSizeAll lists every declared value of ContainerSize, in declaration order.
+var ContainerSizeAll = []ContainerSize{ContainerSizeContainerSizeFourInch, ContainerSizeContainerSizeSixInch, ContainerSizeContainerSizeEightInch}
+
+// ContainerSizeMissing returns the declared values of ContainerSize that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over ContainerSize handles to check
+// that the switch is exhaustive.
+func ContainerSizeMissing(handled ...ContainerSize) []ContainerSize {
+	var missing []ContainerSize
+	for _, v := range ContainerSizeAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
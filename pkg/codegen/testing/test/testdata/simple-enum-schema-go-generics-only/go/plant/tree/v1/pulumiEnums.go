@@ -10,6 +10,32 @@ const (
 	DiameterDiameterTwelveinch = Diameter(12)
 )
 
+// DiameterDefault is the default value of properties of type Diameter.
+const DiameterDefault = DiameterDiameterSixinch
+
+// DiameterAll lists every declared value of Diameter, in declaration order.
+var DiameterAll = []Diameter{DiameterDiameterSixinch, DiameterDiameterTwelveinch}
+
+// DiameterMissing returns the declared values of Diameter that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over Diameter handles to check
+// that the switch is exhaustive.
+func DiameterMissing(handled ...Diameter) []Diameter {
+	var missing []Diameter
+	for _, v := range DiameterAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
 type Farm string
 
 const (
@@ -17,6 +43,29 @@ const (
 	Farm_Farm_Plants_R_Us          = Farm("Plants'R'Us")
 )
 
+// FarmAll lists every declared value of Farm, in declaration order.
+var FarmAll = []Farm{Farm_Farm_Pulumi_Planters_Inc_, Farm_Farm_Plants_R_Us}
+
+// FarmMissing returns the declared values of Farm that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over Farm handles to check
+// that the switch is exhaustive.
+func FarmMissing(handled ...Farm) []Farm {
+	var missing []Farm
+	for _, v := range FarmAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
 // types of rubber trees
 type RubberTreeVariety string
 
@@ -29,6 +78,32 @@ const (
 	RubberTreeVarietyRubberTreeVarietyTineke = RubberTreeVariety("Tineke")
 )
 
+// RubberTreeVarietyDefault is the default value of properties of type RubberTreeVariety.
+const RubberTreeVarietyDefault = RubberTreeVarietyRubberTreeVarietyBurgundy
+
+// RubberTreeVarietyAll lists every declared value of RubberTreeVariety, in declaration order.
+var RubberTreeVarietyAll = []RubberTreeVariety{RubberTreeVarietyRubberTreeVarietyBurgundy, RubberTreeVarietyRubberTreeVarietyRuby, RubberTreeVarietyRubberTreeVarietyTineke}
+
+// RubberTreeVarietyMissing returns the declared values of RubberTreeVariety that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over RubberTreeVariety handles to check
+// that the switch is exhaustive.
+func RubberTreeVarietyMissing(handled ...RubberTreeVariety) []RubberTreeVariety {
+	var missing []RubberTreeVariety
+	for _, v := range RubberTreeVarietyAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
 type TreeSize string
 
 const (
@@ -36,3 +111,29 @@ const (
 	TreeSizeTreeSizeMedium = TreeSize("medium")
 	TreeSizeTreeSizeLarge  = TreeSize("large")
 )
+
+// TreeSizeDefault is the default value of properties of type TreeSize.
+const TreeSizeDefault = TreeSizeTreeSizeMedium
+
+// TreeSizeAll lists every declared value of TreeSize, in declaration order.
+var TreeSizeAll = []TreeSize{TreeSizeTreeSizeSmall, TreeSizeTreeSizeMedium, TreeSizeTreeSizeLarge}
+
+// TreeSizeMissing returns the declared values of TreeSize that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over TreeSize handles to check
+// that the switch is exhaustive.
+func TreeSizeMissing(handled ...TreeSize) []TreeSize {
+	var missing []TreeSize
+	for _, v := range TreeSizeAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
@@ -1,6 +1,10 @@
 package tests
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
 	"sync"
 	"testing"
 
@@ -173,6 +177,272 @@ func TestEnumUsage(t *testing.T) {
 			return nil
 		}, pulumi.WithMocks("project", "stack", mocks(1))))
 	})
+
+	t.Run("EnumCollections", func(t *testing.T) {
+		require.NoError(t, pulumi.RunErr(func(ctx *pulumi.Context) error {
+			varieties := tree.RubberTreeVarietyArray{
+				tree.RubberTreeVarietyBurgundy,
+				tree.RubberTreeVarietyRuby,
+			}.ToRubberTreeVarietyArrayOutput()
+			farms := tree.FarmMap{
+				"planters": tree.Farm_Pulumi_Planters_Inc_,
+			}.ToFarmMapOutput()
+			var wg sync.WaitGroup
+			wg.Add(1)
+			pulumi.All(
+				varieties,
+				varieties.Index(pulumi.Int(1)),
+				farms,
+				farms.MapIndex(pulumi.String("planters")),
+			).ApplyT(func(all []interface{}) error {
+				assert.Equal(t, []tree.RubberTreeVariety{
+					tree.RubberTreeVarietyBurgundy,
+					tree.RubberTreeVarietyRuby,
+				}, all[0])
+				assert.Equal(t, tree.RubberTreeVarietyRuby, all[1])
+				assert.Equal(t, map[string]tree.Farm{"planters": tree.Farm_Pulumi_Planters_Inc_}, all[2])
+				assert.Equal(t, tree.Farm_Pulumi_Planters_Inc_, all[3])
+				wg.Done()
+				return nil
+			})
+			wg.Wait()
+			return nil
+		}, pulumi.WithMocks("project", "stack", mocks(1))))
+	})
+}
+
+func TestEnumParse(t *testing.T) {
+	t.Run("Strict", func(t *testing.T) {
+		color, err := plant.ParseContainerColor("red")
+		require.NoError(t, err)
+		assert.Equal(t, plant.ContainerColorRed, color)
+
+		for _, s := range []string{"Red", " red", "purple"} {
+			_, err := plant.ParseContainerColor(s)
+			assert.EqualError(t, err, fmt.Sprintf("invalid ContainerColor value %q", s))
+		}
+	})
+	t.Run("Loose", func(t *testing.T) {
+		for _, s := range []string{"red", "RED", "  Red\t"} {
+			color, err := plant.ParseContainerColorLoose(s)
+			require.NoError(t, err)
+			assert.Equal(t, plant.ContainerColorRed, color)
+		}
+
+		farm, err := tree.ParseFarmLoose(" plants'r'us ")
+		require.NoError(t, err)
+		assert.Equal(t, tree.Farm_Plants_R_Us, farm)
+
+		_, err = plant.ParseContainerColorLoose(" purple ")
+		assert.EqualError(t, err, `invalid ContainerColor value "purple"`)
+	})
+}
+
+func TestEnumFloatValidation(t *testing.T) {
+	brightness, err := plant.ParseContainerBrightness("0.1")
+	require.NoError(t, err)
+	assert.Equal(t, plant.ContainerBrightnessZeroPointOne, brightness)
+	assert.True(t, brightness.IsValid())
+
+	for _, s := range []string{"NaN", "Inf", "+Inf", "-Inf"} {
+		_, err := plant.ParseContainerBrightness(s)
+		assert.EqualError(t, err, fmt.Sprintf("invalid ContainerBrightness value %q: must be a finite number", s))
+	}
+	_, err = plant.ParseContainerBrightness("0.5")
+	assert.EqualError(t, err, `invalid ContainerBrightness value "0.5"`)
+	_, err = plant.ParseContainerBrightness("bright")
+	assert.Error(t, err)
+
+	for _, v := range []float64{math.NaN(), math.Inf(1), math.Inf(-1), 0.5} {
+		assert.False(t, plant.ContainerBrightness(v).IsValid(), "%v", v)
+	}
+}
+
+func TestEnumIsZero(t *testing.T) {
+	var size plant.ContainerSize
+	assert.True(t, size.IsZero())
+	assert.Equal(t, plant.ContainerSizeUnset, size)
+	assert.False(t, plant.ContainerSizeFourInch.IsZero())
+
+	var farm tree.Farm
+	assert.True(t, farm.IsZero())
+	assert.Equal(t, tree.FarmUnset, farm)
+	assert.False(t, tree.Farm_Plants_R_Us.IsZero())
+}
+
+func TestEnumPtrHelpers(t *testing.T) {
+	assert.Nil(t, plant.ContainerSizePtrFromPtr(nil))
+
+	size := plant.ContainerSizeSixInch
+	sizePtr := plant.ContainerSizePtrFromPtr(&size)
+	size = plant.ContainerSizeFourInch
+
+	require.NoError(t, pulumi.RunErr(func(ctx *pulumi.Context) error {
+		var fromPtr plant.ContainerSizePtrOutput = sizePtr.ToContainerSizePtrOutput()
+		var ptr plant.ContainerSizePtrOutput = plant.ContainerSizeEightInch.ToContainerSizeOutput().Ptr()
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		pulumi.All(fromPtr, ptr).ApplyT(func(all []interface{}) error {
+			assert.Equal(t, plant.ContainerSizeSixInch, *all[0].(*plant.ContainerSize))
+			assert.Equal(t, plant.ContainerSizeEightInch, *all[1].(*plant.ContainerSize))
+			wg.Done()
+			return nil
+		})
+		wg.Wait()
+		return nil
+	}, pulumi.WithMocks("project", "stack", mocks(0))))
+}
+
+func TestEnumSlice(t *testing.T) {
+	diameters, err := tree.DiameterSlice(6, 12, 6)
+	require.NoError(t, err)
+	assert.Equal(t, []tree.Diameter{tree.DiameterSixinch, tree.DiameterTwelveinch, tree.DiameterSixinch}, diameters)
+
+	_, err = tree.DiameterSlice(6, 7, 12, math.NaN(), 0)
+	assert.EqualError(t, err, "invalid Diameter values: 7 at index 1, NaN at index 3, 0 at index 4")
+
+	sizes, err := plant.ContainerSizeSlice()
+	require.NoError(t, err)
+	assert.Empty(t, sizes)
+
+	_, err = plant.ContainerSizeSlice(4, 5, 8)
+	assert.EqualError(t, err, "invalid ContainerSize values: 5 at index 1")
+
+	varieties, err := tree.RubberTreeVarietySlice("Ruby", "Tineke")
+	require.NoError(t, err)
+	assert.Equal(t, []tree.RubberTreeVariety{tree.RubberTreeVarietyRuby, tree.RubberTreeVarietyTineke}, varieties)
+
+	_, err = tree.RubberTreeVarietySlice("ruby", "Ruby", "")
+	assert.EqualError(t, err, `invalid RubberTreeVariety values: "ruby" at index 0, "" at index 2`)
+}
+
+func TestEnumToWire(t *testing.T) {
+	assert.Equal(t, 0.1, plant.ContainerBrightnessZeroPointOne.ToWire())
+	assert.Equal(t, 12.0, tree.DiameterTwelveinch.ToWire())
+	assert.Equal(t, 4, plant.ContainerSizeFourInch.ToWire())
+	assert.Equal(t, "Ruby", tree.RubberTreeVarietyRuby.ToWire())
+}
+
+func TestEnumEquals(t *testing.T) {
+	// The text that JSON encoders produce for 0.1 once it has been stored as a float32.
+	var brightness plant.ContainerBrightness
+	require.NoError(t, json.Unmarshal([]byte("0.10000000149011612"), &brightness))
+	assert.NotEqual(t, plant.ContainerBrightnessZeroPointOne, brightness)
+	assert.True(t, brightness.Equals(plant.ContainerBrightnessZeroPointOne))
+	assert.False(t, brightness.Equals(plant.ContainerBrightnessOne))
+
+	var diameter tree.Diameter
+	require.NoError(t, json.Unmarshal([]byte("12.000000000000002"), &diameter))
+	assert.True(t, diameter.Equals(tree.DiameterTwelveinch))
+	assert.False(t, diameter.Equals(tree.DiameterSixinch))
+
+	assert.True(t, tree.RubberTreeVarietyRuby.Equals(tree.RubberTreeVarietyRuby))
+	assert.False(t, tree.RubberTreeVarietyRuby.Equals(tree.RubberTreeVarietyTineke))
+}
+
+func TestEnumAll(t *testing.T) {
+	assert.Equal(t, []tree.Diameter{tree.DiameterSixinch, tree.DiameterTwelveinch}, tree.DiameterAll)
+
+	// A switch over an enum can be kept exhaustive by checking that the values of its cases are not missing any of the
+	// enum's values.
+	describe := func(v tree.RubberTreeVariety) string {
+		switch v {
+		case tree.RubberTreeVarietyBurgundy, tree.RubberTreeVarietyRuby:
+			return "red"
+		case tree.RubberTreeVarietyTineke:
+			return "variegated"
+		default:
+			return ""
+		}
+	}
+	for _, v := range tree.RubberTreeVarietyAll {
+		assert.NotEmpty(t, describe(v), "unhandled value %v", v)
+	}
+	assert.Empty(t, tree.RubberTreeVarietyMissing(
+		tree.RubberTreeVarietyBurgundy, tree.RubberTreeVarietyRuby, tree.RubberTreeVarietyTineke))
+	assert.Equal(t, []tree.RubberTreeVariety{tree.RubberTreeVarietyTineke},
+		tree.RubberTreeVarietyMissing(tree.RubberTreeVarietyBurgundy, tree.RubberTreeVarietyRuby))
+}
+
+func TestEnumRawInput(t *testing.T) {
+	require.NoError(t, pulumi.RunErr(func(ctx *pulumi.Context) error {
+		// Raw values of an enum's underlying type, including outputs, can be passed where the enum's input is expected.
+		var diameter tree.DiameterInput = tree.DiameterFromFloat64(pulumi.Float64(12))
+		variety := pulumi.String("Tineke").ToStringOutput()
+		rubberTree, err := tree.NewRubberTree(ctx, "raw", &tree.RubberTreeArgs{
+			Diameter: diameter,
+			Farm:     tree.Farm_Plants_R_Us,
+			Type:     tree.RubberTreeVarietyFromString(variety),
+		})
+		require.NoError(t, err)
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		pulumi.All(rubberTree.Diameter, rubberTree.Type).ApplyT(func(all []interface{}) error {
+			assert.Equal(t, tree.DiameterTwelveinch, all[0])
+			assert.Equal(t, tree.RubberTreeVarietyTineke, all[1])
+			wg.Done()
+			return nil
+		})
+		wg.Wait()
+		return nil
+	}, pulumi.WithMocks("project", "stack", mocks(0))))
+}
+
+func TestEnumApply(t *testing.T) {
+	require.NoError(t, pulumi.RunErr(func(ctx *pulumi.Context) error {
+		variety := tree.RubberTreeVarietyRuby.ToRubberTreeVarietyOutput()
+		size := plant.ContainerSizeSixInch.ToContainerSizeOutput()
+
+		// These assignments check the exact result types of the generated helpers.
+		var name pulumi.StringOutput = variety.Apply(func(v tree.RubberTreeVariety) interface{} {
+			return "variety:" + string(v)
+		}).AsStringOutput()
+		var doubled pulumi.IntOutput = size.ApplyWithContext(context.Background(),
+			func(_ context.Context, v plant.ContainerSize) interface{} {
+				return int(v) * 2
+			}).AsIntOutput()
+		ptr := variety.ToRubberTreeVarietyPtrOutput()
+		var isNil pulumi.BoolOutput = ptr.Apply(func(v *tree.RubberTreeVariety) interface{} {
+			return v == nil
+		}).AsBoolOutput()
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		pulumi.All(name, doubled, isNil).ApplyT(func(all []interface{}) error {
+			assert.Equal(t, "variety:Ruby", all[0])
+			assert.Equal(t, 12, all[1])
+			assert.Equal(t, false, all[2])
+			wg.Done()
+			return nil
+		})
+		wg.Wait()
+		return nil
+	}, pulumi.WithMocks("project", "stack", mocks(0))))
+}
+
+func TestEnumElemOrDefault(t *testing.T) {
+	require.NoError(t, pulumi.RunErr(func(ctx *pulumi.Context) error {
+		unset := pulumi.ToOutput(plant.Container{}).(plant.ContainerOutput).Brightness()
+		set := plant.ContainerBrightnessZeroPointOne.ToContainerBrightnessPtrOutput()
+
+		var defaulted pulumi.Float64Output = unset.ElemOrDefault(plant.ContainerBrightnessOne).ToFloat64Output()
+		var kept pulumi.Float64Output = set.ElemOrDefault(plant.ContainerBrightnessOne).ToFloat64Output()
+		var zero pulumi.Float64Output = unset.Elem().ToFloat64Output()
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		pulumi.All(defaulted, kept, zero).ApplyT(func(all []interface{}) error {
+			assert.Equal(t, 1.0, all[0])
+			assert.Equal(t, 0.1, all[1])
+			assert.Equal(t, 0.0, all[2])
+			wg.Done()
+			return nil
+		})
+		wg.Wait()
+		return nil
+	}, pulumi.WithMocks("project", "stack", mocks(0))))
 }
 
 type mocks int
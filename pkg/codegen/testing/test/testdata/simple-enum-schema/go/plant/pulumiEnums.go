@@ -5,13 +5,19 @@ package plant
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 )
 
 // The log_name to populate in the Cloud Audit Record. This is added to regress pulumi/pulumi issue #7913
+//
+// The zero value of CloudAuditOptionsLogName is not a declared value; see CloudAuditOptionsLogNameUnset.
 type CloudAuditOptionsLogName string
 
 const (
@@ -26,6 +32,103 @@ const (
 	CloudAuditOptionsLogName_NO_NAME  = CloudAuditOptionsLogName("_NO_NAME")
 )
 
+// CloudAuditOptionsLogNameUnset is the zero value of CloudAuditOptionsLogName. It is not one of the enum's declared values.
+const CloudAuditOptionsLogNameUnset CloudAuditOptionsLogName = ""
+
+// IsZero reports whether e is the zero value of CloudAuditOptionsLogName.
+func (e CloudAuditOptionsLogName) IsZero() bool {
+	return e == CloudAuditOptionsLogNameUnset
+}
+
+// ToWire returns e in the representation that the schema declares for CloudAuditOptionsLogName, which is a string.
+func (e CloudAuditOptionsLogName) ToWire() interface{} {
+	return string(e)
+}
+
+// Equals reports whether e and other are the same value of CloudAuditOptionsLogName.
+func (e CloudAuditOptionsLogName) Equals(other CloudAuditOptionsLogName) bool {
+	return e == other
+}
+
+// CloudAuditOptionsLogNameAll lists every declared value of CloudAuditOptionsLogName, in declaration order.
+var CloudAuditOptionsLogNameAll = []CloudAuditOptionsLogName{CloudAuditOptionsLogNameUnspecifiedLogName, CloudAuditOptionsLogNameAdminActivity, CloudAuditOptionsLogNameDataAccess, CloudAuditOptionsLogNameSynthetic, CloudAuditOptionsLogName_NO_NAME}
+
+// CloudAuditOptionsLogNameMissing returns the declared values of CloudAuditOptionsLogName that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over CloudAuditOptionsLogName handles to check
+// that the switch is exhaustive.
+func CloudAuditOptionsLogNameMissing(handled ...CloudAuditOptionsLogName) []CloudAuditOptionsLogName {
+	var missing []CloudAuditOptionsLogName
+	for _, v := range CloudAuditOptionsLogNameAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// CloudAuditOptionsLogNameMeta describes the CloudAuditOptionsLogName enum and its values.
+var CloudAuditOptionsLogNameMeta = pulumi.EnumMeta{
+	Name: "CloudAuditOptionsLogName",
+	Type: "plant::CloudAuditOptionsLogName",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "CloudAuditOptionsLogNameUnspecifiedLogName", Value: CloudAuditOptionsLogNameUnspecifiedLogName},
+		{Name: "CloudAuditOptionsLogNameAdminActivity", Value: CloudAuditOptionsLogNameAdminActivity},
+		{Name: "CloudAuditOptionsLogNameDataAccess", Value: CloudAuditOptionsLogNameDataAccess},
+		{Name: "CloudAuditOptionsLogNameSynthetic", Value: CloudAuditOptionsLogNameSynthetic},
+		{Name: "CloudAuditOptionsLogName_NO_NAME", Value: CloudAuditOptionsLogName_NO_NAME},
+	},
+}
+
+// cloudAuditOptionsLogNameValues maps the underlying values of CloudAuditOptionsLogName to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var cloudAuditOptionsLogNameValues map[string]CloudAuditOptionsLogName
+
+func init() {
+	cloudAuditOptionsLogNameValues = make(map[string]CloudAuditOptionsLogName, 5)
+	for _, v := range []CloudAuditOptionsLogName{CloudAuditOptionsLogNameUnspecifiedLogName, CloudAuditOptionsLogNameAdminActivity, CloudAuditOptionsLogNameDataAccess, CloudAuditOptionsLogNameSynthetic, CloudAuditOptionsLogName_NO_NAME} {
+		cloudAuditOptionsLogNameValues[string(v)] = v
+	}
+}
+
+// ParseCloudAuditOptionsLogName parses s as a CloudAuditOptionsLogName. s must exactly match one of the enum's values.
+func ParseCloudAuditOptionsLogName(s string) (CloudAuditOptionsLogName, error) {
+	if v, ok := cloudAuditOptionsLogNameValues[s]; ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid CloudAuditOptionsLogName value %q", s)
+}
+
+// ParseCloudAuditOptionsLogNameLoose parses s as a CloudAuditOptionsLogName, ignoring surrounding whitespace and case.
+// s may match either one of the enum's values or one of its declared names.
+func ParseCloudAuditOptionsLogNameLoose(s string) (CloudAuditOptionsLogName, error) {
+	s = strings.TrimSpace(s)
+	if v, ok := cloudAuditOptionsLogNameValues[s]; ok {
+		return v, nil
+	}
+	for _, v := range []CloudAuditOptionsLogName{CloudAuditOptionsLogNameUnspecifiedLogName, CloudAuditOptionsLogNameAdminActivity, CloudAuditOptionsLogNameDataAccess, CloudAuditOptionsLogNameSynthetic, CloudAuditOptionsLogName_NO_NAME} {
+		if strings.EqualFold(string(v), s) {
+			return v, nil
+		}
+	}
+	if strings.EqualFold("UnspecifiedLogName", s) {
+		return CloudAuditOptionsLogNameUnspecifiedLogName, nil
+	}
+	if strings.EqualFold("AdminActivity", s) {
+		return CloudAuditOptionsLogNameAdminActivity, nil
+	}
+	if strings.EqualFold("DataAccess", s) {
+		return CloudAuditOptionsLogNameDataAccess, nil
+	}
+	return "", fmt.Errorf("invalid CloudAuditOptionsLogName value %q", s)
+}
+
 func (CloudAuditOptionsLogName) ElementType() reflect.Type {
 	return reflect.TypeOf((*CloudAuditOptionsLogName)(nil)).Elem()
 }
@@ -92,6 +195,11 @@ func (o CloudAuditOptionsLogNameOutput) ToOutput(ctx context.Context) pulumix.Ou
 	}
 }
 
+// Ptr converts the output to a CloudAuditOptionsLogNamePtrOutput. It is shorthand for ToCloudAuditOptionsLogNamePtrOutput.
+func (o CloudAuditOptionsLogNameOutput) Ptr() CloudAuditOptionsLogNamePtrOutput {
+	return o.ToCloudAuditOptionsLogNamePtrOutput()
+}
+
 func (o CloudAuditOptionsLogNameOutput) ToStringOutput() pulumi.StringOutput {
 	return o.ToStringOutputWithContext(context.Background())
 }
@@ -143,6 +251,16 @@ func (o CloudAuditOptionsLogNamePtrOutput) Elem() CloudAuditOptionsLogNameOutput
 	}).(CloudAuditOptionsLogNameOutput)
 }
 
+// ElemOrDefault is like Elem, but returns the given default rather than the zero value when the pointer is nil.
+func (o CloudAuditOptionsLogNamePtrOutput) ElemOrDefault(def CloudAuditOptionsLogName) CloudAuditOptionsLogNameOutput {
+	return o.ApplyT(func(v *CloudAuditOptionsLogName) CloudAuditOptionsLogName {
+		if v != nil {
+			return *v
+		}
+		return def
+	}).(CloudAuditOptionsLogNameOutput)
+}
+
 func (o CloudAuditOptionsLogNamePtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
 	return o.ToStringPtrOutputWithContext(context.Background())
 }
@@ -157,6 +275,50 @@ func (o CloudAuditOptionsLogNamePtrOutput) ToStringPtrOutputWithContext(ctx cont
 	}).(pulumi.StringPtrOutput)
 }
 
+// Apply applies the given function to the value of the output, returning an output of the result.
+func (o CloudAuditOptionsLogNameOutput) Apply(applier func(CloudAuditOptionsLogName) interface{}) pulumi.AnyOutput {
+	return o.ApplyT(applier).(pulumi.AnyOutput)
+}
+
+// ApplyWithContext is like Apply, but also passes the given context to the function.
+func (o CloudAuditOptionsLogNameOutput) ApplyWithContext(ctx context.Context, applier func(context.Context, CloudAuditOptionsLogName) interface{}) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, applier).(pulumi.AnyOutput)
+}
+
+// Apply applies the given function to the value of the output, returning an output of the result.
+func (o CloudAuditOptionsLogNamePtrOutput) Apply(applier func(*CloudAuditOptionsLogName) interface{}) pulumi.AnyOutput {
+	return o.ApplyT(applier).(pulumi.AnyOutput)
+}
+
+// ApplyWithContext is like Apply, but also passes the given context to the function.
+func (o CloudAuditOptionsLogNamePtrOutput) ApplyWithContext(ctx context.Context, applier func(context.Context, *CloudAuditOptionsLogName) interface{}) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, applier).(pulumi.AnyOutput)
+}
+
+// ToAnyOutput converts the output to a pulumi.AnyOutput with the same value.
+func (o CloudAuditOptionsLogNameOutput) ToAnyOutput() pulumi.AnyOutput {
+	return o.ToAnyOutputWithContext(context.Background())
+}
+
+// ToAnyOutputWithContext is like ToAnyOutput, but uses the given context.
+func (o CloudAuditOptionsLogNameOutput) ToAnyOutputWithContext(ctx context.Context) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v CloudAuditOptionsLogName) interface{} {
+		return v
+	}).(pulumi.AnyOutput)
+}
+
+// ToAnyOutput converts the output to a pulumi.AnyOutput with the same value.
+func (o CloudAuditOptionsLogNamePtrOutput) ToAnyOutput() pulumi.AnyOutput {
+	return o.ToAnyOutputWithContext(context.Background())
+}
+
+// ToAnyOutputWithContext is like ToAnyOutput, but uses the given context.
+func (o CloudAuditOptionsLogNamePtrOutput) ToAnyOutputWithContext(ctx context.Context) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v *CloudAuditOptionsLogName) interface{} {
+		return v
+	}).(pulumi.AnyOutput)
+}
+
 // CloudAuditOptionsLogNameInput is an input type that accepts CloudAuditOptionsLogNameArgs and CloudAuditOptionsLogNameOutput values.
 // You can construct a concrete instance of `CloudAuditOptionsLogNameInput` via:
 //
@@ -183,6 +345,34 @@ func CloudAuditOptionsLogNamePtr(v string) CloudAuditOptionsLogNamePtrInput {
 	return (*cloudAuditOptionsLogNamePtr)(&v)
 }
 
+// CloudAuditOptionsLogNamePtrFromPtr returns a CloudAuditOptionsLogNamePtrInput for the value that v points to. A nil pointer returns a nil input.
+func CloudAuditOptionsLogNamePtrFromPtr(v *CloudAuditOptionsLogName) CloudAuditOptionsLogNamePtrInput {
+	if v == nil {
+		return nil
+	}
+	return CloudAuditOptionsLogNamePtr(string(*v))
+}
+
+// CloudAuditOptionsLogNameUnspecifiedLogNamePtr returns a CloudAuditOptionsLogNamePtrInput for CloudAuditOptionsLogNameUnspecifiedLogName.
+func CloudAuditOptionsLogNameUnspecifiedLogNamePtr() CloudAuditOptionsLogNamePtrInput {
+	return CloudAuditOptionsLogNamePtr(string(CloudAuditOptionsLogNameUnspecifiedLogName))
+}
+
+// CloudAuditOptionsLogNameAdminActivityPtr returns a CloudAuditOptionsLogNamePtrInput for CloudAuditOptionsLogNameAdminActivity.
+func CloudAuditOptionsLogNameAdminActivityPtr() CloudAuditOptionsLogNamePtrInput {
+	return CloudAuditOptionsLogNamePtr(string(CloudAuditOptionsLogNameAdminActivity))
+}
+
+// CloudAuditOptionsLogNameDataAccessPtr returns a CloudAuditOptionsLogNamePtrInput for CloudAuditOptionsLogNameDataAccess.
+func CloudAuditOptionsLogNameDataAccessPtr() CloudAuditOptionsLogNamePtrInput {
+	return CloudAuditOptionsLogNamePtr(string(CloudAuditOptionsLogNameDataAccess))
+}
+
+// CloudAuditOptionsLogNameSyntheticPtr returns a CloudAuditOptionsLogNamePtrInput for CloudAuditOptionsLogNameSynthetic.
+func CloudAuditOptionsLogNameSyntheticPtr() CloudAuditOptionsLogNamePtrInput {
+	return CloudAuditOptionsLogNamePtr(string(CloudAuditOptionsLogNameSynthetic))
+}
+
 func (*cloudAuditOptionsLogNamePtr) ElementType() reflect.Type {
 	return cloudAuditOptionsLogNamePtrType
 }
@@ -201,6 +391,160 @@ func (in *cloudAuditOptionsLogNamePtr) ToOutput(ctx context.Context) pulumix.Out
 	}
 }
 
+// CloudAuditOptionsLogNameFromStringInput is an input of CloudAuditOptionsLogName that is built from an input of the underlying string type.
+// Construct one with CloudAuditOptionsLogNameFromString.
+type CloudAuditOptionsLogNameFromStringInput interface {
+	pulumi.Input
+
+	ToCloudAuditOptionsLogNameOutput() CloudAuditOptionsLogNameOutput
+	ToCloudAuditOptionsLogNameOutputWithContext(context.Context) CloudAuditOptionsLogNameOutput
+	ToCloudAuditOptionsLogNamePtrOutput() CloudAuditOptionsLogNamePtrOutput
+	ToCloudAuditOptionsLogNamePtrOutputWithContext(context.Context) CloudAuditOptionsLogNamePtrOutput
+}
+
+type cloudAuditOptionsLogNameFromString struct{ CloudAuditOptionsLogNameOutput }
+
+// CloudAuditOptionsLogNameFromString returns a CloudAuditOptionsLogNameFromStringInput for an input of the underlying string type, such as a pulumi.String
+// or a pulumi.StringOutput. The value is not checked against the enum's declared values.
+func CloudAuditOptionsLogNameFromString(v pulumi.StringInput) CloudAuditOptionsLogNameFromStringInput {
+	return cloudAuditOptionsLogNameFromString{v.ToStringOutput().ApplyT(func(v string) CloudAuditOptionsLogName {
+		return CloudAuditOptionsLogName(v)
+	}).(CloudAuditOptionsLogNameOutput)}
+}
+
+// CloudAuditOptionsLogNameSlice returns vals as a slice of CloudAuditOptionsLogName.
+// Every value must be one of the enum's declared values; if any are not, the returned error
+// lists all of them.
+func CloudAuditOptionsLogNameSlice(vals ...string) ([]CloudAuditOptionsLogName, error) {
+	result := make([]CloudAuditOptionsLogName, len(vals))
+	var invalid []string
+	for i, v := range vals {
+		result[i] = CloudAuditOptionsLogName(v)
+		if _, ok := cloudAuditOptionsLogNameValues[v]; !ok {
+			invalid = append(invalid, fmt.Sprintf("%q at index %d", v, i))
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid CloudAuditOptionsLogName values: %s", strings.Join(invalid, ", "))
+	}
+	return result, nil
+}
+
+// CloudAuditOptionsLogNameArrayInput is an input type that accepts CloudAuditOptionsLogNameArray and CloudAuditOptionsLogNameArrayOutput values.
+// You can construct a concrete instance of `CloudAuditOptionsLogNameArrayInput` via:
+//
+//	CloudAuditOptionsLogNameArray{ CloudAuditOptionsLogNameArgs{...} }
+type CloudAuditOptionsLogNameArrayInput interface {
+	pulumi.Input
+
+	ToCloudAuditOptionsLogNameArrayOutput() CloudAuditOptionsLogNameArrayOutput
+	ToCloudAuditOptionsLogNameArrayOutputWithContext(context.Context) CloudAuditOptionsLogNameArrayOutput
+}
+
+type CloudAuditOptionsLogNameArray []CloudAuditOptionsLogName
+
+func (CloudAuditOptionsLogNameArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]CloudAuditOptionsLogName)(nil)).Elem()
+}
+
+func (i CloudAuditOptionsLogNameArray) ToCloudAuditOptionsLogNameArrayOutput() CloudAuditOptionsLogNameArrayOutput {
+	return i.ToCloudAuditOptionsLogNameArrayOutputWithContext(context.Background())
+}
+
+func (i CloudAuditOptionsLogNameArray) ToCloudAuditOptionsLogNameArrayOutputWithContext(ctx context.Context) CloudAuditOptionsLogNameArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CloudAuditOptionsLogNameArrayOutput)
+}
+
+func (i CloudAuditOptionsLogNameArray) ToOutput(ctx context.Context) pulumix.Output[[]CloudAuditOptionsLogName] {
+	return pulumix.Output[[]CloudAuditOptionsLogName]{
+		OutputState: i.ToCloudAuditOptionsLogNameArrayOutputWithContext(ctx).OutputState,
+	}
+}
+
+// CloudAuditOptionsLogNameMapInput is an input type that accepts CloudAuditOptionsLogNameMap and CloudAuditOptionsLogNameMapOutput values.
+// You can construct a concrete instance of `CloudAuditOptionsLogNameMapInput` via:
+//
+//	CloudAuditOptionsLogNameMap{ "key": CloudAuditOptionsLogNameArgs{...} }
+type CloudAuditOptionsLogNameMapInput interface {
+	pulumi.Input
+
+	ToCloudAuditOptionsLogNameMapOutput() CloudAuditOptionsLogNameMapOutput
+	ToCloudAuditOptionsLogNameMapOutputWithContext(context.Context) CloudAuditOptionsLogNameMapOutput
+}
+
+type CloudAuditOptionsLogNameMap map[string]CloudAuditOptionsLogName
+
+func (CloudAuditOptionsLogNameMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]CloudAuditOptionsLogName)(nil)).Elem()
+}
+
+func (i CloudAuditOptionsLogNameMap) ToCloudAuditOptionsLogNameMapOutput() CloudAuditOptionsLogNameMapOutput {
+	return i.ToCloudAuditOptionsLogNameMapOutputWithContext(context.Background())
+}
+
+func (i CloudAuditOptionsLogNameMap) ToCloudAuditOptionsLogNameMapOutputWithContext(ctx context.Context) CloudAuditOptionsLogNameMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CloudAuditOptionsLogNameMapOutput)
+}
+
+func (i CloudAuditOptionsLogNameMap) ToOutput(ctx context.Context) pulumix.Output[map[string]CloudAuditOptionsLogName] {
+	return pulumix.Output[map[string]CloudAuditOptionsLogName]{
+		OutputState: i.ToCloudAuditOptionsLogNameMapOutputWithContext(ctx).OutputState,
+	}
+}
+
+type CloudAuditOptionsLogNameArrayOutput struct{ *pulumi.OutputState }
+
+func (CloudAuditOptionsLogNameArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]CloudAuditOptionsLogName)(nil)).Elem()
+}
+
+func (o CloudAuditOptionsLogNameArrayOutput) ToCloudAuditOptionsLogNameArrayOutput() CloudAuditOptionsLogNameArrayOutput {
+	return o
+}
+
+func (o CloudAuditOptionsLogNameArrayOutput) ToCloudAuditOptionsLogNameArrayOutputWithContext(ctx context.Context) CloudAuditOptionsLogNameArrayOutput {
+	return o
+}
+
+func (o CloudAuditOptionsLogNameArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]CloudAuditOptionsLogName] {
+	return pulumix.Output[[]CloudAuditOptionsLogName]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o CloudAuditOptionsLogNameArrayOutput) Index(i pulumi.IntInput) CloudAuditOptionsLogNameOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) CloudAuditOptionsLogName {
+		return vs[0].([]CloudAuditOptionsLogName)[vs[1].(int)]
+	}).(CloudAuditOptionsLogNameOutput)
+}
+
+type CloudAuditOptionsLogNameMapOutput struct{ *pulumi.OutputState }
+
+func (CloudAuditOptionsLogNameMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]CloudAuditOptionsLogName)(nil)).Elem()
+}
+
+func (o CloudAuditOptionsLogNameMapOutput) ToCloudAuditOptionsLogNameMapOutput() CloudAuditOptionsLogNameMapOutput {
+	return o
+}
+
+func (o CloudAuditOptionsLogNameMapOutput) ToCloudAuditOptionsLogNameMapOutputWithContext(ctx context.Context) CloudAuditOptionsLogNameMapOutput {
+	return o
+}
+
+func (o CloudAuditOptionsLogNameMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]CloudAuditOptionsLogName] {
+	return pulumix.Output[map[string]CloudAuditOptionsLogName]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o CloudAuditOptionsLogNameMapOutput) MapIndex(k pulumi.StringInput) CloudAuditOptionsLogNameOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) CloudAuditOptionsLogName {
+		return vs[0].(map[string]CloudAuditOptionsLogName)[vs[1].(string)]
+	}).(CloudAuditOptionsLogNameOutput)
+}
+
+// The zero value of ContainerBrightness is not a declared value; see ContainerBrightnessUnset.
 type ContainerBrightness float64
 
 const (
@@ -208,6 +552,98 @@ const (
 	ContainerBrightnessOne          = ContainerBrightness(1)
 )
 
+// ContainerBrightnessDefault is the default value of properties of type ContainerBrightness.
+const ContainerBrightnessDefault = ContainerBrightnessOne
+
+// ContainerBrightnessUnset is the zero value of ContainerBrightness. It is not one of the enum's declared values.
+const ContainerBrightnessUnset ContainerBrightness = 0
+
+// IsZero reports whether e is the zero value of ContainerBrightness.
+func (e ContainerBrightness) IsZero() bool {
+	return e == ContainerBrightnessUnset
+}
+
+// ToWire returns e in the representation that the schema declares for ContainerBrightness, which is a float64.
+func (e ContainerBrightness) ToWire() interface{} {
+	return float64(e)
+}
+
+// Equals reports whether e and other are the same value of ContainerBrightness. The values are compared within 0.01,
+// which is finer than the precision of the enum's declared values, so that values that have passed
+// through JSON compare equal to the declared values they represent.
+func (e ContainerBrightness) Equals(other ContainerBrightness) bool {
+	d := float64(e - other)
+	return d <= 0.01 && d >= -0.01
+}
+
+// ContainerBrightnessAll lists every declared value of ContainerBrightness, in declaration order.
+var ContainerBrightnessAll = []ContainerBrightness{ContainerBrightnessZeroPointOne, ContainerBrightnessOne}
+
+// ContainerBrightnessMissing returns the declared values of ContainerBrightness that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over ContainerBrightness handles to check
+// that the switch is exhaustive.
+func ContainerBrightnessMissing(handled ...ContainerBrightness) []ContainerBrightness {
+	var missing []ContainerBrightness
+	for _, v := range ContainerBrightnessAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// ContainerBrightnessMeta describes the ContainerBrightness enum and its values.
+var ContainerBrightnessMeta = pulumi.EnumMeta{
+	Name: "ContainerBrightness",
+	Type: "plant::ContainerBrightness",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "ContainerBrightnessZeroPointOne", Value: ContainerBrightnessZeroPointOne},
+		{Name: "ContainerBrightnessOne", Value: ContainerBrightnessOne},
+	},
+}
+
+// containerBrightnessValues maps the underlying values of ContainerBrightness to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var containerBrightnessValues map[float64]ContainerBrightness
+
+func init() {
+	containerBrightnessValues = make(map[float64]ContainerBrightness, 2)
+	for _, v := range []ContainerBrightness{ContainerBrightnessZeroPointOne, ContainerBrightnessOne} {
+		containerBrightnessValues[float64(v)] = v
+	}
+}
+
+// IsValid reports whether e is one of the enum's values. NaN and infinite values are never valid.
+func (e ContainerBrightness) IsValid() bool {
+	if math.IsNaN(float64(e)) || math.IsInf(float64(e), 0) {
+		return false
+	}
+	_, ok := containerBrightnessValues[float64(e)]
+	return ok
+}
+
+// ParseContainerBrightness parses s as a ContainerBrightness. s must be a finite number equal to one of its values.
+func ParseContainerBrightness(s string) (ContainerBrightness, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ContainerBrightness value %q: %w", s, err)
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("invalid ContainerBrightness value %q: must be a finite number", s)
+	}
+	if e := ContainerBrightness(f); e.IsValid() {
+		return e, nil
+	}
+	return 0, fmt.Errorf("invalid ContainerBrightness value %q", s)
+}
+
 func (ContainerBrightness) ElementType() reflect.Type {
 	return reflect.TypeOf((*ContainerBrightness)(nil)).Elem()
 }
@@ -274,6 +710,11 @@ func (o ContainerBrightnessOutput) ToOutput(ctx context.Context) pulumix.Output[
 	}
 }
 
+// Ptr converts the output to a ContainerBrightnessPtrOutput. It is shorthand for ToContainerBrightnessPtrOutput.
+func (o ContainerBrightnessOutput) Ptr() ContainerBrightnessPtrOutput {
+	return o.ToContainerBrightnessPtrOutput()
+}
+
 func (o ContainerBrightnessOutput) ToFloat64Output() pulumi.Float64Output {
 	return o.ToFloat64OutputWithContext(context.Background())
 }
@@ -325,6 +766,16 @@ func (o ContainerBrightnessPtrOutput) Elem() ContainerBrightnessOutput {
 	}).(ContainerBrightnessOutput)
 }
 
+// ElemOrDefault is like Elem, but returns the given default rather than the zero value when the pointer is nil.
+func (o ContainerBrightnessPtrOutput) ElemOrDefault(def ContainerBrightness) ContainerBrightnessOutput {
+	return o.ApplyT(func(v *ContainerBrightness) ContainerBrightness {
+		if v != nil {
+			return *v
+		}
+		return def
+	}).(ContainerBrightnessOutput)
+}
+
 func (o ContainerBrightnessPtrOutput) ToFloat64PtrOutput() pulumi.Float64PtrOutput {
 	return o.ToFloat64PtrOutputWithContext(context.Background())
 }
@@ -339,6 +790,50 @@ func (o ContainerBrightnessPtrOutput) ToFloat64PtrOutputWithContext(ctx context.
 	}).(pulumi.Float64PtrOutput)
 }
 
+// Apply applies the given function to the value of the output, returning an output of the result.
+func (o ContainerBrightnessOutput) Apply(applier func(ContainerBrightness) interface{}) pulumi.AnyOutput {
+	return o.ApplyT(applier).(pulumi.AnyOutput)
+}
+
+// ApplyWithContext is like Apply, but also passes the given context to the function.
+func (o ContainerBrightnessOutput) ApplyWithContext(ctx context.Context, applier func(context.Context, ContainerBrightness) interface{}) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, applier).(pulumi.AnyOutput)
+}
+
+// Apply applies the given function to the value of the output, returning an output of the result.
+func (o ContainerBrightnessPtrOutput) Apply(applier func(*ContainerBrightness) interface{}) pulumi.AnyOutput {
+	return o.ApplyT(applier).(pulumi.AnyOutput)
+}
+
+// ApplyWithContext is like Apply, but also passes the given context to the function.
+func (o ContainerBrightnessPtrOutput) ApplyWithContext(ctx context.Context, applier func(context.Context, *ContainerBrightness) interface{}) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, applier).(pulumi.AnyOutput)
+}
+
+// ToAnyOutput converts the output to a pulumi.AnyOutput with the same value.
+func (o ContainerBrightnessOutput) ToAnyOutput() pulumi.AnyOutput {
+	return o.ToAnyOutputWithContext(context.Background())
+}
+
+// ToAnyOutputWithContext is like ToAnyOutput, but uses the given context.
+func (o ContainerBrightnessOutput) ToAnyOutputWithContext(ctx context.Context) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ContainerBrightness) interface{} {
+		return v
+	}).(pulumi.AnyOutput)
+}
+
+// ToAnyOutput converts the output to a pulumi.AnyOutput with the same value.
+func (o ContainerBrightnessPtrOutput) ToAnyOutput() pulumi.AnyOutput {
+	return o.ToAnyOutputWithContext(context.Background())
+}
+
+// ToAnyOutputWithContext is like ToAnyOutput, but uses the given context.
+func (o ContainerBrightnessPtrOutput) ToAnyOutputWithContext(ctx context.Context) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v *ContainerBrightness) interface{} {
+		return v
+	}).(pulumi.AnyOutput)
+}
+
 // ContainerBrightnessInput is an input type that accepts ContainerBrightnessArgs and ContainerBrightnessOutput values.
 // You can construct a concrete instance of `ContainerBrightnessInput` via:
 //
@@ -365,6 +860,24 @@ func ContainerBrightnessPtr(v float64) ContainerBrightnessPtrInput {
 	return (*containerBrightnessPtr)(&v)
 }
 
+// ContainerBrightnessPtrFromPtr returns a ContainerBrightnessPtrInput for the value that v points to. A nil pointer returns a nil input.
+func ContainerBrightnessPtrFromPtr(v *ContainerBrightness) ContainerBrightnessPtrInput {
+	if v == nil {
+		return nil
+	}
+	return ContainerBrightnessPtr(float64(*v))
+}
+
+// ContainerBrightnessZeroPointOnePtr returns a ContainerBrightnessPtrInput for ContainerBrightnessZeroPointOne.
+func ContainerBrightnessZeroPointOnePtr() ContainerBrightnessPtrInput {
+	return ContainerBrightnessPtr(float64(ContainerBrightnessZeroPointOne))
+}
+
+// ContainerBrightnessOnePtr returns a ContainerBrightnessPtrInput for ContainerBrightnessOne.
+func ContainerBrightnessOnePtr() ContainerBrightnessPtrInput {
+	return ContainerBrightnessPtr(float64(ContainerBrightnessOne))
+}
+
 func (*containerBrightnessPtr) ElementType() reflect.Type {
 	return containerBrightnessPtrType
 }
@@ -383,62 +896,303 @@ func (in *containerBrightnessPtr) ToOutput(ctx context.Context) pulumix.Output[*
 	}
 }
 
-// plant container colors
-type ContainerColor string
-
-const (
-	ContainerColorRed    = ContainerColor("red")
-	ContainerColorBlue   = ContainerColor("blue")
-	ContainerColorYellow = ContainerColor("yellow")
-)
+// ContainerBrightnessFromFloat64Input is an input of ContainerBrightness that is built from an input of the underlying float64 type.
+// Construct one with ContainerBrightnessFromFloat64.
+type ContainerBrightnessFromFloat64Input interface {
+	pulumi.Input
 
-func (ContainerColor) ElementType() reflect.Type {
-	return reflect.TypeOf((*ContainerColor)(nil)).Elem()
+	ToContainerBrightnessOutput() ContainerBrightnessOutput
+	ToContainerBrightnessOutputWithContext(context.Context) ContainerBrightnessOutput
+	ToContainerBrightnessPtrOutput() ContainerBrightnessPtrOutput
+	ToContainerBrightnessPtrOutputWithContext(context.Context) ContainerBrightnessPtrOutput
 }
 
-func (e ContainerColor) ToContainerColorOutput() ContainerColorOutput {
-	return pulumi.ToOutput(e).(ContainerColorOutput)
+type containerBrightnessFromFloat64 struct{ ContainerBrightnessOutput }
+
+// ContainerBrightnessFromFloat64 returns a ContainerBrightnessFromFloat64Input for an input of the underlying float64 type, such as a pulumi.Float64
+// or a pulumi.Float64Output. The value is not checked against the enum's declared values.
+func ContainerBrightnessFromFloat64(v pulumi.Float64Input) ContainerBrightnessFromFloat64Input {
+	return containerBrightnessFromFloat64{v.ToFloat64Output().ApplyT(func(v float64) ContainerBrightness {
+		return ContainerBrightness(v)
+	}).(ContainerBrightnessOutput)}
 }
 
-func (e ContainerColor) ToContainerColorOutputWithContext(ctx context.Context) ContainerColorOutput {
-	return pulumi.ToOutputWithContext(ctx, e).(ContainerColorOutput)
+// ContainerBrightnessSlice returns vals as a slice of ContainerBrightness.
+// Every value must be one of the enum's declared values; if any are not, the returned error
+// lists all of them.
+func ContainerBrightnessSlice(vals ...float64) ([]ContainerBrightness, error) {
+	result := make([]ContainerBrightness, len(vals))
+	var invalid []string
+	for i, v := range vals {
+		result[i] = ContainerBrightness(v)
+		if _, ok := containerBrightnessValues[v]; !ok {
+			invalid = append(invalid, fmt.Sprintf("%v at index %d", v, i))
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid ContainerBrightness values: %s", strings.Join(invalid, ", "))
+	}
+	return result, nil
 }
 
-func (e ContainerColor) ToContainerColorPtrOutput() ContainerColorPtrOutput {
-	return e.ToContainerColorPtrOutputWithContext(context.Background())
+// ContainerBrightnessArrayInput is an input type that accepts ContainerBrightnessArray and ContainerBrightnessArrayOutput values.
+// You can construct a concrete instance of `ContainerBrightnessArrayInput` via:
+//
+//	ContainerBrightnessArray{ ContainerBrightnessArgs{...} }
+type ContainerBrightnessArrayInput interface {
+	pulumi.Input
+
+	ToContainerBrightnessArrayOutput() ContainerBrightnessArrayOutput
+	ToContainerBrightnessArrayOutputWithContext(context.Context) ContainerBrightnessArrayOutput
 }
 
-func (e ContainerColor) ToContainerColorPtrOutputWithContext(ctx context.Context) ContainerColorPtrOutput {
-	return ContainerColor(e).ToContainerColorOutputWithContext(ctx).ToContainerColorPtrOutputWithContext(ctx)
+type ContainerBrightnessArray []ContainerBrightness
+
+func (ContainerBrightnessArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ContainerBrightness)(nil)).Elem()
 }
 
-func (e ContainerColor) ToStringOutput() pulumi.StringOutput {
-	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+func (i ContainerBrightnessArray) ToContainerBrightnessArrayOutput() ContainerBrightnessArrayOutput {
+	return i.ToContainerBrightnessArrayOutputWithContext(context.Background())
 }
 
-func (e ContainerColor) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
-	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+func (i ContainerBrightnessArray) ToContainerBrightnessArrayOutputWithContext(ctx context.Context) ContainerBrightnessArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ContainerBrightnessArrayOutput)
 }
 
-func (e ContainerColor) ToStringPtrOutput() pulumi.StringPtrOutput {
-	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+func (i ContainerBrightnessArray) ToOutput(ctx context.Context) pulumix.Output[[]ContainerBrightness] {
+	return pulumix.Output[[]ContainerBrightness]{
+		OutputState: i.ToContainerBrightnessArrayOutputWithContext(ctx).OutputState,
+	}
 }
 
-func (e ContainerColor) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
-	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+// ContainerBrightnessMapInput is an input type that accepts ContainerBrightnessMap and ContainerBrightnessMapOutput values.
+// You can construct a concrete instance of `ContainerBrightnessMapInput` via:
+//
+//	ContainerBrightnessMap{ "key": ContainerBrightnessArgs{...} }
+type ContainerBrightnessMapInput interface {
+	pulumi.Input
+
+	ToContainerBrightnessMapOutput() ContainerBrightnessMapOutput
+	ToContainerBrightnessMapOutputWithContext(context.Context) ContainerBrightnessMapOutput
 }
 
-type ContainerColorOutput struct{ *pulumi.OutputState }
+type ContainerBrightnessMap map[string]ContainerBrightness
 
-func (ContainerColorOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*ContainerColor)(nil)).Elem()
+func (ContainerBrightnessMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]ContainerBrightness)(nil)).Elem()
 }
 
-func (o ContainerColorOutput) ToContainerColorOutput() ContainerColorOutput {
-	return o
+func (i ContainerBrightnessMap) ToContainerBrightnessMapOutput() ContainerBrightnessMapOutput {
+	return i.ToContainerBrightnessMapOutputWithContext(context.Background())
 }
 
-func (o ContainerColorOutput) ToContainerColorOutputWithContext(ctx context.Context) ContainerColorOutput {
+func (i ContainerBrightnessMap) ToContainerBrightnessMapOutputWithContext(ctx context.Context) ContainerBrightnessMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ContainerBrightnessMapOutput)
+}
+
+func (i ContainerBrightnessMap) ToOutput(ctx context.Context) pulumix.Output[map[string]ContainerBrightness] {
+	return pulumix.Output[map[string]ContainerBrightness]{
+		OutputState: i.ToContainerBrightnessMapOutputWithContext(ctx).OutputState,
+	}
+}
+
+type ContainerBrightnessArrayOutput struct{ *pulumi.OutputState }
+
+func (ContainerBrightnessArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ContainerBrightness)(nil)).Elem()
+}
+
+func (o ContainerBrightnessArrayOutput) ToContainerBrightnessArrayOutput() ContainerBrightnessArrayOutput {
+	return o
+}
+
+func (o ContainerBrightnessArrayOutput) ToContainerBrightnessArrayOutputWithContext(ctx context.Context) ContainerBrightnessArrayOutput {
+	return o
+}
+
+func (o ContainerBrightnessArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]ContainerBrightness] {
+	return pulumix.Output[[]ContainerBrightness]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ContainerBrightnessArrayOutput) Index(i pulumi.IntInput) ContainerBrightnessOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ContainerBrightness {
+		return vs[0].([]ContainerBrightness)[vs[1].(int)]
+	}).(ContainerBrightnessOutput)
+}
+
+type ContainerBrightnessMapOutput struct{ *pulumi.OutputState }
+
+func (ContainerBrightnessMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]ContainerBrightness)(nil)).Elem()
+}
+
+func (o ContainerBrightnessMapOutput) ToContainerBrightnessMapOutput() ContainerBrightnessMapOutput {
+	return o
+}
+
+func (o ContainerBrightnessMapOutput) ToContainerBrightnessMapOutputWithContext(ctx context.Context) ContainerBrightnessMapOutput {
+	return o
+}
+
+func (o ContainerBrightnessMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]ContainerBrightness] {
+	return pulumix.Output[map[string]ContainerBrightness]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ContainerBrightnessMapOutput) MapIndex(k pulumi.StringInput) ContainerBrightnessOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) ContainerBrightness {
+		return vs[0].(map[string]ContainerBrightness)[vs[1].(string)]
+	}).(ContainerBrightnessOutput)
+}
+
+// plant container colors
+//
+// The zero value of ContainerColor is not a declared value; see ContainerColorUnset.
+type ContainerColor string
+
+const (
+	ContainerColorRed    = ContainerColor("red")
+	ContainerColorBlue   = ContainerColor("blue")
+	ContainerColorYellow = ContainerColor("yellow")
+)
+
+// ContainerColorUnset is the zero value of ContainerColor. It is not one of the enum's declared values.
+const ContainerColorUnset ContainerColor = ""
+
+// IsZero reports whether e is the zero value of ContainerColor.
+func (e ContainerColor) IsZero() bool {
+	return e == ContainerColorUnset
+}
+
+// ToWire returns e in the representation that the schema declares for ContainerColor, which is a string.
+func (e ContainerColor) ToWire() interface{} {
+	return string(e)
+}
+
+// Equals reports whether e and other are the same value of ContainerColor.
+func (e ContainerColor) Equals(other ContainerColor) bool {
+	return e == other
+}
+
+// ContainerColorAll lists every declared value of ContainerColor, in declaration order.
+var ContainerColorAll = []ContainerColor{ContainerColorRed, ContainerColorBlue, ContainerColorYellow}
+
+// ContainerColorMissing returns the declared values of ContainerColor that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over ContainerColor handles to check
+// that the switch is exhaustive.
+func ContainerColorMissing(handled ...ContainerColor) []ContainerColor {
+	var missing []ContainerColor
+	for _, v := range ContainerColorAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// ContainerColorMeta describes the ContainerColor enum and its values.
+var ContainerColorMeta = pulumi.EnumMeta{
+	Name: "ContainerColor",
+	Type: "plant::ContainerColor",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "ContainerColorRed", Value: ContainerColorRed},
+		{Name: "ContainerColorBlue", Value: ContainerColorBlue},
+		{Name: "ContainerColorYellow", Value: ContainerColorYellow},
+	},
+}
+
+// containerColorValues maps the underlying values of ContainerColor to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var containerColorValues map[string]ContainerColor
+
+func init() {
+	containerColorValues = make(map[string]ContainerColor, 3)
+	for _, v := range []ContainerColor{ContainerColorRed, ContainerColorBlue, ContainerColorYellow} {
+		containerColorValues[string(v)] = v
+	}
+}
+
+// ParseContainerColor parses s as a ContainerColor. s must exactly match one of the enum's values.
+func ParseContainerColor(s string) (ContainerColor, error) {
+	if v, ok := containerColorValues[s]; ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid ContainerColor value %q", s)
+}
+
+// ParseContainerColorLoose parses s as a ContainerColor, ignoring surrounding whitespace and case.
+// s may match either one of the enum's values or one of its declared names.
+func ParseContainerColorLoose(s string) (ContainerColor, error) {
+	s = strings.TrimSpace(s)
+	if v, ok := containerColorValues[s]; ok {
+		return v, nil
+	}
+	for _, v := range []ContainerColor{ContainerColorRed, ContainerColorBlue, ContainerColorYellow} {
+		if strings.EqualFold(string(v), s) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ContainerColor value %q", s)
+}
+
+func (ContainerColor) ElementType() reflect.Type {
+	return reflect.TypeOf((*ContainerColor)(nil)).Elem()
+}
+
+func (e ContainerColor) ToContainerColorOutput() ContainerColorOutput {
+	return pulumi.ToOutput(e).(ContainerColorOutput)
+}
+
+func (e ContainerColor) ToContainerColorOutputWithContext(ctx context.Context) ContainerColorOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(ContainerColorOutput)
+}
+
+func (e ContainerColor) ToContainerColorPtrOutput() ContainerColorPtrOutput {
+	return e.ToContainerColorPtrOutputWithContext(context.Background())
+}
+
+func (e ContainerColor) ToContainerColorPtrOutputWithContext(ctx context.Context) ContainerColorPtrOutput {
+	return ContainerColor(e).ToContainerColorOutputWithContext(ctx).ToContainerColorPtrOutputWithContext(ctx)
+}
+
+func (e ContainerColor) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e ContainerColor) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e ContainerColor) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e ContainerColor) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type ContainerColorOutput struct{ *pulumi.OutputState }
+
+func (ContainerColorOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ContainerColor)(nil)).Elem()
+}
+
+func (o ContainerColorOutput) ToContainerColorOutput() ContainerColorOutput {
+	return o
+}
+
+func (o ContainerColorOutput) ToContainerColorOutputWithContext(ctx context.Context) ContainerColorOutput {
 	return o
 }
 
@@ -458,6 +1212,11 @@ func (o ContainerColorOutput) ToOutput(ctx context.Context) pulumix.Output[Conta
 	}
 }
 
+// Ptr converts the output to a ContainerColorPtrOutput. It is shorthand for ToContainerColorPtrOutput.
+func (o ContainerColorOutput) Ptr() ContainerColorPtrOutput {
+	return o.ToContainerColorPtrOutput()
+}
+
 func (o ContainerColorOutput) ToStringOutput() pulumi.StringOutput {
 	return o.ToStringOutputWithContext(context.Background())
 }
@@ -509,6 +1268,16 @@ func (o ContainerColorPtrOutput) Elem() ContainerColorOutput {
 	}).(ContainerColorOutput)
 }
 
+// ElemOrDefault is like Elem, but returns the given default rather than the zero value when the pointer is nil.
+func (o ContainerColorPtrOutput) ElemOrDefault(def ContainerColor) ContainerColorOutput {
+	return o.ApplyT(func(v *ContainerColor) ContainerColor {
+		if v != nil {
+			return *v
+		}
+		return def
+	}).(ContainerColorOutput)
+}
+
 func (o ContainerColorPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
 	return o.ToStringPtrOutputWithContext(context.Background())
 }
@@ -523,6 +1292,50 @@ func (o ContainerColorPtrOutput) ToStringPtrOutputWithContext(ctx context.Contex
 	}).(pulumi.StringPtrOutput)
 }
 
+// Apply applies the given function to the value of the output, returning an output of the result.
+func (o ContainerColorOutput) Apply(applier func(ContainerColor) interface{}) pulumi.AnyOutput {
+	return o.ApplyT(applier).(pulumi.AnyOutput)
+}
+
+// ApplyWithContext is like Apply, but also passes the given context to the function.
+func (o ContainerColorOutput) ApplyWithContext(ctx context.Context, applier func(context.Context, ContainerColor) interface{}) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, applier).(pulumi.AnyOutput)
+}
+
+// Apply applies the given function to the value of the output, returning an output of the result.
+func (o ContainerColorPtrOutput) Apply(applier func(*ContainerColor) interface{}) pulumi.AnyOutput {
+	return o.ApplyT(applier).(pulumi.AnyOutput)
+}
+
+// ApplyWithContext is like Apply, but also passes the given context to the function.
+func (o ContainerColorPtrOutput) ApplyWithContext(ctx context.Context, applier func(context.Context, *ContainerColor) interface{}) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, applier).(pulumi.AnyOutput)
+}
+
+// ToAnyOutput converts the output to a pulumi.AnyOutput with the same value.
+func (o ContainerColorOutput) ToAnyOutput() pulumi.AnyOutput {
+	return o.ToAnyOutputWithContext(context.Background())
+}
+
+// ToAnyOutputWithContext is like ToAnyOutput, but uses the given context.
+func (o ContainerColorOutput) ToAnyOutputWithContext(ctx context.Context) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ContainerColor) interface{} {
+		return v
+	}).(pulumi.AnyOutput)
+}
+
+// ToAnyOutput converts the output to a pulumi.AnyOutput with the same value.
+func (o ContainerColorPtrOutput) ToAnyOutput() pulumi.AnyOutput {
+	return o.ToAnyOutputWithContext(context.Background())
+}
+
+// ToAnyOutputWithContext is like ToAnyOutput, but uses the given context.
+func (o ContainerColorPtrOutput) ToAnyOutputWithContext(ctx context.Context) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v *ContainerColor) interface{} {
+		return v
+	}).(pulumi.AnyOutput)
+}
+
 // ContainerColorInput is an input type that accepts ContainerColorArgs and ContainerColorOutput values.
 // You can construct a concrete instance of `ContainerColorInput` via:
 //
@@ -549,6 +1362,14 @@ func ContainerColorPtr(v string) ContainerColorPtrInput {
 	return (*containerColorPtr)(&v)
 }
 
+// ContainerColorPtrFromPtr returns a ContainerColorPtrInput for the value that v points to. A nil pointer returns a nil input.
+func ContainerColorPtrFromPtr(v *ContainerColor) ContainerColorPtrInput {
+	if v == nil {
+		return nil
+	}
+	return ContainerColorPtr(string(*v))
+}
+
 func (*containerColorPtr) ElementType() reflect.Type {
 	return containerColorPtrType
 }
@@ -567,7 +1388,162 @@ func (in *containerColorPtr) ToOutput(ctx context.Context) pulumix.Output[*Conta
 	}
 }
 
+// ContainerColorFromStringInput is an input of ContainerColor that is built from an input of the underlying string type.
+// Construct one with ContainerColorFromString.
+type ContainerColorFromStringInput interface {
+	pulumi.Input
+
+	ToContainerColorOutput() ContainerColorOutput
+	ToContainerColorOutputWithContext(context.Context) ContainerColorOutput
+	ToContainerColorPtrOutput() ContainerColorPtrOutput
+	ToContainerColorPtrOutputWithContext(context.Context) ContainerColorPtrOutput
+}
+
+type containerColorFromString struct{ ContainerColorOutput }
+
+// ContainerColorFromString returns a ContainerColorFromStringInput for an input of the underlying string type, such as a pulumi.String
+// or a pulumi.StringOutput. The value is not checked against the enum's declared values.
+func ContainerColorFromString(v pulumi.StringInput) ContainerColorFromStringInput {
+	return containerColorFromString{v.ToStringOutput().ApplyT(func(v string) ContainerColor {
+		return ContainerColor(v)
+	}).(ContainerColorOutput)}
+}
+
+// ContainerColorSlice returns vals as a slice of ContainerColor.
+// Every value must be one of the enum's declared values; if any are not, the returned error
+// lists all of them.
+func ContainerColorSlice(vals ...string) ([]ContainerColor, error) {
+	result := make([]ContainerColor, len(vals))
+	var invalid []string
+	for i, v := range vals {
+		result[i] = ContainerColor(v)
+		if _, ok := containerColorValues[v]; !ok {
+			invalid = append(invalid, fmt.Sprintf("%q at index %d", v, i))
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid ContainerColor values: %s", strings.Join(invalid, ", "))
+	}
+	return result, nil
+}
+
+// ContainerColorArrayInput is an input type that accepts ContainerColorArray and ContainerColorArrayOutput values.
+// You can construct a concrete instance of `ContainerColorArrayInput` via:
+//
+//	ContainerColorArray{ ContainerColorArgs{...} }
+type ContainerColorArrayInput interface {
+	pulumi.Input
+
+	ToContainerColorArrayOutput() ContainerColorArrayOutput
+	ToContainerColorArrayOutputWithContext(context.Context) ContainerColorArrayOutput
+}
+
+type ContainerColorArray []ContainerColor
+
+func (ContainerColorArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ContainerColor)(nil)).Elem()
+}
+
+func (i ContainerColorArray) ToContainerColorArrayOutput() ContainerColorArrayOutput {
+	return i.ToContainerColorArrayOutputWithContext(context.Background())
+}
+
+func (i ContainerColorArray) ToContainerColorArrayOutputWithContext(ctx context.Context) ContainerColorArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ContainerColorArrayOutput)
+}
+
+func (i ContainerColorArray) ToOutput(ctx context.Context) pulumix.Output[[]ContainerColor] {
+	return pulumix.Output[[]ContainerColor]{
+		OutputState: i.ToContainerColorArrayOutputWithContext(ctx).OutputState,
+	}
+}
+
+// ContainerColorMapInput is an input type that accepts ContainerColorMap and ContainerColorMapOutput values.
+// You can construct a concrete instance of `ContainerColorMapInput` via:
+//
+//	ContainerColorMap{ "key": ContainerColorArgs{...} }
+type ContainerColorMapInput interface {
+	pulumi.Input
+
+	ToContainerColorMapOutput() ContainerColorMapOutput
+	ToContainerColorMapOutputWithContext(context.Context) ContainerColorMapOutput
+}
+
+type ContainerColorMap map[string]ContainerColor
+
+func (ContainerColorMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]ContainerColor)(nil)).Elem()
+}
+
+func (i ContainerColorMap) ToContainerColorMapOutput() ContainerColorMapOutput {
+	return i.ToContainerColorMapOutputWithContext(context.Background())
+}
+
+func (i ContainerColorMap) ToContainerColorMapOutputWithContext(ctx context.Context) ContainerColorMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ContainerColorMapOutput)
+}
+
+func (i ContainerColorMap) ToOutput(ctx context.Context) pulumix.Output[map[string]ContainerColor] {
+	return pulumix.Output[map[string]ContainerColor]{
+		OutputState: i.ToContainerColorMapOutputWithContext(ctx).OutputState,
+	}
+}
+
+type ContainerColorArrayOutput struct{ *pulumi.OutputState }
+
+func (ContainerColorArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ContainerColor)(nil)).Elem()
+}
+
+func (o ContainerColorArrayOutput) ToContainerColorArrayOutput() ContainerColorArrayOutput {
+	return o
+}
+
+func (o ContainerColorArrayOutput) ToContainerColorArrayOutputWithContext(ctx context.Context) ContainerColorArrayOutput {
+	return o
+}
+
+func (o ContainerColorArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]ContainerColor] {
+	return pulumix.Output[[]ContainerColor]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ContainerColorArrayOutput) Index(i pulumi.IntInput) ContainerColorOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ContainerColor {
+		return vs[0].([]ContainerColor)[vs[1].(int)]
+	}).(ContainerColorOutput)
+}
+
+type ContainerColorMapOutput struct{ *pulumi.OutputState }
+
+func (ContainerColorMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]ContainerColor)(nil)).Elem()
+}
+
+func (o ContainerColorMapOutput) ToContainerColorMapOutput() ContainerColorMapOutput {
+	return o
+}
+
+func (o ContainerColorMapOutput) ToContainerColorMapOutputWithContext(ctx context.Context) ContainerColorMapOutput {
+	return o
+}
+
+func (o ContainerColorMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]ContainerColor] {
+	return pulumix.Output[map[string]ContainerColor]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ContainerColorMapOutput) MapIndex(k pulumi.StringInput) ContainerColorOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) ContainerColor {
+		return vs[0].(map[string]ContainerColor)[vs[1].(string)]
+	}).(ContainerColorOutput)
+}
+
 // plant container sizes
+//
+// The zero value of ContainerSize is not a declared value; see ContainerSizeUnset.
 type ContainerSize int
 
 const (
@@ -577,6 +1553,58 @@ const (
 	ContainerSizeEightInch = ContainerSize(8)
 )
 
+// ContainerSizeUnset is the zero value of ContainerSize. It is not one of the enum's declared values.
+const ContainerSizeUnset ContainerSize = 0
+
+// IsZero reports whether e is the zero value of ContainerSize.
+func (e ContainerSize) IsZero() bool {
+	return e == ContainerSizeUnset
+}
+
+// ToWire returns e in the representation that the schema declares for ContainerSize, which is a int.
+func (e ContainerSize) ToWire() interface{} {
+	return int(e)
+}
+
+// Equals reports whether e and other are the same value of ContainerSize.
+func (e ContainerSize) Equals(other ContainerSize) bool {
+	return e == other
+}
+
+// ContainerSizeAll lists every declared value of ContainerSize, in declaration order.
+var ContainerSizeAll = []ContainerSize{ContainerSizeFourInch, ContainerSizeSixInch, ContainerSizeEightInch}
+
+// ContainerSizeMissing returns the declared values of ContainerSize that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over ContainerSize handles to check
+// that the switch is exhaustive.
+func ContainerSizeMissing(handled ...ContainerSize) []ContainerSize {
+	var missing []ContainerSize
+	for _, v := range ContainerSizeAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// ContainerSizeMeta describes the ContainerSize enum and its values.
+var ContainerSizeMeta = pulumi.EnumMeta{
+	Name: "ContainerSize",
+	Type: "plant::ContainerSize",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "ContainerSizeFourInch", Value: ContainerSizeFourInch},
+		{Name: "ContainerSizeSixInch", Value: ContainerSizeSixInch},
+		{Name: "ContainerSizeEightInch", Value: ContainerSizeEightInch, Deprecated: "Eight inch pots are no longer supported."},
+	},
+}
+
 func (ContainerSize) ElementType() reflect.Type {
 	return reflect.TypeOf((*ContainerSize)(nil)).Elem()
 }
@@ -643,6 +1671,11 @@ func (o ContainerSizeOutput) ToOutput(ctx context.Context) pulumix.Output[Contai
 	}
 }
 
+// Ptr converts the output to a ContainerSizePtrOutput. It is shorthand for ToContainerSizePtrOutput.
+func (o ContainerSizeOutput) Ptr() ContainerSizePtrOutput {
+	return o.ToContainerSizePtrOutput()
+}
+
 func (o ContainerSizeOutput) ToIntOutput() pulumi.IntOutput {
 	return o.ToIntOutputWithContext(context.Background())
 }
@@ -694,6 +1727,16 @@ func (o ContainerSizePtrOutput) Elem() ContainerSizeOutput {
 	}).(ContainerSizeOutput)
 }
 
+// ElemOrDefault is like Elem, but returns the given default rather than the zero value when the pointer is nil.
+func (o ContainerSizePtrOutput) ElemOrDefault(def ContainerSize) ContainerSizeOutput {
+	return o.ApplyT(func(v *ContainerSize) ContainerSize {
+		if v != nil {
+			return *v
+		}
+		return def
+	}).(ContainerSizeOutput)
+}
+
 func (o ContainerSizePtrOutput) ToIntPtrOutput() pulumi.IntPtrOutput {
 	return o.ToIntPtrOutputWithContext(context.Background())
 }
@@ -708,6 +1751,50 @@ func (o ContainerSizePtrOutput) ToIntPtrOutputWithContext(ctx context.Context) p
 	}).(pulumi.IntPtrOutput)
 }
 
+// Apply applies the given function to the value of the output, returning an output of the result.
+func (o ContainerSizeOutput) Apply(applier func(ContainerSize) interface{}) pulumi.AnyOutput {
+	return o.ApplyT(applier).(pulumi.AnyOutput)
+}
+
+// ApplyWithContext is like Apply, but also passes the given context to the function.
+func (o ContainerSizeOutput) ApplyWithContext(ctx context.Context, applier func(context.Context, ContainerSize) interface{}) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, applier).(pulumi.AnyOutput)
+}
+
+// Apply applies the given function to the value of the output, returning an output of the result.
+func (o ContainerSizePtrOutput) Apply(applier func(*ContainerSize) interface{}) pulumi.AnyOutput {
+	return o.ApplyT(applier).(pulumi.AnyOutput)
+}
+
+// ApplyWithContext is like Apply, but also passes the given context to the function.
+func (o ContainerSizePtrOutput) ApplyWithContext(ctx context.Context, applier func(context.Context, *ContainerSize) interface{}) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, applier).(pulumi.AnyOutput)
+}
+
+// ToAnyOutput converts the output to a pulumi.AnyOutput with the same value.
+func (o ContainerSizeOutput) ToAnyOutput() pulumi.AnyOutput {
+	return o.ToAnyOutputWithContext(context.Background())
+}
+
+// ToAnyOutputWithContext is like ToAnyOutput, but uses the given context.
+func (o ContainerSizeOutput) ToAnyOutputWithContext(ctx context.Context) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ContainerSize) interface{} {
+		return v
+	}).(pulumi.AnyOutput)
+}
+
+// ToAnyOutput converts the output to a pulumi.AnyOutput with the same value.
+func (o ContainerSizePtrOutput) ToAnyOutput() pulumi.AnyOutput {
+	return o.ToAnyOutputWithContext(context.Background())
+}
+
+// ToAnyOutputWithContext is like ToAnyOutput, but uses the given context.
+func (o ContainerSizePtrOutput) ToAnyOutputWithContext(ctx context.Context) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v *ContainerSize) interface{} {
+		return v
+	}).(pulumi.AnyOutput)
+}
+
 // ContainerSizeInput is an input type that accepts ContainerSizeArgs and ContainerSizeOutput values.
 // You can construct a concrete instance of `ContainerSizeInput` via:
 //
@@ -734,6 +1821,31 @@ func ContainerSizePtr(v int) ContainerSizePtrInput {
 	return (*containerSizePtr)(&v)
 }
 
+// ContainerSizePtrFromPtr returns a ContainerSizePtrInput for the value that v points to. A nil pointer returns a nil input.
+func ContainerSizePtrFromPtr(v *ContainerSize) ContainerSizePtrInput {
+	if v == nil {
+		return nil
+	}
+	return ContainerSizePtr(int(*v))
+}
+
+// ContainerSizeFourInchPtr returns a ContainerSizePtrInput for ContainerSizeFourInch.
+func ContainerSizeFourInchPtr() ContainerSizePtrInput {
+	return ContainerSizePtr(int(ContainerSizeFourInch))
+}
+
+// ContainerSizeSixInchPtr returns a ContainerSizePtrInput for ContainerSizeSixInch.
+func ContainerSizeSixInchPtr() ContainerSizePtrInput {
+	return ContainerSizePtr(int(ContainerSizeSixInch))
+}
+
+// ContainerSizeEightInchPtr returns a ContainerSizePtrInput for ContainerSizeEightInch.
+//
+// Deprecated: Eight inch pots are no longer supported.
+func ContainerSizeEightInchPtr() ContainerSizePtrInput {
+	return ContainerSizePtr(int(ContainerSizeEightInch))
+}
+
 func (*containerSizePtr) ElementType() reflect.Type {
 	return containerSizePtrType
 }
@@ -752,21 +1864,205 @@ func (in *containerSizePtr) ToOutput(ctx context.Context) pulumix.Output[*Contai
 	}
 }
 
+// ContainerSizeFromIntInput is an input of ContainerSize that is built from an input of the underlying int type.
+// Construct one with ContainerSizeFromInt.
+type ContainerSizeFromIntInput interface {
+	pulumi.Input
+
+	ToContainerSizeOutput() ContainerSizeOutput
+	ToContainerSizeOutputWithContext(context.Context) ContainerSizeOutput
+	ToContainerSizePtrOutput() ContainerSizePtrOutput
+	ToContainerSizePtrOutputWithContext(context.Context) ContainerSizePtrOutput
+}
+
+type containerSizeFromInt struct{ ContainerSizeOutput }
+
+// ContainerSizeFromInt returns a ContainerSizeFromIntInput for an input of the underlying int type, such as a pulumi.Int
+// or a pulumi.IntOutput. The value is not checked against the enum's declared values.
+func ContainerSizeFromInt(v pulumi.IntInput) ContainerSizeFromIntInput {
+	return containerSizeFromInt{v.ToIntOutput().ApplyT(func(v int) ContainerSize {
+		return ContainerSize(v)
+	}).(ContainerSizeOutput)}
+}
+
+// containerSizeValues maps the underlying values of ContainerSize to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var containerSizeValues map[int]ContainerSize
+
+func init() {
+	containerSizeValues = make(map[int]ContainerSize, 3)
+	for _, v := range []ContainerSize{ContainerSizeFourInch, ContainerSizeSixInch, ContainerSizeEightInch} {
+		containerSizeValues[int(v)] = v
+	}
+}
+
+// ContainerSizeSlice returns vals as a slice of ContainerSize.
+// Every value must be one of the enum's declared values; if any are not, the returned error
+// lists all of them.
+func ContainerSizeSlice(vals ...int) ([]ContainerSize, error) {
+	result := make([]ContainerSize, len(vals))
+	var invalid []string
+	for i, v := range vals {
+		result[i] = ContainerSize(v)
+		if _, ok := containerSizeValues[v]; !ok {
+			invalid = append(invalid, fmt.Sprintf("%v at index %d", v, i))
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid ContainerSize values: %s", strings.Join(invalid, ", "))
+	}
+	return result, nil
+}
+
+// ContainerSizeArrayInput is an input type that accepts ContainerSizeArray and ContainerSizeArrayOutput values.
+// You can construct a concrete instance of `ContainerSizeArrayInput` via:
+//
+//	ContainerSizeArray{ ContainerSizeArgs{...} }
+type ContainerSizeArrayInput interface {
+	pulumi.Input
+
+	ToContainerSizeArrayOutput() ContainerSizeArrayOutput
+	ToContainerSizeArrayOutputWithContext(context.Context) ContainerSizeArrayOutput
+}
+
+type ContainerSizeArray []ContainerSize
+
+func (ContainerSizeArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ContainerSize)(nil)).Elem()
+}
+
+func (i ContainerSizeArray) ToContainerSizeArrayOutput() ContainerSizeArrayOutput {
+	return i.ToContainerSizeArrayOutputWithContext(context.Background())
+}
+
+func (i ContainerSizeArray) ToContainerSizeArrayOutputWithContext(ctx context.Context) ContainerSizeArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ContainerSizeArrayOutput)
+}
+
+func (i ContainerSizeArray) ToOutput(ctx context.Context) pulumix.Output[[]ContainerSize] {
+	return pulumix.Output[[]ContainerSize]{
+		OutputState: i.ToContainerSizeArrayOutputWithContext(ctx).OutputState,
+	}
+}
+
+// ContainerSizeMapInput is an input type that accepts ContainerSizeMap and ContainerSizeMapOutput values.
+// You can construct a concrete instance of `ContainerSizeMapInput` via:
+//
+//	ContainerSizeMap{ "key": ContainerSizeArgs{...} }
+type ContainerSizeMapInput interface {
+	pulumi.Input
+
+	ToContainerSizeMapOutput() ContainerSizeMapOutput
+	ToContainerSizeMapOutputWithContext(context.Context) ContainerSizeMapOutput
+}
+
+type ContainerSizeMap map[string]ContainerSize
+
+func (ContainerSizeMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]ContainerSize)(nil)).Elem()
+}
+
+func (i ContainerSizeMap) ToContainerSizeMapOutput() ContainerSizeMapOutput {
+	return i.ToContainerSizeMapOutputWithContext(context.Background())
+}
+
+func (i ContainerSizeMap) ToContainerSizeMapOutputWithContext(ctx context.Context) ContainerSizeMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ContainerSizeMapOutput)
+}
+
+func (i ContainerSizeMap) ToOutput(ctx context.Context) pulumix.Output[map[string]ContainerSize] {
+	return pulumix.Output[map[string]ContainerSize]{
+		OutputState: i.ToContainerSizeMapOutputWithContext(ctx).OutputState,
+	}
+}
+
+type ContainerSizeArrayOutput struct{ *pulumi.OutputState }
+
+func (ContainerSizeArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ContainerSize)(nil)).Elem()
+}
+
+func (o ContainerSizeArrayOutput) ToContainerSizeArrayOutput() ContainerSizeArrayOutput {
+	return o
+}
+
+func (o ContainerSizeArrayOutput) ToContainerSizeArrayOutputWithContext(ctx context.Context) ContainerSizeArrayOutput {
+	return o
+}
+
+func (o ContainerSizeArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]ContainerSize] {
+	return pulumix.Output[[]ContainerSize]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ContainerSizeArrayOutput) Index(i pulumi.IntInput) ContainerSizeOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ContainerSize {
+		return vs[0].([]ContainerSize)[vs[1].(int)]
+	}).(ContainerSizeOutput)
+}
+
+type ContainerSizeMapOutput struct{ *pulumi.OutputState }
+
+func (ContainerSizeMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]ContainerSize)(nil)).Elem()
+}
+
+func (o ContainerSizeMapOutput) ToContainerSizeMapOutput() ContainerSizeMapOutput {
+	return o
+}
+
+func (o ContainerSizeMapOutput) ToContainerSizeMapOutputWithContext(ctx context.Context) ContainerSizeMapOutput {
+	return o
+}
+
+func (o ContainerSizeMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]ContainerSize] {
+	return pulumix.Output[map[string]ContainerSize]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ContainerSizeMapOutput) MapIndex(k pulumi.StringInput) ContainerSizeOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) ContainerSize {
+		return vs[0].(map[string]ContainerSize)[vs[1].(string)]
+	}).(ContainerSizeOutput)
+}
+
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*CloudAuditOptionsLogNameInput)(nil)).Elem(), CloudAuditOptionsLogName("UNSPECIFIED_LOG_NAME"))
 	pulumi.RegisterInputType(reflect.TypeOf((*CloudAuditOptionsLogNamePtrInput)(nil)).Elem(), CloudAuditOptionsLogName("UNSPECIFIED_LOG_NAME"))
+	pulumi.RegisterInputType(reflect.TypeOf((*CloudAuditOptionsLogNameFromStringInput)(nil)).Elem(), cloudAuditOptionsLogNameFromString{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CloudAuditOptionsLogNameArrayInput)(nil)).Elem(), CloudAuditOptionsLogNameArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CloudAuditOptionsLogNameMapInput)(nil)).Elem(), CloudAuditOptionsLogNameMap{})
 	pulumi.RegisterInputType(reflect.TypeOf((*ContainerBrightnessInput)(nil)).Elem(), ContainerBrightness(0.1))
 	pulumi.RegisterInputType(reflect.TypeOf((*ContainerBrightnessPtrInput)(nil)).Elem(), ContainerBrightness(0.1))
+	pulumi.RegisterInputType(reflect.TypeOf((*ContainerBrightnessFromFloat64Input)(nil)).Elem(), containerBrightnessFromFloat64{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ContainerBrightnessArrayInput)(nil)).Elem(), ContainerBrightnessArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ContainerBrightnessMapInput)(nil)).Elem(), ContainerBrightnessMap{})
 	pulumi.RegisterInputType(reflect.TypeOf((*ContainerColorInput)(nil)).Elem(), ContainerColor("red"))
 	pulumi.RegisterInputType(reflect.TypeOf((*ContainerColorPtrInput)(nil)).Elem(), ContainerColor("red"))
+	pulumi.RegisterInputType(reflect.TypeOf((*ContainerColorFromStringInput)(nil)).Elem(), containerColorFromString{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ContainerColorArrayInput)(nil)).Elem(), ContainerColorArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ContainerColorMapInput)(nil)).Elem(), ContainerColorMap{})
 	pulumi.RegisterInputType(reflect.TypeOf((*ContainerSizeInput)(nil)).Elem(), ContainerSize(4))
 	pulumi.RegisterInputType(reflect.TypeOf((*ContainerSizePtrInput)(nil)).Elem(), ContainerSize(4))
+	pulumi.RegisterInputType(reflect.TypeOf((*ContainerSizeFromIntInput)(nil)).Elem(), containerSizeFromInt{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ContainerSizeArrayInput)(nil)).Elem(), ContainerSizeArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ContainerSizeMapInput)(nil)).Elem(), ContainerSizeMap{})
 	pulumi.RegisterOutputType(CloudAuditOptionsLogNameOutput{})
 	pulumi.RegisterOutputType(CloudAuditOptionsLogNamePtrOutput{})
+	pulumi.RegisterOutputType(CloudAuditOptionsLogNameArrayOutput{})
+	pulumi.RegisterOutputType(CloudAuditOptionsLogNameMapOutput{})
 	pulumi.RegisterOutputType(ContainerBrightnessOutput{})
 	pulumi.RegisterOutputType(ContainerBrightnessPtrOutput{})
+	pulumi.RegisterOutputType(ContainerBrightnessArrayOutput{})
+	pulumi.RegisterOutputType(ContainerBrightnessMapOutput{})
 	pulumi.RegisterOutputType(ContainerColorOutput{})
 	pulumi.RegisterOutputType(ContainerColorPtrOutput{})
+	pulumi.RegisterOutputType(ContainerColorArrayOutput{})
+	pulumi.RegisterOutputType(ContainerColorMapOutput{})
 	pulumi.RegisterOutputType(ContainerSizeOutput{})
 	pulumi.RegisterOutputType(ContainerSizePtrOutput{})
+	pulumi.RegisterOutputType(ContainerSizeArrayOutput{})
+	pulumi.RegisterOutputType(ContainerSizeMapOutput{})
 }
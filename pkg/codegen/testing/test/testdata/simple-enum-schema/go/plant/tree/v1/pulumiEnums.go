@@ -5,12 +5,17 @@ package v1
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 )
 
+// The zero value of Diameter is not a declared value; see DiameterUnset.
 type Diameter float64
 
 const (
@@ -18,6 +23,98 @@ const (
 	DiameterTwelveinch = Diameter(12)
 )
 
+// DiameterDefault is the default value of properties of type Diameter.
+const DiameterDefault = DiameterSixinch
+
+// DiameterUnset is the zero value of Diameter. It is not one of the enum's declared values.
+const DiameterUnset Diameter = 0
+
+// IsZero reports whether e is the zero value of Diameter.
+func (e Diameter) IsZero() bool {
+	return e == DiameterUnset
+}
+
+// ToWire returns e in the representation that the schema declares for Diameter, which is a float64.
+func (e Diameter) ToWire() interface{} {
+	return float64(e)
+}
+
+// Equals reports whether e and other are the same value of Diameter. The values are compared within 0.1,
+// which is finer than the precision of the enum's declared values, so that values that have passed
+// through JSON compare equal to the declared values they represent.
+func (e Diameter) Equals(other Diameter) bool {
+	d := float64(e - other)
+	return d <= 0.1 && d >= -0.1
+}
+
+// DiameterAll lists every declared value of Diameter, in declaration order.
+var DiameterAll = []Diameter{DiameterSixinch, DiameterTwelveinch}
+
+// DiameterMissing returns the declared values of Diameter that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over Diameter handles to check
+// that the switch is exhaustive.
+func DiameterMissing(handled ...Diameter) []Diameter {
+	var missing []Diameter
+	for _, v := range DiameterAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// DiameterMeta describes the Diameter enum and its values.
+var DiameterMeta = pulumi.EnumMeta{
+	Name: "Diameter",
+	Type: "plant:tree/v1:Diameter",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "DiameterSixinch", Value: DiameterSixinch},
+		{Name: "DiameterTwelveinch", Value: DiameterTwelveinch},
+	},
+}
+
+// diameterValues maps the underlying values of Diameter to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var diameterValues map[float64]Diameter
+
+func init() {
+	diameterValues = make(map[float64]Diameter, 2)
+	for _, v := range []Diameter{DiameterSixinch, DiameterTwelveinch} {
+		diameterValues[float64(v)] = v
+	}
+}
+
+// IsValid reports whether e is one of the enum's values. NaN and infinite values are never valid.
+func (e Diameter) IsValid() bool {
+	if math.IsNaN(float64(e)) || math.IsInf(float64(e), 0) {
+		return false
+	}
+	_, ok := diameterValues[float64(e)]
+	return ok
+}
+
+// ParseDiameter parses s as a Diameter. s must be a finite number equal to one of its values.
+func ParseDiameter(s string) (Diameter, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid Diameter value %q: %w", s, err)
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("invalid Diameter value %q: must be a finite number", s)
+	}
+	if e := Diameter(f); e.IsValid() {
+		return e, nil
+	}
+	return 0, fmt.Errorf("invalid Diameter value %q", s)
+}
+
 func (Diameter) ElementType() reflect.Type {
 	return reflect.TypeOf((*Diameter)(nil)).Elem()
 }
@@ -84,6 +181,11 @@ func (o DiameterOutput) ToOutput(ctx context.Context) pulumix.Output[Diameter] {
 	}
 }
 
+// Ptr converts the output to a DiameterPtrOutput. It is shorthand for ToDiameterPtrOutput.
+func (o DiameterOutput) Ptr() DiameterPtrOutput {
+	return o.ToDiameterPtrOutput()
+}
+
 func (o DiameterOutput) ToFloat64Output() pulumi.Float64Output {
 	return o.ToFloat64OutputWithContext(context.Background())
 }
@@ -135,6 +237,16 @@ func (o DiameterPtrOutput) Elem() DiameterOutput {
 	}).(DiameterOutput)
 }
 
+// ElemOrDefault is like Elem, but returns the given default rather than the zero value when the pointer is nil.
+func (o DiameterPtrOutput) ElemOrDefault(def Diameter) DiameterOutput {
+	return o.ApplyT(func(v *Diameter) Diameter {
+		if v != nil {
+			return *v
+		}
+		return def
+	}).(DiameterOutput)
+}
+
 func (o DiameterPtrOutput) ToFloat64PtrOutput() pulumi.Float64PtrOutput {
 	return o.ToFloat64PtrOutputWithContext(context.Background())
 }
@@ -149,6 +261,50 @@ func (o DiameterPtrOutput) ToFloat64PtrOutputWithContext(ctx context.Context) pu
 	}).(pulumi.Float64PtrOutput)
 }
 
+// Apply applies the given function to the value of the output, returning an output of the result.
+func (o DiameterOutput) Apply(applier func(Diameter) interface{}) pulumi.AnyOutput {
+	return o.ApplyT(applier).(pulumi.AnyOutput)
+}
+
+// ApplyWithContext is like Apply, but also passes the given context to the function.
+func (o DiameterOutput) ApplyWithContext(ctx context.Context, applier func(context.Context, Diameter) interface{}) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, applier).(pulumi.AnyOutput)
+}
+
+// Apply applies the given function to the value of the output, returning an output of the result.
+func (o DiameterPtrOutput) Apply(applier func(*Diameter) interface{}) pulumi.AnyOutput {
+	return o.ApplyT(applier).(pulumi.AnyOutput)
+}
+
+// ApplyWithContext is like Apply, but also passes the given context to the function.
+func (o DiameterPtrOutput) ApplyWithContext(ctx context.Context, applier func(context.Context, *Diameter) interface{}) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, applier).(pulumi.AnyOutput)
+}
+
+// ToAnyOutput converts the output to a pulumi.AnyOutput with the same value.
+func (o DiameterOutput) ToAnyOutput() pulumi.AnyOutput {
+	return o.ToAnyOutputWithContext(context.Background())
+}
+
+// ToAnyOutputWithContext is like ToAnyOutput, but uses the given context.
+func (o DiameterOutput) ToAnyOutputWithContext(ctx context.Context) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Diameter) interface{} {
+		return v
+	}).(pulumi.AnyOutput)
+}
+
+// ToAnyOutput converts the output to a pulumi.AnyOutput with the same value.
+func (o DiameterPtrOutput) ToAnyOutput() pulumi.AnyOutput {
+	return o.ToAnyOutputWithContext(context.Background())
+}
+
+// ToAnyOutputWithContext is like ToAnyOutput, but uses the given context.
+func (o DiameterPtrOutput) ToAnyOutputWithContext(ctx context.Context) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v *Diameter) interface{} {
+		return v
+	}).(pulumi.AnyOutput)
+}
+
 // DiameterInput is an input type that accepts DiameterArgs and DiameterOutput values.
 // You can construct a concrete instance of `DiameterInput` via:
 //
@@ -175,6 +331,24 @@ func DiameterPtr(v float64) DiameterPtrInput {
 	return (*diameterPtr)(&v)
 }
 
+// DiameterPtrFromPtr returns a DiameterPtrInput for the value that v points to. A nil pointer returns a nil input.
+func DiameterPtrFromPtr(v *Diameter) DiameterPtrInput {
+	if v == nil {
+		return nil
+	}
+	return DiameterPtr(float64(*v))
+}
+
+// DiameterSixinchPtr returns a DiameterPtrInput for DiameterSixinch.
+func DiameterSixinchPtr() DiameterPtrInput {
+	return DiameterPtr(float64(DiameterSixinch))
+}
+
+// DiameterTwelveinchPtr returns a DiameterPtrInput for DiameterTwelveinch.
+func DiameterTwelveinchPtr() DiameterPtrInput {
+	return DiameterPtr(float64(DiameterTwelveinch))
+}
+
 func (*diameterPtr) ElementType() reflect.Type {
 	return diameterPtrType
 }
@@ -193,6 +367,160 @@ func (in *diameterPtr) ToOutput(ctx context.Context) pulumix.Output[*Diameter] {
 	}
 }
 
+// DiameterFromFloat64Input is an input of Diameter that is built from an input of the underlying float64 type.
+// Construct one with DiameterFromFloat64.
+type DiameterFromFloat64Input interface {
+	pulumi.Input
+
+	ToDiameterOutput() DiameterOutput
+	ToDiameterOutputWithContext(context.Context) DiameterOutput
+	ToDiameterPtrOutput() DiameterPtrOutput
+	ToDiameterPtrOutputWithContext(context.Context) DiameterPtrOutput
+}
+
+type diameterFromFloat64 struct{ DiameterOutput }
+
+// DiameterFromFloat64 returns a DiameterFromFloat64Input for an input of the underlying float64 type, such as a pulumi.Float64
+// or a pulumi.Float64Output. The value is not checked against the enum's declared values.
+func DiameterFromFloat64(v pulumi.Float64Input) DiameterFromFloat64Input {
+	return diameterFromFloat64{v.ToFloat64Output().ApplyT(func(v float64) Diameter {
+		return Diameter(v)
+	}).(DiameterOutput)}
+}
+
+// DiameterSlice returns vals as a slice of Diameter.
+// Every value must be one of the enum's declared values; if any are not, the returned error
+// lists all of them.
+func DiameterSlice(vals ...float64) ([]Diameter, error) {
+	result := make([]Diameter, len(vals))
+	var invalid []string
+	for i, v := range vals {
+		result[i] = Diameter(v)
+		if _, ok := diameterValues[v]; !ok {
+			invalid = append(invalid, fmt.Sprintf("%v at index %d", v, i))
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid Diameter values: %s", strings.Join(invalid, ", "))
+	}
+	return result, nil
+}
+
+// DiameterArrayInput is an input type that accepts DiameterArray and DiameterArrayOutput values.
+// You can construct a concrete instance of `DiameterArrayInput` via:
+//
+//	DiameterArray{ DiameterArgs{...} }
+type DiameterArrayInput interface {
+	pulumi.Input
+
+	ToDiameterArrayOutput() DiameterArrayOutput
+	ToDiameterArrayOutputWithContext(context.Context) DiameterArrayOutput
+}
+
+type DiameterArray []Diameter
+
+func (DiameterArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Diameter)(nil)).Elem()
+}
+
+func (i DiameterArray) ToDiameterArrayOutput() DiameterArrayOutput {
+	return i.ToDiameterArrayOutputWithContext(context.Background())
+}
+
+func (i DiameterArray) ToDiameterArrayOutputWithContext(ctx context.Context) DiameterArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DiameterArrayOutput)
+}
+
+func (i DiameterArray) ToOutput(ctx context.Context) pulumix.Output[[]Diameter] {
+	return pulumix.Output[[]Diameter]{
+		OutputState: i.ToDiameterArrayOutputWithContext(ctx).OutputState,
+	}
+}
+
+// DiameterMapInput is an input type that accepts DiameterMap and DiameterMapOutput values.
+// You can construct a concrete instance of `DiameterMapInput` via:
+//
+//	DiameterMap{ "key": DiameterArgs{...} }
+type DiameterMapInput interface {
+	pulumi.Input
+
+	ToDiameterMapOutput() DiameterMapOutput
+	ToDiameterMapOutputWithContext(context.Context) DiameterMapOutput
+}
+
+type DiameterMap map[string]Diameter
+
+func (DiameterMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]Diameter)(nil)).Elem()
+}
+
+func (i DiameterMap) ToDiameterMapOutput() DiameterMapOutput {
+	return i.ToDiameterMapOutputWithContext(context.Background())
+}
+
+func (i DiameterMap) ToDiameterMapOutputWithContext(ctx context.Context) DiameterMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DiameterMapOutput)
+}
+
+func (i DiameterMap) ToOutput(ctx context.Context) pulumix.Output[map[string]Diameter] {
+	return pulumix.Output[map[string]Diameter]{
+		OutputState: i.ToDiameterMapOutputWithContext(ctx).OutputState,
+	}
+}
+
+type DiameterArrayOutput struct{ *pulumi.OutputState }
+
+func (DiameterArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Diameter)(nil)).Elem()
+}
+
+func (o DiameterArrayOutput) ToDiameterArrayOutput() DiameterArrayOutput {
+	return o
+}
+
+func (o DiameterArrayOutput) ToDiameterArrayOutputWithContext(ctx context.Context) DiameterArrayOutput {
+	return o
+}
+
+func (o DiameterArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]Diameter] {
+	return pulumix.Output[[]Diameter]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o DiameterArrayOutput) Index(i pulumi.IntInput) DiameterOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) Diameter {
+		return vs[0].([]Diameter)[vs[1].(int)]
+	}).(DiameterOutput)
+}
+
+type DiameterMapOutput struct{ *pulumi.OutputState }
+
+func (DiameterMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]Diameter)(nil)).Elem()
+}
+
+func (o DiameterMapOutput) ToDiameterMapOutput() DiameterMapOutput {
+	return o
+}
+
+func (o DiameterMapOutput) ToDiameterMapOutputWithContext(ctx context.Context) DiameterMapOutput {
+	return o
+}
+
+func (o DiameterMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]Diameter] {
+	return pulumix.Output[map[string]Diameter]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o DiameterMapOutput) MapIndex(k pulumi.StringInput) DiameterOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) Diameter {
+		return vs[0].(map[string]Diameter)[vs[1].(string)]
+	}).(DiameterOutput)
+}
+
+// The zero value of Farm is not a declared value; see FarmUnset.
 type Farm string
 
 const (
@@ -200,6 +528,91 @@ const (
 	Farm_Plants_R_Us          = Farm("Plants'R'Us")
 )
 
+// FarmUnset is the zero value of Farm. It is not one of the enum's declared values.
+const FarmUnset Farm = ""
+
+// IsZero reports whether e is the zero value of Farm.
+func (e Farm) IsZero() bool {
+	return e == FarmUnset
+}
+
+// ToWire returns e in the representation that the schema declares for Farm, which is a string.
+func (e Farm) ToWire() interface{} {
+	return string(e)
+}
+
+// Equals reports whether e and other are the same value of Farm.
+func (e Farm) Equals(other Farm) bool {
+	return e == other
+}
+
+// FarmAll lists every declared value of Farm, in declaration order.
+var FarmAll = []Farm{Farm_Pulumi_Planters_Inc_, Farm_Plants_R_Us}
+
+// FarmMissing returns the declared values of Farm that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over Farm handles to check
+// that the switch is exhaustive.
+func FarmMissing(handled ...Farm) []Farm {
+	var missing []Farm
+	for _, v := range FarmAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// FarmMeta describes the Farm enum and its values.
+var FarmMeta = pulumi.EnumMeta{
+	Name: "Farm",
+	Type: "plant:tree/v1:Farm",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "Farm_Pulumi_Planters_Inc_", Value: Farm_Pulumi_Planters_Inc_},
+		{Name: "Farm_Plants_R_Us", Value: Farm_Plants_R_Us},
+	},
+}
+
+// farmValues maps the underlying values of Farm to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var farmValues map[string]Farm
+
+func init() {
+	farmValues = make(map[string]Farm, 2)
+	for _, v := range []Farm{Farm_Pulumi_Planters_Inc_, Farm_Plants_R_Us} {
+		farmValues[string(v)] = v
+	}
+}
+
+// ParseFarm parses s as a Farm. s must exactly match one of the enum's values.
+func ParseFarm(s string) (Farm, error) {
+	if v, ok := farmValues[s]; ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid Farm value %q", s)
+}
+
+// ParseFarmLoose parses s as a Farm, ignoring surrounding whitespace and case.
+// s may match either one of the enum's values or one of its declared names.
+func ParseFarmLoose(s string) (Farm, error) {
+	s = strings.TrimSpace(s)
+	if v, ok := farmValues[s]; ok {
+		return v, nil
+	}
+	for _, v := range []Farm{Farm_Pulumi_Planters_Inc_, Farm_Plants_R_Us} {
+		if strings.EqualFold(string(v), s) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid Farm value %q", s)
+}
+
 func (Farm) ElementType() reflect.Type {
 	return reflect.TypeOf((*Farm)(nil)).Elem()
 }
@@ -266,6 +679,11 @@ func (o FarmOutput) ToOutput(ctx context.Context) pulumix.Output[Farm] {
 	}
 }
 
+// Ptr converts the output to a FarmPtrOutput. It is shorthand for ToFarmPtrOutput.
+func (o FarmOutput) Ptr() FarmPtrOutput {
+	return o.ToFarmPtrOutput()
+}
+
 func (o FarmOutput) ToStringOutput() pulumi.StringOutput {
 	return o.ToStringOutputWithContext(context.Background())
 }
@@ -317,6 +735,16 @@ func (o FarmPtrOutput) Elem() FarmOutput {
 	}).(FarmOutput)
 }
 
+// ElemOrDefault is like Elem, but returns the given default rather than the zero value when the pointer is nil.
+func (o FarmPtrOutput) ElemOrDefault(def Farm) FarmOutput {
+	return o.ApplyT(func(v *Farm) Farm {
+		if v != nil {
+			return *v
+		}
+		return def
+	}).(FarmOutput)
+}
+
 func (o FarmPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
 	return o.ToStringPtrOutputWithContext(context.Background())
 }
@@ -331,6 +759,50 @@ func (o FarmPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.
 	}).(pulumi.StringPtrOutput)
 }
 
+// Apply applies the given function to the value of the output, returning an output of the result.
+func (o FarmOutput) Apply(applier func(Farm) interface{}) pulumi.AnyOutput {
+	return o.ApplyT(applier).(pulumi.AnyOutput)
+}
+
+// ApplyWithContext is like Apply, but also passes the given context to the function.
+func (o FarmOutput) ApplyWithContext(ctx context.Context, applier func(context.Context, Farm) interface{}) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, applier).(pulumi.AnyOutput)
+}
+
+// Apply applies the given function to the value of the output, returning an output of the result.
+func (o FarmPtrOutput) Apply(applier func(*Farm) interface{}) pulumi.AnyOutput {
+	return o.ApplyT(applier).(pulumi.AnyOutput)
+}
+
+// ApplyWithContext is like Apply, but also passes the given context to the function.
+func (o FarmPtrOutput) ApplyWithContext(ctx context.Context, applier func(context.Context, *Farm) interface{}) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, applier).(pulumi.AnyOutput)
+}
+
+// ToAnyOutput converts the output to a pulumi.AnyOutput with the same value.
+func (o FarmOutput) ToAnyOutput() pulumi.AnyOutput {
+	return o.ToAnyOutputWithContext(context.Background())
+}
+
+// ToAnyOutputWithContext is like ToAnyOutput, but uses the given context.
+func (o FarmOutput) ToAnyOutputWithContext(ctx context.Context) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Farm) interface{} {
+		return v
+	}).(pulumi.AnyOutput)
+}
+
+// ToAnyOutput converts the output to a pulumi.AnyOutput with the same value.
+func (o FarmPtrOutput) ToAnyOutput() pulumi.AnyOutput {
+	return o.ToAnyOutputWithContext(context.Background())
+}
+
+// ToAnyOutputWithContext is like ToAnyOutput, but uses the given context.
+func (o FarmPtrOutput) ToAnyOutputWithContext(ctx context.Context) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v *Farm) interface{} {
+		return v
+	}).(pulumi.AnyOutput)
+}
+
 // FarmInput is an input type that accepts FarmArgs and FarmOutput values.
 // You can construct a concrete instance of `FarmInput` via:
 //
@@ -357,6 +829,14 @@ func FarmPtr(v string) FarmPtrInput {
 	return (*farmPtr)(&v)
 }
 
+// FarmPtrFromPtr returns a FarmPtrInput for the value that v points to. A nil pointer returns a nil input.
+func FarmPtrFromPtr(v *Farm) FarmPtrInput {
+	if v == nil {
+		return nil
+	}
+	return FarmPtr(string(*v))
+}
+
 func (*farmPtr) ElementType() reflect.Type {
 	return farmPtrType
 }
@@ -375,7 +855,162 @@ func (in *farmPtr) ToOutput(ctx context.Context) pulumix.Output[*Farm] {
 	}
 }
 
+// FarmFromStringInput is an input of Farm that is built from an input of the underlying string type.
+// Construct one with FarmFromString.
+type FarmFromStringInput interface {
+	pulumi.Input
+
+	ToFarmOutput() FarmOutput
+	ToFarmOutputWithContext(context.Context) FarmOutput
+	ToFarmPtrOutput() FarmPtrOutput
+	ToFarmPtrOutputWithContext(context.Context) FarmPtrOutput
+}
+
+type farmFromString struct{ FarmOutput }
+
+// FarmFromString returns a FarmFromStringInput for an input of the underlying string type, such as a pulumi.String
+// or a pulumi.StringOutput. The value is not checked against the enum's declared values.
+func FarmFromString(v pulumi.StringInput) FarmFromStringInput {
+	return farmFromString{v.ToStringOutput().ApplyT(func(v string) Farm {
+		return Farm(v)
+	}).(FarmOutput)}
+}
+
+// FarmSlice returns vals as a slice of Farm.
+// Every value must be one of the enum's declared values; if any are not, the returned error
+// lists all of them.
+func FarmSlice(vals ...string) ([]Farm, error) {
+	result := make([]Farm, len(vals))
+	var invalid []string
+	for i, v := range vals {
+		result[i] = Farm(v)
+		if _, ok := farmValues[v]; !ok {
+			invalid = append(invalid, fmt.Sprintf("%q at index %d", v, i))
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid Farm values: %s", strings.Join(invalid, ", "))
+	}
+	return result, nil
+}
+
+// FarmArrayInput is an input type that accepts FarmArray and FarmArrayOutput values.
+// You can construct a concrete instance of `FarmArrayInput` via:
+//
+//	FarmArray{ FarmArgs{...} }
+type FarmArrayInput interface {
+	pulumi.Input
+
+	ToFarmArrayOutput() FarmArrayOutput
+	ToFarmArrayOutputWithContext(context.Context) FarmArrayOutput
+}
+
+type FarmArray []Farm
+
+func (FarmArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Farm)(nil)).Elem()
+}
+
+func (i FarmArray) ToFarmArrayOutput() FarmArrayOutput {
+	return i.ToFarmArrayOutputWithContext(context.Background())
+}
+
+func (i FarmArray) ToFarmArrayOutputWithContext(ctx context.Context) FarmArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FarmArrayOutput)
+}
+
+func (i FarmArray) ToOutput(ctx context.Context) pulumix.Output[[]Farm] {
+	return pulumix.Output[[]Farm]{
+		OutputState: i.ToFarmArrayOutputWithContext(ctx).OutputState,
+	}
+}
+
+// FarmMapInput is an input type that accepts FarmMap and FarmMapOutput values.
+// You can construct a concrete instance of `FarmMapInput` via:
+//
+//	FarmMap{ "key": FarmArgs{...} }
+type FarmMapInput interface {
+	pulumi.Input
+
+	ToFarmMapOutput() FarmMapOutput
+	ToFarmMapOutputWithContext(context.Context) FarmMapOutput
+}
+
+type FarmMap map[string]Farm
+
+func (FarmMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]Farm)(nil)).Elem()
+}
+
+func (i FarmMap) ToFarmMapOutput() FarmMapOutput {
+	return i.ToFarmMapOutputWithContext(context.Background())
+}
+
+func (i FarmMap) ToFarmMapOutputWithContext(ctx context.Context) FarmMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FarmMapOutput)
+}
+
+func (i FarmMap) ToOutput(ctx context.Context) pulumix.Output[map[string]Farm] {
+	return pulumix.Output[map[string]Farm]{
+		OutputState: i.ToFarmMapOutputWithContext(ctx).OutputState,
+	}
+}
+
+type FarmArrayOutput struct{ *pulumi.OutputState }
+
+func (FarmArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Farm)(nil)).Elem()
+}
+
+func (o FarmArrayOutput) ToFarmArrayOutput() FarmArrayOutput {
+	return o
+}
+
+func (o FarmArrayOutput) ToFarmArrayOutputWithContext(ctx context.Context) FarmArrayOutput {
+	return o
+}
+
+func (o FarmArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]Farm] {
+	return pulumix.Output[[]Farm]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o FarmArrayOutput) Index(i pulumi.IntInput) FarmOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) Farm {
+		return vs[0].([]Farm)[vs[1].(int)]
+	}).(FarmOutput)
+}
+
+type FarmMapOutput struct{ *pulumi.OutputState }
+
+func (FarmMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]Farm)(nil)).Elem()
+}
+
+func (o FarmMapOutput) ToFarmMapOutput() FarmMapOutput {
+	return o
+}
+
+func (o FarmMapOutput) ToFarmMapOutputWithContext(ctx context.Context) FarmMapOutput {
+	return o
+}
+
+func (o FarmMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]Farm] {
+	return pulumix.Output[map[string]Farm]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o FarmMapOutput) MapIndex(k pulumi.StringInput) FarmOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) Farm {
+		return vs[0].(map[string]Farm)[vs[1].(string)]
+	}).(FarmOutput)
+}
+
 // types of rubber trees
+//
+// The zero value of RubberTreeVariety is not a declared value; see RubberTreeVarietyUnset.
 type RubberTreeVariety string
 
 const (
@@ -387,6 +1022,95 @@ const (
 	RubberTreeVarietyTineke = RubberTreeVariety("Tineke")
 )
 
+// RubberTreeVarietyDefault is the default value of properties of type RubberTreeVariety.
+const RubberTreeVarietyDefault = RubberTreeVarietyBurgundy
+
+// RubberTreeVarietyUnset is the zero value of RubberTreeVariety. It is not one of the enum's declared values.
+const RubberTreeVarietyUnset RubberTreeVariety = ""
+
+// IsZero reports whether e is the zero value of RubberTreeVariety.
+func (e RubberTreeVariety) IsZero() bool {
+	return e == RubberTreeVarietyUnset
+}
+
+// ToWire returns e in the representation that the schema declares for RubberTreeVariety, which is a string.
+func (e RubberTreeVariety) ToWire() interface{} {
+	return string(e)
+}
+
+// Equals reports whether e and other are the same value of RubberTreeVariety.
+func (e RubberTreeVariety) Equals(other RubberTreeVariety) bool {
+	return e == other
+}
+
+// RubberTreeVarietyAll lists every declared value of RubberTreeVariety, in declaration order.
+var RubberTreeVarietyAll = []RubberTreeVariety{RubberTreeVarietyBurgundy, RubberTreeVarietyRuby, RubberTreeVarietyTineke}
+
+// RubberTreeVarietyMissing returns the declared values of RubberTreeVariety that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over RubberTreeVariety handles to check
+// that the switch is exhaustive.
+func RubberTreeVarietyMissing(handled ...RubberTreeVariety) []RubberTreeVariety {
+	var missing []RubberTreeVariety
+	for _, v := range RubberTreeVarietyAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// RubberTreeVarietyMeta describes the RubberTreeVariety enum and its values.
+var RubberTreeVarietyMeta = pulumi.EnumMeta{
+	Name: "RubberTreeVariety",
+	Type: "plant:tree/v1:RubberTreeVariety",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "RubberTreeVarietyBurgundy", Value: RubberTreeVarietyBurgundy},
+		{Name: "RubberTreeVarietyRuby", Value: RubberTreeVarietyRuby},
+		{Name: "RubberTreeVarietyTineke", Value: RubberTreeVarietyTineke},
+	},
+}
+
+// rubberTreeVarietyValues maps the underlying values of RubberTreeVariety to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var rubberTreeVarietyValues map[string]RubberTreeVariety
+
+func init() {
+	rubberTreeVarietyValues = make(map[string]RubberTreeVariety, 3)
+	for _, v := range []RubberTreeVariety{RubberTreeVarietyBurgundy, RubberTreeVarietyRuby, RubberTreeVarietyTineke} {
+		rubberTreeVarietyValues[string(v)] = v
+	}
+}
+
+// ParseRubberTreeVariety parses s as a RubberTreeVariety. s must exactly match one of the enum's values.
+func ParseRubberTreeVariety(s string) (RubberTreeVariety, error) {
+	if v, ok := rubberTreeVarietyValues[s]; ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid RubberTreeVariety value %q", s)
+}
+
+// ParseRubberTreeVarietyLoose parses s as a RubberTreeVariety, ignoring surrounding whitespace and case.
+// s may match either one of the enum's values or one of its declared names.
+func ParseRubberTreeVarietyLoose(s string) (RubberTreeVariety, error) {
+	s = strings.TrimSpace(s)
+	if v, ok := rubberTreeVarietyValues[s]; ok {
+		return v, nil
+	}
+	for _, v := range []RubberTreeVariety{RubberTreeVarietyBurgundy, RubberTreeVarietyRuby, RubberTreeVarietyTineke} {
+		if strings.EqualFold(string(v), s) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid RubberTreeVariety value %q", s)
+}
+
 func (RubberTreeVariety) ElementType() reflect.Type {
 	return reflect.TypeOf((*RubberTreeVariety)(nil)).Elem()
 }
@@ -453,6 +1177,11 @@ func (o RubberTreeVarietyOutput) ToOutput(ctx context.Context) pulumix.Output[Ru
 	}
 }
 
+// Ptr converts the output to a RubberTreeVarietyPtrOutput. It is shorthand for ToRubberTreeVarietyPtrOutput.
+func (o RubberTreeVarietyOutput) Ptr() RubberTreeVarietyPtrOutput {
+	return o.ToRubberTreeVarietyPtrOutput()
+}
+
 func (o RubberTreeVarietyOutput) ToStringOutput() pulumi.StringOutput {
 	return o.ToStringOutputWithContext(context.Background())
 }
@@ -504,6 +1233,16 @@ func (o RubberTreeVarietyPtrOutput) Elem() RubberTreeVarietyOutput {
 	}).(RubberTreeVarietyOutput)
 }
 
+// ElemOrDefault is like Elem, but returns the given default rather than the zero value when the pointer is nil.
+func (o RubberTreeVarietyPtrOutput) ElemOrDefault(def RubberTreeVariety) RubberTreeVarietyOutput {
+	return o.ApplyT(func(v *RubberTreeVariety) RubberTreeVariety {
+		if v != nil {
+			return *v
+		}
+		return def
+	}).(RubberTreeVarietyOutput)
+}
+
 func (o RubberTreeVarietyPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
 	return o.ToStringPtrOutputWithContext(context.Background())
 }
@@ -518,6 +1257,50 @@ func (o RubberTreeVarietyPtrOutput) ToStringPtrOutputWithContext(ctx context.Con
 	}).(pulumi.StringPtrOutput)
 }
 
+// Apply applies the given function to the value of the output, returning an output of the result.
+func (o RubberTreeVarietyOutput) Apply(applier func(RubberTreeVariety) interface{}) pulumi.AnyOutput {
+	return o.ApplyT(applier).(pulumi.AnyOutput)
+}
+
+// ApplyWithContext is like Apply, but also passes the given context to the function.
+func (o RubberTreeVarietyOutput) ApplyWithContext(ctx context.Context, applier func(context.Context, RubberTreeVariety) interface{}) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, applier).(pulumi.AnyOutput)
+}
+
+// Apply applies the given function to the value of the output, returning an output of the result.
+func (o RubberTreeVarietyPtrOutput) Apply(applier func(*RubberTreeVariety) interface{}) pulumi.AnyOutput {
+	return o.ApplyT(applier).(pulumi.AnyOutput)
+}
+
+// ApplyWithContext is like Apply, but also passes the given context to the function.
+func (o RubberTreeVarietyPtrOutput) ApplyWithContext(ctx context.Context, applier func(context.Context, *RubberTreeVariety) interface{}) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, applier).(pulumi.AnyOutput)
+}
+
+// ToAnyOutput converts the output to a pulumi.AnyOutput with the same value.
+func (o RubberTreeVarietyOutput) ToAnyOutput() pulumi.AnyOutput {
+	return o.ToAnyOutputWithContext(context.Background())
+}
+
+// ToAnyOutputWithContext is like ToAnyOutput, but uses the given context.
+func (o RubberTreeVarietyOutput) ToAnyOutputWithContext(ctx context.Context) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v RubberTreeVariety) interface{} {
+		return v
+	}).(pulumi.AnyOutput)
+}
+
+// ToAnyOutput converts the output to a pulumi.AnyOutput with the same value.
+func (o RubberTreeVarietyPtrOutput) ToAnyOutput() pulumi.AnyOutput {
+	return o.ToAnyOutputWithContext(context.Background())
+}
+
+// ToAnyOutputWithContext is like ToAnyOutput, but uses the given context.
+func (o RubberTreeVarietyPtrOutput) ToAnyOutputWithContext(ctx context.Context) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v *RubberTreeVariety) interface{} {
+		return v
+	}).(pulumi.AnyOutput)
+}
+
 // RubberTreeVarietyInput is an input type that accepts RubberTreeVarietyArgs and RubberTreeVarietyOutput values.
 // You can construct a concrete instance of `RubberTreeVarietyInput` via:
 //
@@ -544,6 +1327,14 @@ func RubberTreeVarietyPtr(v string) RubberTreeVarietyPtrInput {
 	return (*rubberTreeVarietyPtr)(&v)
 }
 
+// RubberTreeVarietyPtrFromPtr returns a RubberTreeVarietyPtrInput for the value that v points to. A nil pointer returns a nil input.
+func RubberTreeVarietyPtrFromPtr(v *RubberTreeVariety) RubberTreeVarietyPtrInput {
+	if v == nil {
+		return nil
+	}
+	return RubberTreeVarietyPtr(string(*v))
+}
+
 func (*rubberTreeVarietyPtr) ElementType() reflect.Type {
 	return rubberTreeVarietyPtrType
 }
@@ -562,6 +1353,45 @@ func (in *rubberTreeVarietyPtr) ToOutput(ctx context.Context) pulumix.Output[*Ru
 	}
 }
 
+// RubberTreeVarietyFromStringInput is an input of RubberTreeVariety that is built from an input of the underlying string type.
+// Construct one with RubberTreeVarietyFromString.
+type RubberTreeVarietyFromStringInput interface {
+	pulumi.Input
+
+	ToRubberTreeVarietyOutput() RubberTreeVarietyOutput
+	ToRubberTreeVarietyOutputWithContext(context.Context) RubberTreeVarietyOutput
+	ToRubberTreeVarietyPtrOutput() RubberTreeVarietyPtrOutput
+	ToRubberTreeVarietyPtrOutputWithContext(context.Context) RubberTreeVarietyPtrOutput
+}
+
+type rubberTreeVarietyFromString struct{ RubberTreeVarietyOutput }
+
+// RubberTreeVarietyFromString returns a RubberTreeVarietyFromStringInput for an input of the underlying string type, such as a pulumi.String
+// or a pulumi.StringOutput. The value is not checked against the enum's declared values.
+func RubberTreeVarietyFromString(v pulumi.StringInput) RubberTreeVarietyFromStringInput {
+	return rubberTreeVarietyFromString{v.ToStringOutput().ApplyT(func(v string) RubberTreeVariety {
+		return RubberTreeVariety(v)
+	}).(RubberTreeVarietyOutput)}
+}
+
+// RubberTreeVarietySlice returns vals as a slice of RubberTreeVariety.
+// Every value must be one of the enum's declared values; if any are not, the returned error
+// lists all of them.
+func RubberTreeVarietySlice(vals ...string) ([]RubberTreeVariety, error) {
+	result := make([]RubberTreeVariety, len(vals))
+	var invalid []string
+	for i, v := range vals {
+		result[i] = RubberTreeVariety(v)
+		if _, ok := rubberTreeVarietyValues[v]; !ok {
+			invalid = append(invalid, fmt.Sprintf("%q at index %d", v, i))
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid RubberTreeVariety values: %s", strings.Join(invalid, ", "))
+	}
+	return result, nil
+}
+
 // RubberTreeVarietyArrayInput is an input type that accepts RubberTreeVarietyArray and RubberTreeVarietyArrayOutput values.
 // You can construct a concrete instance of `RubberTreeVarietyArrayInput` via:
 //
@@ -593,6 +1423,37 @@ func (i RubberTreeVarietyArray) ToOutput(ctx context.Context) pulumix.Output[[]R
 	}
 }
 
+// RubberTreeVarietyMapInput is an input type that accepts RubberTreeVarietyMap and RubberTreeVarietyMapOutput values.
+// You can construct a concrete instance of `RubberTreeVarietyMapInput` via:
+//
+//	RubberTreeVarietyMap{ "key": RubberTreeVarietyArgs{...} }
+type RubberTreeVarietyMapInput interface {
+	pulumi.Input
+
+	ToRubberTreeVarietyMapOutput() RubberTreeVarietyMapOutput
+	ToRubberTreeVarietyMapOutputWithContext(context.Context) RubberTreeVarietyMapOutput
+}
+
+type RubberTreeVarietyMap map[string]RubberTreeVariety
+
+func (RubberTreeVarietyMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]RubberTreeVariety)(nil)).Elem()
+}
+
+func (i RubberTreeVarietyMap) ToRubberTreeVarietyMapOutput() RubberTreeVarietyMapOutput {
+	return i.ToRubberTreeVarietyMapOutputWithContext(context.Background())
+}
+
+func (i RubberTreeVarietyMap) ToRubberTreeVarietyMapOutputWithContext(ctx context.Context) RubberTreeVarietyMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RubberTreeVarietyMapOutput)
+}
+
+func (i RubberTreeVarietyMap) ToOutput(ctx context.Context) pulumix.Output[map[string]RubberTreeVariety] {
+	return pulumix.Output[map[string]RubberTreeVariety]{
+		OutputState: i.ToRubberTreeVarietyMapOutputWithContext(ctx).OutputState,
+	}
+}
+
 type RubberTreeVarietyArrayOutput struct{ *pulumi.OutputState }
 
 func (RubberTreeVarietyArrayOutput) ElementType() reflect.Type {
@@ -619,6 +1480,33 @@ func (o RubberTreeVarietyArrayOutput) Index(i pulumi.IntInput) RubberTreeVariety
 	}).(RubberTreeVarietyOutput)
 }
 
+type RubberTreeVarietyMapOutput struct{ *pulumi.OutputState }
+
+func (RubberTreeVarietyMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]RubberTreeVariety)(nil)).Elem()
+}
+
+func (o RubberTreeVarietyMapOutput) ToRubberTreeVarietyMapOutput() RubberTreeVarietyMapOutput {
+	return o
+}
+
+func (o RubberTreeVarietyMapOutput) ToRubberTreeVarietyMapOutputWithContext(ctx context.Context) RubberTreeVarietyMapOutput {
+	return o
+}
+
+func (o RubberTreeVarietyMapOutput) ToOutput(ctx context.Context) pulumix.Output[map[string]RubberTreeVariety] {
+	return pulumix.Output[map[string]RubberTreeVariety]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o RubberTreeVarietyMapOutput) MapIndex(k pulumi.StringInput) RubberTreeVarietyOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) RubberTreeVariety {
+		return vs[0].(map[string]RubberTreeVariety)[vs[1].(string)]
+	}).(RubberTreeVarietyOutput)
+}
+
+// The zero value of TreeSize is not a declared value; see TreeSizeUnset.
 type TreeSize string
 
 const (
@@ -627,6 +1515,95 @@ const (
 	TreeSizeLarge  = TreeSize("large")
 )
 
+// TreeSizeDefault is the default value of properties of type TreeSize.
+const TreeSizeDefault = TreeSizeMedium
+
+// TreeSizeUnset is the zero value of TreeSize. It is not one of the enum's declared values.
+const TreeSizeUnset TreeSize = ""
+
+// IsZero reports whether e is the zero value of TreeSize.
+func (e TreeSize) IsZero() bool {
+	return e == TreeSizeUnset
+}
+
+// ToWire returns e in the representation that the schema declares for TreeSize, which is a string.
+func (e TreeSize) ToWire() interface{} {
+	return string(e)
+}
+
+// Equals reports whether e and other are the same value of TreeSize.
+func (e TreeSize) Equals(other TreeSize) bool {
+	return e == other
+}
+
+// TreeSizeAll lists every declared value of TreeSize, in declaration order.
+var TreeSizeAll = []TreeSize{TreeSizeSmall, TreeSizeMedium, TreeSizeLarge}
+
+// TreeSizeMissing returns the declared values of TreeSize that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over TreeSize handles to check
+// that the switch is exhaustive.
+func TreeSizeMissing(handled ...TreeSize) []TreeSize {
+	var missing []TreeSize
+	for _, v := range TreeSizeAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// TreeSizeMeta describes the TreeSize enum and its values.
+var TreeSizeMeta = pulumi.EnumMeta{
+	Name: "TreeSize",
+	Type: "plant:tree/v1:TreeSize",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "TreeSizeSmall", Value: TreeSizeSmall},
+		{Name: "TreeSizeMedium", Value: TreeSizeMedium},
+		{Name: "TreeSizeLarge", Value: TreeSizeLarge},
+	},
+}
+
+// treeSizeValues maps the underlying values of TreeSize to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var treeSizeValues map[string]TreeSize
+
+func init() {
+	treeSizeValues = make(map[string]TreeSize, 3)
+	for _, v := range []TreeSize{TreeSizeSmall, TreeSizeMedium, TreeSizeLarge} {
+		treeSizeValues[string(v)] = v
+	}
+}
+
+// ParseTreeSize parses s as a TreeSize. s must exactly match one of the enum's values.
+func ParseTreeSize(s string) (TreeSize, error) {
+	if v, ok := treeSizeValues[s]; ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid TreeSize value %q", s)
+}
+
+// ParseTreeSizeLoose parses s as a TreeSize, ignoring surrounding whitespace and case.
+// s may match either one of the enum's values or one of its declared names.
+func ParseTreeSizeLoose(s string) (TreeSize, error) {
+	s = strings.TrimSpace(s)
+	if v, ok := treeSizeValues[s]; ok {
+		return v, nil
+	}
+	for _, v := range []TreeSize{TreeSizeSmall, TreeSizeMedium, TreeSizeLarge} {
+		if strings.EqualFold(string(v), s) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid TreeSize value %q", s)
+}
+
 func (TreeSize) ElementType() reflect.Type {
 	return reflect.TypeOf((*TreeSize)(nil)).Elem()
 }
@@ -693,6 +1670,11 @@ func (o TreeSizeOutput) ToOutput(ctx context.Context) pulumix.Output[TreeSize] {
 	}
 }
 
+// Ptr converts the output to a TreeSizePtrOutput. It is shorthand for ToTreeSizePtrOutput.
+func (o TreeSizeOutput) Ptr() TreeSizePtrOutput {
+	return o.ToTreeSizePtrOutput()
+}
+
 func (o TreeSizeOutput) ToStringOutput() pulumi.StringOutput {
 	return o.ToStringOutputWithContext(context.Background())
 }
@@ -744,6 +1726,16 @@ func (o TreeSizePtrOutput) Elem() TreeSizeOutput {
 	}).(TreeSizeOutput)
 }
 
+// ElemOrDefault is like Elem, but returns the given default rather than the zero value when the pointer is nil.
+func (o TreeSizePtrOutput) ElemOrDefault(def TreeSize) TreeSizeOutput {
+	return o.ApplyT(func(v *TreeSize) TreeSize {
+		if v != nil {
+			return *v
+		}
+		return def
+	}).(TreeSizeOutput)
+}
+
 func (o TreeSizePtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
 	return o.ToStringPtrOutputWithContext(context.Background())
 }
@@ -758,6 +1750,50 @@ func (o TreeSizePtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pul
 	}).(pulumi.StringPtrOutput)
 }
 
+// Apply applies the given function to the value of the output, returning an output of the result.
+func (o TreeSizeOutput) Apply(applier func(TreeSize) interface{}) pulumi.AnyOutput {
+	return o.ApplyT(applier).(pulumi.AnyOutput)
+}
+
+// ApplyWithContext is like Apply, but also passes the given context to the function.
+func (o TreeSizeOutput) ApplyWithContext(ctx context.Context, applier func(context.Context, TreeSize) interface{}) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, applier).(pulumi.AnyOutput)
+}
+
+// Apply applies the given function to the value of the output, returning an output of the result.
+func (o TreeSizePtrOutput) Apply(applier func(*TreeSize) interface{}) pulumi.AnyOutput {
+	return o.ApplyT(applier).(pulumi.AnyOutput)
+}
+
+// ApplyWithContext is like Apply, but also passes the given context to the function.
+func (o TreeSizePtrOutput) ApplyWithContext(ctx context.Context, applier func(context.Context, *TreeSize) interface{}) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, applier).(pulumi.AnyOutput)
+}
+
+// ToAnyOutput converts the output to a pulumi.AnyOutput with the same value.
+func (o TreeSizeOutput) ToAnyOutput() pulumi.AnyOutput {
+	return o.ToAnyOutputWithContext(context.Background())
+}
+
+// ToAnyOutputWithContext is like ToAnyOutput, but uses the given context.
+func (o TreeSizeOutput) ToAnyOutputWithContext(ctx context.Context) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v TreeSize) interface{} {
+		return v
+	}).(pulumi.AnyOutput)
+}
+
+// ToAnyOutput converts the output to a pulumi.AnyOutput with the same value.
+func (o TreeSizePtrOutput) ToAnyOutput() pulumi.AnyOutput {
+	return o.ToAnyOutputWithContext(context.Background())
+}
+
+// ToAnyOutputWithContext is like ToAnyOutput, but uses the given context.
+func (o TreeSizePtrOutput) ToAnyOutputWithContext(ctx context.Context) pulumi.AnyOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v *TreeSize) interface{} {
+		return v
+	}).(pulumi.AnyOutput)
+}
+
 // TreeSizeInput is an input type that accepts TreeSizeArgs and TreeSizeOutput values.
 // You can construct a concrete instance of `TreeSizeInput` via:
 //
@@ -784,6 +1820,14 @@ func TreeSizePtr(v string) TreeSizePtrInput {
 	return (*treeSizePtr)(&v)
 }
 
+// TreeSizePtrFromPtr returns a TreeSizePtrInput for the value that v points to. A nil pointer returns a nil input.
+func TreeSizePtrFromPtr(v *TreeSize) TreeSizePtrInput {
+	if v == nil {
+		return nil
+	}
+	return TreeSizePtr(string(*v))
+}
+
 func (*treeSizePtr) ElementType() reflect.Type {
 	return treeSizePtrType
 }
@@ -802,6 +1846,76 @@ func (in *treeSizePtr) ToOutput(ctx context.Context) pulumix.Output[*TreeSize] {
 	}
 }
 
+// TreeSizeFromStringInput is an input of TreeSize that is built from an input of the underlying string type.
+// Construct one with TreeSizeFromString.
+type TreeSizeFromStringInput interface {
+	pulumi.Input
+
+	ToTreeSizeOutput() TreeSizeOutput
+	ToTreeSizeOutputWithContext(context.Context) TreeSizeOutput
+	ToTreeSizePtrOutput() TreeSizePtrOutput
+	ToTreeSizePtrOutputWithContext(context.Context) TreeSizePtrOutput
+}
+
+type treeSizeFromString struct{ TreeSizeOutput }
+
+// TreeSizeFromString returns a TreeSizeFromStringInput for an input of the underlying string type, such as a pulumi.String
+// or a pulumi.StringOutput. The value is not checked against the enum's declared values.
+func TreeSizeFromString(v pulumi.StringInput) TreeSizeFromStringInput {
+	return treeSizeFromString{v.ToStringOutput().ApplyT(func(v string) TreeSize {
+		return TreeSize(v)
+	}).(TreeSizeOutput)}
+}
+
+// TreeSizeSlice returns vals as a slice of TreeSize.
+// Every value must be one of the enum's declared values; if any are not, the returned error
+// lists all of them.
+func TreeSizeSlice(vals ...string) ([]TreeSize, error) {
+	result := make([]TreeSize, len(vals))
+	var invalid []string
+	for i, v := range vals {
+		result[i] = TreeSize(v)
+		if _, ok := treeSizeValues[v]; !ok {
+			invalid = append(invalid, fmt.Sprintf("%q at index %d", v, i))
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid TreeSize values: %s", strings.Join(invalid, ", "))
+	}
+	return result, nil
+}
+
+// TreeSizeArrayInput is an input type that accepts TreeSizeArray and TreeSizeArrayOutput values.
+// You can construct a concrete instance of `TreeSizeArrayInput` via:
+//
+//	TreeSizeArray{ TreeSizeArgs{...} }
+type TreeSizeArrayInput interface {
+	pulumi.Input
+
+	ToTreeSizeArrayOutput() TreeSizeArrayOutput
+	ToTreeSizeArrayOutputWithContext(context.Context) TreeSizeArrayOutput
+}
+
+type TreeSizeArray []TreeSize
+
+func (TreeSizeArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]TreeSize)(nil)).Elem()
+}
+
+func (i TreeSizeArray) ToTreeSizeArrayOutput() TreeSizeArrayOutput {
+	return i.ToTreeSizeArrayOutputWithContext(context.Background())
+}
+
+func (i TreeSizeArray) ToTreeSizeArrayOutputWithContext(ctx context.Context) TreeSizeArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TreeSizeArrayOutput)
+}
+
+func (i TreeSizeArray) ToOutput(ctx context.Context) pulumix.Output[[]TreeSize] {
+	return pulumix.Output[[]TreeSize]{
+		OutputState: i.ToTreeSizeArrayOutputWithContext(ctx).OutputState,
+	}
+}
+
 // TreeSizeMapInput is an input type that accepts TreeSizeMap and TreeSizeMapOutput values.
 // You can construct a concrete instance of `TreeSizeMapInput` via:
 //
@@ -833,6 +1947,32 @@ func (i TreeSizeMap) ToOutput(ctx context.Context) pulumix.Output[map[string]Tre
 	}
 }
 
+type TreeSizeArrayOutput struct{ *pulumi.OutputState }
+
+func (TreeSizeArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]TreeSize)(nil)).Elem()
+}
+
+func (o TreeSizeArrayOutput) ToTreeSizeArrayOutput() TreeSizeArrayOutput {
+	return o
+}
+
+func (o TreeSizeArrayOutput) ToTreeSizeArrayOutputWithContext(ctx context.Context) TreeSizeArrayOutput {
+	return o
+}
+
+func (o TreeSizeArrayOutput) ToOutput(ctx context.Context) pulumix.Output[[]TreeSize] {
+	return pulumix.Output[[]TreeSize]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o TreeSizeArrayOutput) Index(i pulumi.IntInput) TreeSizeOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) TreeSize {
+		return vs[0].([]TreeSize)[vs[1].(int)]
+	}).(TreeSizeOutput)
+}
+
 type TreeSizeMapOutput struct{ *pulumi.OutputState }
 
 func (TreeSizeMapOutput) ElementType() reflect.Type {
@@ -862,22 +2002,38 @@ func (o TreeSizeMapOutput) MapIndex(k pulumi.StringInput) TreeSizeOutput {
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*DiameterInput)(nil)).Elem(), Diameter(6))
 	pulumi.RegisterInputType(reflect.TypeOf((*DiameterPtrInput)(nil)).Elem(), Diameter(6))
+	pulumi.RegisterInputType(reflect.TypeOf((*DiameterFromFloat64Input)(nil)).Elem(), diameterFromFloat64{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DiameterArrayInput)(nil)).Elem(), DiameterArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DiameterMapInput)(nil)).Elem(), DiameterMap{})
 	pulumi.RegisterInputType(reflect.TypeOf((*FarmInput)(nil)).Elem(), Farm("Pulumi Planters Inc."))
 	pulumi.RegisterInputType(reflect.TypeOf((*FarmPtrInput)(nil)).Elem(), Farm("Pulumi Planters Inc."))
+	pulumi.RegisterInputType(reflect.TypeOf((*FarmFromStringInput)(nil)).Elem(), farmFromString{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FarmArrayInput)(nil)).Elem(), FarmArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FarmMapInput)(nil)).Elem(), FarmMap{})
 	pulumi.RegisterInputType(reflect.TypeOf((*RubberTreeVarietyInput)(nil)).Elem(), RubberTreeVariety("Burgundy"))
 	pulumi.RegisterInputType(reflect.TypeOf((*RubberTreeVarietyPtrInput)(nil)).Elem(), RubberTreeVariety("Burgundy"))
+	pulumi.RegisterInputType(reflect.TypeOf((*RubberTreeVarietyFromStringInput)(nil)).Elem(), rubberTreeVarietyFromString{})
 	pulumi.RegisterInputType(reflect.TypeOf((*RubberTreeVarietyArrayInput)(nil)).Elem(), RubberTreeVarietyArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*RubberTreeVarietyMapInput)(nil)).Elem(), RubberTreeVarietyMap{})
 	pulumi.RegisterInputType(reflect.TypeOf((*TreeSizeInput)(nil)).Elem(), TreeSize("small"))
 	pulumi.RegisterInputType(reflect.TypeOf((*TreeSizePtrInput)(nil)).Elem(), TreeSize("small"))
+	pulumi.RegisterInputType(reflect.TypeOf((*TreeSizeFromStringInput)(nil)).Elem(), treeSizeFromString{})
+	pulumi.RegisterInputType(reflect.TypeOf((*TreeSizeArrayInput)(nil)).Elem(), TreeSizeArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*TreeSizeMapInput)(nil)).Elem(), TreeSizeMap{})
 	pulumi.RegisterOutputType(DiameterOutput{})
 	pulumi.RegisterOutputType(DiameterPtrOutput{})
+	pulumi.RegisterOutputType(DiameterArrayOutput{})
+	pulumi.RegisterOutputType(DiameterMapOutput{})
 	pulumi.RegisterOutputType(FarmOutput{})
 	pulumi.RegisterOutputType(FarmPtrOutput{})
+	pulumi.RegisterOutputType(FarmArrayOutput{})
+	pulumi.RegisterOutputType(FarmMapOutput{})
 	pulumi.RegisterOutputType(RubberTreeVarietyOutput{})
 	pulumi.RegisterOutputType(RubberTreeVarietyPtrOutput{})
 	pulumi.RegisterOutputType(RubberTreeVarietyArrayOutput{})
+	pulumi.RegisterOutputType(RubberTreeVarietyMapOutput{})
 	pulumi.RegisterOutputType(TreeSizeOutput{})
 	pulumi.RegisterOutputType(TreeSizePtrOutput{})
+	pulumi.RegisterOutputType(TreeSizeArrayOutput{})
 	pulumi.RegisterOutputType(TreeSizeMapOutput{})
 }
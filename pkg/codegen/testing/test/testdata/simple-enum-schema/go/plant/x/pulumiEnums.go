@@ -4,6 +4,8 @@
 package plant
 
 // The log_name to populate in the Cloud Audit Record. This is added to regress pulumi/pulumi issue #7913
+//
+// The zero value of CloudAuditOptionsLogName is not a declared value; see CloudAuditOptionsLogNameUnset.
 type CloudAuditOptionsLogName string
 
 const (
@@ -18,6 +20,48 @@ const (
 	CloudAuditOptionsLogName_CloudAuditOptionsLogName_NO_NAME = CloudAuditOptionsLogName("_NO_NAME")
 )
 
+// CloudAuditOptionsLogNameUnset is the zero value of CloudAuditOptionsLogName. It is not one of the enum's declared values.
+const CloudAuditOptionsLogNameUnset CloudAuditOptionsLogName = ""
+
+// IsZero reports whether e is the zero value of CloudAuditOptionsLogName.
+func (e CloudAuditOptionsLogName) IsZero() bool {
+	return e == CloudAuditOptionsLogNameUnset
+}
+
+// ToWire returns e in the representation that the schema declares for CloudAuditOptionsLogName, which is a string.
+func (e CloudAuditOptionsLogName) ToWire() interface{} {
+	return string(e)
+}
+
+// Equals reports whether e and other are the same value of CloudAuditOptionsLogName.
+func (e CloudAuditOptionsLogName) Equals(other CloudAuditOptionsLogName) bool {
+	return e == other
+}
+
+// CloudAuditOptionsLogNameAll lists every declared value of CloudAuditOptionsLogName, in declaration order.
+var CloudAuditOptionsLogNameAll = []CloudAuditOptionsLogName{CloudAuditOptionsLogNameCloudAuditOptionsLogNameUnspecifiedLogName, CloudAuditOptionsLogNameCloudAuditOptionsLogNameAdminActivity, CloudAuditOptionsLogNameCloudAuditOptionsLogNameDataAccess, CloudAuditOptionsLogNameCloudAuditOptionsLogNameSynthetic, CloudAuditOptionsLogName_CloudAuditOptionsLogName_NO_NAME}
+
+// CloudAuditOptionsLogNameMissing returns the declared values of CloudAuditOptionsLogName that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over CloudAuditOptionsLogName handles to check
+// that the switch is exhaustive.
+func CloudAuditOptionsLogNameMissing(handled ...CloudAuditOptionsLogName) []CloudAuditOptionsLogName {
+	var missing []CloudAuditOptionsLogName
+	for _, v := range CloudAuditOptionsLogNameAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// The zero value of ContainerBrightness is not a declared value; see ContainerBrightnessUnset.
 type ContainerBrightness float64
 
 const (
@@ -25,7 +69,56 @@ const (
 	ContainerBrightnessContainerBrightnessOne          = ContainerBrightness(1)
 )
 
+// ContainerBrightnessDefault is the default value of properties of type ContainerBrightness.
+const ContainerBrightnessDefault = ContainerBrightnessContainerBrightnessOne
+
+// ContainerBrightnessUnset is the zero value of ContainerBrightness. It is not one of the enum's declared values.
+const ContainerBrightnessUnset ContainerBrightness = 0
+
+// IsZero reports whether e is the zero value of ContainerBrightness.
+func (e ContainerBrightness) IsZero() bool {
+	return e == ContainerBrightnessUnset
+}
+
+// ToWire returns e in the representation that the schema declares for ContainerBrightness, which is a float64.
+func (e ContainerBrightness) ToWire() interface{} {
+	return float64(e)
+}
+
+// Equals reports whether e and other are the same value of ContainerBrightness. The values are compared within 0.01,
+// which is finer than the precision of the enum's declared values, so that values that have passed
+// through JSON compare equal to the declared values they represent.
+func (e ContainerBrightness) Equals(other ContainerBrightness) bool {
+	d := float64(e - other)
+	return d <= 0.01 && d >= -0.01
+}
+
+// ContainerBrightnessAll lists every declared value of ContainerBrightness, in declaration order.
+var ContainerBrightnessAll = []ContainerBrightness{ContainerBrightnessContainerBrightnessZeroPointOne, ContainerBrightnessContainerBrightnessOne}
+
+// ContainerBrightnessMissing returns the declared values of ContainerBrightness that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over ContainerBrightness handles to check
+// that the switch is exhaustive.
+func ContainerBrightnessMissing(handled ...ContainerBrightness) []ContainerBrightness {
+	var missing []ContainerBrightness
+	for _, v := range ContainerBrightnessAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
 // plant container colors
+//
+// The zero value of ContainerColor is not a declared value; see ContainerColorUnset.
 type ContainerColor string
 
 const (
@@ -34,7 +127,50 @@ const (
 	ContainerColorContainerColorYellow = ContainerColor("yellow")
 )
 
+// ContainerColorUnset is the zero value of ContainerColor. It is not one of the enum's declared values.
+const ContainerColorUnset ContainerColor = ""
+
+// IsZero reports whether e is the zero value of ContainerColor.
+func (e ContainerColor) IsZero() bool {
+	return e == ContainerColorUnset
+}
+
+// ToWire returns e in the representation that the schema declares for ContainerColor, which is a string.
+func (e ContainerColor) ToWire() interface{} {
+	return string(e)
+}
+
+// Equals reports whether e and other are the same value of ContainerColor.
+func (e ContainerColor) Equals(other ContainerColor) bool {
+	return e == other
+}
+
+// ContainerColorAll lists every declared value of ContainerColor, in declaration order.
+var ContainerColorAll = []ContainerColor{ContainerColorContainerColorRed, ContainerColorContainerColorBlue, ContainerColorContainerColorYellow}
+
+// ContainerColorMissing returns the declared values of ContainerColor that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over ContainerColor handles to check
+// that the switch is exhaustive.
+func ContainerColorMissing(handled ...ContainerColor) []ContainerColor {
+	var missing []ContainerColor
+	for _, v := range ContainerColorAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
 // plant container sizes
+//
+// The zero value of ContainerSize is not a declared value; see ContainerSizeUnset.
 type ContainerSize int
 
 const (
@@ -43,3 +179,44 @@ const (
 	// Deprecated: Eight inch pots are no longer supported.
 	ContainerSizeContainerSizeEightInch = ContainerSize(8)
 )
+
+// ContainerSizeUnset is the zero value of ContainerSize. It is not one of the enum's declared values.
+const ContainerSizeUnset ContainerSize = 0
+
+// IsZero reports whether e is the zero value of ContainerSize.
+func (e ContainerSize) IsZero() bool {
+	return e == ContainerSizeUnset
+}
+
+// ToWire returns e in the representation that the schema declares for ContainerSize, which is a int.
+func (e ContainerSize) ToWire() interface{} {
+	return int(e)
+}
+
+// Equals reports whether e and other are the same value of ContainerSize.
+func (e ContainerSize) Equals(other ContainerSize) bool {
+	return e == other
+}
+
+// ContainerSizeAll lists every declared value of ContainerSize, in declaration order.
+var ContainerSizeAll = []ContainerSize{ContainerSizeContainerSizeFourInch, ContainerSizeContainerSizeSixInch, ContainerSizeContainerSizeEightInch}
+
+// ContainerSizeMissing returns the declared values of ContainerSize that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over ContainerSize handles to check
+// that the switch is exhaustive.
+func ContainerSizeMissing(handled ...ContainerSize) []ContainerSize {
+	var missing []ContainerSize
+	for _, v := range ContainerSizeAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
@@ -3,6 +3,7 @@
 
 package v1
 
+// The zero value of Diameter is not a declared value; see DiameterUnset.
 type Diameter float64
 
 const (
@@ -10,6 +11,54 @@ const (
 	DiameterDiameterTwelveinch = Diameter(12)
 )
 
+// DiameterDefault is the default value of properties of type Diameter.
+const DiameterDefault = DiameterDiameterSixinch
+
+// DiameterUnset is the zero value of Diameter. It is not one of the enum's declared values.
+const DiameterUnset Diameter = 0
+
+// IsZero reports whether e is the zero value of Diameter.
+func (e Diameter) IsZero() bool {
+	return e == DiameterUnset
+}
+
+// ToWire returns e in the representation that the schema declares for Diameter, which is a float64.
+func (e Diameter) ToWire() interface{} {
+	return float64(e)
+}
+
+// Equals reports whether e and other are the same value of Diameter. The values are compared within 0.1,
+// which is finer than the precision of the enum's declared values, so that values that have passed
+// through JSON compare equal to the declared values they represent.
+func (e Diameter) Equals(other Diameter) bool {
+	d := float64(e - other)
+	return d <= 0.1 && d >= -0.1
+}
+
+// DiameterAll lists every declared value of Diameter, in declaration order.
+var DiameterAll = []Diameter{DiameterDiameterSixinch, DiameterDiameterTwelveinch}
+
+// DiameterMissing returns the declared values of Diameter that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over Diameter handles to check
+// that the switch is exhaustive.
+func DiameterMissing(handled ...Diameter) []Diameter {
+	var missing []Diameter
+	for _, v := range DiameterAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// The zero value of Farm is not a declared value; see FarmUnset.
 type Farm string
 
 const (
@@ -17,7 +66,50 @@ const (
 	Farm_Farm_Plants_R_Us          = Farm("Plants'R'Us")
 )
 
+// FarmUnset is the zero value of Farm. It is not one of the enum's declared values.
+const FarmUnset Farm = ""
+
+// IsZero reports whether e is the zero value of Farm.
+func (e Farm) IsZero() bool {
+	return e == FarmUnset
+}
+
+// ToWire returns e in the representation that the schema declares for Farm, which is a string.
+func (e Farm) ToWire() interface{} {
+	return string(e)
+}
+
+// Equals reports whether e and other are the same value of Farm.
+func (e Farm) Equals(other Farm) bool {
+	return e == other
+}
+
+// FarmAll lists every declared value of Farm, in declaration order.
+var FarmAll = []Farm{Farm_Farm_Pulumi_Planters_Inc_, Farm_Farm_Plants_R_Us}
+
+// FarmMissing returns the declared values of Farm that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over Farm handles to check
+// that the switch is exhaustive.
+func FarmMissing(handled ...Farm) []Farm {
+	var missing []Farm
+	for _, v := range FarmAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
 // types of rubber trees
+//
+// The zero value of RubberTreeVariety is not a declared value; see RubberTreeVarietyUnset.
 type RubberTreeVariety string
 
 const (
@@ -29,6 +121,51 @@ const (
 	RubberTreeVarietyRubberTreeVarietyTineke = RubberTreeVariety("Tineke")
 )
 
+// RubberTreeVarietyDefault is the default value of properties of type RubberTreeVariety.
+const RubberTreeVarietyDefault = RubberTreeVarietyRubberTreeVarietyBurgundy
+
+// RubberTreeVarietyUnset is the zero value of RubberTreeVariety. It is not one of the enum's declared values.
+const RubberTreeVarietyUnset RubberTreeVariety = ""
+
+// IsZero reports whether e is the zero value of RubberTreeVariety.
+func (e RubberTreeVariety) IsZero() bool {
+	return e == RubberTreeVarietyUnset
+}
+
+// ToWire returns e in the representation that the schema declares for RubberTreeVariety, which is a string.
+func (e RubberTreeVariety) ToWire() interface{} {
+	return string(e)
+}
+
+// Equals reports whether e and other are the same value of RubberTreeVariety.
+func (e RubberTreeVariety) Equals(other RubberTreeVariety) bool {
+	return e == other
+}
+
+// RubberTreeVarietyAll lists every declared value of RubberTreeVariety, in declaration order.
+var RubberTreeVarietyAll = []RubberTreeVariety{RubberTreeVarietyRubberTreeVarietyBurgundy, RubberTreeVarietyRubberTreeVarietyRuby, RubberTreeVarietyRubberTreeVarietyTineke}
+
+// RubberTreeVarietyMissing returns the declared values of RubberTreeVariety that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over RubberTreeVariety handles to check
+// that the switch is exhaustive.
+func RubberTreeVarietyMissing(handled ...RubberTreeVariety) []RubberTreeVariety {
+	var missing []RubberTreeVariety
+	for _, v := range RubberTreeVarietyAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// The zero value of TreeSize is not a declared value; see TreeSizeUnset.
 type TreeSize string
 
 const (
@@ -36,3 +173,47 @@ const (
 	TreeSizeTreeSizeMedium = TreeSize("medium")
 	TreeSizeTreeSizeLarge  = TreeSize("large")
 )
+
+// TreeSizeDefault is the default value of properties of type TreeSize.
+const TreeSizeDefault = TreeSizeTreeSizeMedium
+
+// TreeSizeUnset is the zero value of TreeSize. It is not one of the enum's declared values.
+const TreeSizeUnset TreeSize = ""
+
+// IsZero reports whether e is the zero value of TreeSize.
+func (e TreeSize) IsZero() bool {
+	return e == TreeSizeUnset
+}
+
+// ToWire returns e in the representation that the schema declares for TreeSize, which is a string.
+func (e TreeSize) ToWire() interface{} {
+	return string(e)
+}
+
+// Equals reports whether e and other are the same value of TreeSize.
+func (e TreeSize) Equals(other TreeSize) bool {
+	return e == other
+}
+
+// TreeSizeAll lists every declared value of TreeSize, in declaration order.
+var TreeSizeAll = []TreeSize{TreeSizeTreeSizeSmall, TreeSizeTreeSizeMedium, TreeSizeTreeSizeLarge}
+
+// TreeSizeMissing returns the declared values of TreeSize that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over TreeSize handles to check
+// that the switch is exhaustive.
+func TreeSizeMissing(handled ...TreeSize) []TreeSize {
+	var missing []TreeSize
+	for _, v := range TreeSizeAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
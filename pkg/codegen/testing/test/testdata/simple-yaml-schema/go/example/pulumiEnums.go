@@ -5,7 +5,9 @@ package example
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
@@ -18,6 +20,73 @@ const (
 	OutputOnlyEnumTypeBar = OutputOnlyEnumType("bar")
 )
 
+// OutputOnlyEnumTypeAll lists every declared value of OutputOnlyEnumType, in declaration order.
+var OutputOnlyEnumTypeAll = []OutputOnlyEnumType{OutputOnlyEnumTypeFoo, OutputOnlyEnumTypeBar}
+
+// OutputOnlyEnumTypeMissing returns the declared values of OutputOnlyEnumType that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over OutputOnlyEnumType handles to check
+// that the switch is exhaustive.
+func OutputOnlyEnumTypeMissing(handled ...OutputOnlyEnumType) []OutputOnlyEnumType {
+	var missing []OutputOnlyEnumType
+	for _, v := range OutputOnlyEnumTypeAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// OutputOnlyEnumTypeMeta describes the OutputOnlyEnumType enum and its values.
+var OutputOnlyEnumTypeMeta = pulumi.EnumMeta{
+	Name: "OutputOnlyEnumType",
+	Type: "example::OutputOnlyEnumType",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "OutputOnlyEnumTypeFoo", Value: OutputOnlyEnumTypeFoo},
+		{Name: "OutputOnlyEnumTypeBar", Value: OutputOnlyEnumTypeBar},
+	},
+}
+
+// outputOnlyEnumTypeValues maps the underlying values of OutputOnlyEnumType to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var outputOnlyEnumTypeValues map[string]OutputOnlyEnumType
+
+func init() {
+	outputOnlyEnumTypeValues = make(map[string]OutputOnlyEnumType, 2)
+	for _, v := range []OutputOnlyEnumType{OutputOnlyEnumTypeFoo, OutputOnlyEnumTypeBar} {
+		outputOnlyEnumTypeValues[string(v)] = v
+	}
+}
+
+// ParseOutputOnlyEnumType parses s as a OutputOnlyEnumType. s must exactly match one of the enum's values.
+func ParseOutputOnlyEnumType(s string) (OutputOnlyEnumType, error) {
+	if v, ok := outputOnlyEnumTypeValues[s]; ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid OutputOnlyEnumType value %q", s)
+}
+
+// ParseOutputOnlyEnumTypeLoose parses s as a OutputOnlyEnumType, ignoring surrounding whitespace and case.
+// s may match either one of the enum's values or one of its declared names.
+func ParseOutputOnlyEnumTypeLoose(s string) (OutputOnlyEnumType, error) {
+	s = strings.TrimSpace(s)
+	if v, ok := outputOnlyEnumTypeValues[s]; ok {
+		return v, nil
+	}
+	for _, v := range []OutputOnlyEnumType{OutputOnlyEnumTypeFoo, OutputOnlyEnumTypeBar} {
+		if strings.EqualFold(string(v), s) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid OutputOnlyEnumType value %q", s)
+}
+
 type OutputOnlyEnumTypeOutput struct{ *pulumi.OutputState }
 
 func (OutputOnlyEnumTypeOutput) ElementType() reflect.Type {
@@ -42,6 +111,11 @@ func (o OutputOnlyEnumTypeOutput) ToOutputOnlyEnumTypePtrOutputWithContext(ctx c
 	}).(OutputOnlyEnumTypePtrOutput)
 }
 
+// Ptr converts the output to a OutputOnlyEnumTypePtrOutput. It is shorthand for ToOutputOnlyEnumTypePtrOutput.
+func (o OutputOnlyEnumTypeOutput) Ptr() OutputOnlyEnumTypePtrOutput {
+	return o.ToOutputOnlyEnumTypePtrOutput()
+}
+
 func (o OutputOnlyEnumTypeOutput) ToStringOutput() pulumi.StringOutput {
 	return o.ToStringOutputWithContext(context.Background())
 }
@@ -133,6 +207,74 @@ const (
 	RubberTreeVarietyTineke = RubberTreeVariety("Tineke")
 )
 
+// RubberTreeVarietyAll lists every declared value of RubberTreeVariety, in declaration order.
+var RubberTreeVarietyAll = []RubberTreeVariety{RubberTreeVarietyBurgundy, RubberTreeVarietyRuby, RubberTreeVarietyTineke}
+
+// RubberTreeVarietyMissing returns the declared values of RubberTreeVariety that are not among handled, in
+// declaration order. Tests can pass it the values that a switch over RubberTreeVariety handles to check
+// that the switch is exhaustive.
+func RubberTreeVarietyMissing(handled ...RubberTreeVariety) []RubberTreeVariety {
+	var missing []RubberTreeVariety
+	for _, v := range RubberTreeVarietyAll {
+		found := false
+		for _, h := range handled {
+			if h == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// RubberTreeVarietyMeta describes the RubberTreeVariety enum and its values.
+var RubberTreeVarietyMeta = pulumi.EnumMeta{
+	Name: "RubberTreeVariety",
+	Type: "example::RubberTreeVariety",
+	Values: []pulumi.EnumValueMeta{
+		{Name: "RubberTreeVarietyBurgundy", Value: RubberTreeVarietyBurgundy},
+		{Name: "RubberTreeVarietyRuby", Value: RubberTreeVarietyRuby},
+		{Name: "RubberTreeVarietyTineke", Value: RubberTreeVarietyTineke},
+	},
+}
+
+// rubberTreeVarietyValues maps the underlying values of RubberTreeVariety to its declared values.
+// It is read-only after init, so it is safe to read concurrently.
+var rubberTreeVarietyValues map[string]RubberTreeVariety
+
+func init() {
+	rubberTreeVarietyValues = make(map[string]RubberTreeVariety, 3)
+	for _, v := range []RubberTreeVariety{RubberTreeVarietyBurgundy, RubberTreeVarietyRuby, RubberTreeVarietyTineke} {
+		rubberTreeVarietyValues[string(v)] = v
+	}
+}
+
+// ParseRubberTreeVariety parses s as a RubberTreeVariety. s must exactly match one of the enum's values.
+func ParseRubberTreeVariety(s string) (RubberTreeVariety, error) {
+	if v, ok := rubberTreeVarietyValues[s]; ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid RubberTreeVariety value %q", s)
+}
+
+// ParseRubberTreeVarietyLoose parses s as a RubberTreeVariety, ignoring surrounding whitespace and case.
+// s may match either one of the enum's values or one of its declared names.
+func ParseRubberTreeVarietyLoose(s string) (RubberTreeVariety, error) {
+	s = strings.TrimSpace(s)
+	if v, ok := rubberTreeVarietyValues[s]; ok {
+		return v, nil
+	}
+	for _, v := range []RubberTreeVariety{RubberTreeVarietyBurgundy, RubberTreeVarietyRuby, RubberTreeVarietyTineke} {
+		if strings.EqualFold(string(v), s) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid RubberTreeVariety value %q", s)
+}
+
 func (RubberTreeVariety) ElementType() reflect.Type {
 	return reflect.TypeOf((*RubberTreeVariety)(nil)).Elem()
 }
@@ -193,6 +335,11 @@ func (o RubberTreeVarietyOutput) ToRubberTreeVarietyPtrOutputWithContext(ctx con
 	}).(RubberTreeVarietyPtrOutput)
 }
 
+// Ptr converts the output to a RubberTreeVarietyPtrOutput. It is shorthand for ToRubberTreeVarietyPtrOutput.
+func (o RubberTreeVarietyOutput) Ptr() RubberTreeVarietyPtrOutput {
+	return o.ToRubberTreeVarietyPtrOutput()
+}
+
 func (o RubberTreeVarietyOutput) ToStringOutput() pulumi.StringOutput {
 	return o.ToStringOutputWithContext(context.Background())
 }
@@ -278,6 +425,14 @@ func RubberTreeVarietyPtr(v string) RubberTreeVarietyPtrInput {
 	return (*rubberTreeVarietyPtr)(&v)
 }
 
+// RubberTreeVarietyPtrFromPtr returns a RubberTreeVarietyPtrInput for the value that v points to. A nil pointer returns a nil input.
+func RubberTreeVarietyPtrFromPtr(v *RubberTreeVariety) RubberTreeVarietyPtrInput {
+	if v == nil {
+		return nil
+	}
+	return RubberTreeVarietyPtr(string(*v))
+}
+
 func (*rubberTreeVarietyPtr) ElementType() reflect.Type {
 	return rubberTreeVarietyPtrType
 }
@@ -296,9 +451,31 @@ func (in *rubberTreeVarietyPtr) ToOutput(ctx context.Context) pulumix.Output[*Ru
 	}
 }
 
+// RubberTreeVarietyFromStringInput is an input of RubberTreeVariety that is built from an input of the underlying string type.
+// Construct one with RubberTreeVarietyFromString.
+type RubberTreeVarietyFromStringInput interface {
+	pulumi.Input
+
+	ToRubberTreeVarietyOutput() RubberTreeVarietyOutput
+	ToRubberTreeVarietyOutputWithContext(context.Context) RubberTreeVarietyOutput
+	ToRubberTreeVarietyPtrOutput() RubberTreeVarietyPtrOutput
+	ToRubberTreeVarietyPtrOutputWithContext(context.Context) RubberTreeVarietyPtrOutput
+}
+
+type rubberTreeVarietyFromString struct{ RubberTreeVarietyOutput }
+
+// RubberTreeVarietyFromString returns a RubberTreeVarietyFromStringInput for an input of the underlying string type, such as a pulumi.String
+// or a pulumi.StringOutput. The value is not checked against the enum's declared values.
+func RubberTreeVarietyFromString(v pulumi.StringInput) RubberTreeVarietyFromStringInput {
+	return rubberTreeVarietyFromString{v.ToStringOutput().ApplyT(func(v string) RubberTreeVariety {
+		return RubberTreeVariety(v)
+	}).(RubberTreeVarietyOutput)}
+}
+
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*RubberTreeVarietyInput)(nil)).Elem(), RubberTreeVariety("Burgundy"))
 	pulumi.RegisterInputType(reflect.TypeOf((*RubberTreeVarietyPtrInput)(nil)).Elem(), RubberTreeVariety("Burgundy"))
+	pulumi.RegisterInputType(reflect.TypeOf((*RubberTreeVarietyFromStringInput)(nil)).Elem(), rubberTreeVarietyFromString{})
 	pulumi.RegisterOutputType(OutputOnlyEnumTypeOutput{})
 	pulumi.RegisterOutputType(OutputOnlyEnumTypePtrOutput{})
 	pulumi.RegisterOutputType(OutputOnlyEnumTypeMapOutput{})
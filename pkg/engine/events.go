@@ -345,9 +345,7 @@ func makeStepEventMetadata(op display.StepOp, step deploy.Step, debug bool) Step
 	}
 
 	var detailedDiff map[string]plugin.PropertyDiff
-	if detailedDiffer, hasDetailedDiff := step.(interface {
-		DetailedDiff() map[string]plugin.PropertyDiff
-	}); hasDetailedDiff {
+	if detailedDiffer, hasDetailedDiff := step.(deploy.DetailedDiffStep); hasDetailedDiff {
 		detailedDiff = detailedDiffer.DetailedDiff()
 	}
 
@@ -945,6 +945,76 @@ func TestImportIntoParent(t *testing.T) {
 	assert.Len(t, snap.Resources, 4)
 }
 
+func TestImportIntoNestedParents(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				GetSchemaF: func(version int) ([]byte, error) {
+					return []byte(importSchema), nil
+				},
+				DiffF: diffImportResource,
+				ReadF: func(urn resource.URN, id resource.ID,
+					inputs, state resource.PropertyMap,
+				) (plugin.ReadResult, resource.Status, error) {
+					return plugin.ReadResult{
+						Inputs: resource.PropertyMap{
+							"foo":  resource.NewStringProperty("bar"),
+							"frob": resource.NewNumberProperty(1),
+						},
+						Outputs: resource.PropertyMap{
+							"foo":  resource.NewStringProperty("bar"),
+							"frob": resource.NewNumberProperty(1),
+						},
+					}, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+	programF := deploytest.NewLanguageRuntimeF(nil)
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+	p := &TestPlan{
+		Options: TestUpdateOptions{HostF: hostF},
+	}
+
+	// Import a component, a custom resource parented to it, and a custom resource parented to that in turn. The
+	// imports are listed children first, so each must wait for its parent to be imported.
+	compURN := p.NewURN("my-component", "comp", "")
+	resBURN := p.NewURN("pkgA:m:typA", "resB", compURN)
+	project := p.GetProject()
+	snap, err := ImportOp([]deploy.Import{
+		{
+			Type:   "pkgA:m:typA",
+			Name:   "resC",
+			ID:     "imported-idC",
+			Parent: resBURN,
+		},
+		{
+			Type:   "pkgA:m:typA",
+			Name:   "resB",
+			ID:     "imported-idB",
+			Parent: compURN,
+		},
+		{
+			Type:      "my-component",
+			Name:      "comp",
+			Component: true,
+		},
+	}).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+
+	require.NoError(t, err)
+	require.Len(t, snap.Resources, 5)
+
+	// The snapshot lists each parent before its children.
+	assert.Equal(t, compURN, snap.Resources[2].URN)
+	assert.Equal(t, resBURN, snap.Resources[3].URN)
+	assert.Equal(t, compURN, snap.Resources[3].Parent)
+	assert.Equal(t, p.NewURN("pkgA:m:typA", "resC", resBURN), snap.Resources[4].URN)
+	assert.Equal(t, resBURN, snap.Resources[4].Parent)
+}
+
 func TestImportComponent(t *testing.T) {
 	t.Parallel()
 
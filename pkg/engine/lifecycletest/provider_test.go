@@ -367,6 +367,120 @@ func TestSingleResourceExplicitProviderReplace(t *testing.T) {
 	p.Run(t, snap)
 }
 
+func TestSingleResourceExplicitProviderConfigDriftReplace(t *testing.T) {
+	t.Parallel()
+
+	config := resource.PropertyMap{
+		"region":    resource.NewStringProperty("us-west-2"),
+		"accessKey": resource.NewStringProperty("key-1"),
+	}
+	cases := []struct {
+		name     string
+		inputs   resource.PropertyMap
+		replaced bool
+	}{
+		{name: "unchanged", inputs: config},
+		{
+			name: "changed region",
+			inputs: resource.PropertyMap{
+				"region":    resource.NewStringProperty("us-east-1"),
+				"accessKey": resource.NewStringProperty("key-1"),
+			},
+			replaced: true,
+		},
+		{
+			name: "changed credentials and region",
+			inputs: resource.PropertyMap{
+				"region":    resource.NewStringProperty("us-east-1"),
+				"accessKey": resource.NewStringProperty("key-2"),
+			},
+			replaced: true,
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			loaders := []*deploytest.ProviderLoader{
+				deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+					return &deploytest.Provider{
+						DiffConfigF: func(urn resource.URN, oldInputs, oldOutputs, newInputs resource.PropertyMap,
+							ignoreChanges []string,
+						) (plugin.DiffResult, error) {
+							// Never report any changes, even if the configuration has changed.
+							return plugin.DiffResult{Changes: plugin.DiffNone}, nil
+						},
+					}, nil
+				}),
+			}
+
+			providerInputs := config.Copy()
+			programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+				provURN, provID, _, err := monitor.RegisterResource(providers.MakeProviderType("pkgA"), "provA", true,
+					deploytest.ResourceOptions{Inputs: providerInputs})
+				assert.NoError(t, err)
+
+				if provID == "" {
+					provID = providers.UnknownID
+				}
+
+				provRef, err := providers.NewReference(provURN, provID)
+				assert.NoError(t, err)
+
+				_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+					Provider: provRef.String(),
+				})
+				assert.NoError(t, err)
+
+				return nil
+			})
+			hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+			p := &TestPlan{
+				Options: TestUpdateOptions{HostF: hostF},
+				Steps:   []TestStep{{Op: Update}},
+			}
+
+			snap := p.Run(t, nil)
+
+			// Change the config and run an update. The provider reports no changes, but if its configuration has
+			// changed we expect the provider and its resources to be replaced.
+			providerInputs = c.inputs.Copy()
+			p.Steps = []TestStep{{
+				Op: Update,
+				Validate: func(project workspace.Project, target deploy.Target, entries JournalEntries,
+					_ []Event, err error,
+				) error {
+					provURN := p.NewProviderURN("pkgA", "provA", "")
+					resURN := p.NewURN("pkgA:m:typA", "resA", "")
+
+					// Look for replace steps on the provider and the resource.
+					replaced := map[resource.URN]bool{}
+					for _, entry := range entries {
+						if entry.Kind != JournalEntrySuccess {
+							continue
+						}
+						switch entry.Step.Op() {
+						case deploy.OpSame:
+						case deploy.OpDeleteReplaced:
+							replaced[entry.Step.URN()] = true
+						case deploy.OpCreateReplacement, deploy.OpReplace:
+						default:
+							t.Fatalf("unexpected %v step for %v", entry.Step.Op(), entry.Step.URN())
+						}
+					}
+					assert.Equal(t, c.replaced, replaced[provURN])
+					assert.Equal(t, c.replaced, replaced[resURN])
+
+					return err
+				},
+			}}
+			p.Run(t, snap)
+		})
+	}
+}
+
 type configurableProvider struct {
 	id      string
 	replace bool
@@ -603,10 +603,15 @@ func (acts *updateActions) OnResourceStepPost(
 		}
 
 		if record && !isInternalStep {
-			// Increment the counters.
+			// Increment the counters. Updates that the provider reported had no effect are counted as sames.
+			countedOp := op
+			if update, ok := step.(*deploy.UpdateStep); ok {
+				countedOp = update.EffectiveOp()
+			}
+
 			acts.MapLock.Lock()
 			acts.Steps++
-			acts.Ops[op]++
+			acts.Ops[countedOp]++
 			acts.MapLock.Unlock()
 		}
 
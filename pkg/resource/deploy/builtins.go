@@ -140,11 +140,12 @@ func (p *builtinProvider) Create(urn resource.URN, inputs resource.PropertyMap,
 func (p *builtinProvider) Update(urn resource.URN, id resource.ID,
 	oldInputs, oldOutputs, newInputs resource.PropertyMap,
 	timeout float64, ignoreChanges []string, preview bool,
-) (resource.PropertyMap, resource.Status, error) {
+) (plugin.UpdateResult, resource.Status, error) {
 	contract.Failf("unexpected update for builtin resource %v", urn)
 	contract.Assertf(urn.Type() == stackReferenceType, "expected resource type %v, got %v", stackReferenceType, urn.Type())
 
-	return oldOutputs, resource.StatusOK, errors.New("unexpected update for builtin resource")
+	return plugin.UpdateResult{Outputs: oldOutputs}, resource.StatusOK,
+		errors.New("unexpected update for builtin resource")
 }
 
 func (p *builtinProvider) Delete(urn resource.URN, id resource.ID,
@@ -21,8 +21,10 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	uuid "github.com/gofrs/uuid"
+	"golang.org/x/sync/semaphore"
 
 	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
@@ -299,6 +301,137 @@ type Deployment struct {
 	goals                *goalMap                         // the set of resource goals generated by the deployment.
 	news                 *resourceMap                     // the set of new resources generated by the deployment
 	newPlans             *resourcePlans                   // the set of new resource plans.
+
+	// MaxInitErrors caps the number of initialization errors recorded in a resource's state. Reasons beyond the
+	// first MaxInitErrors are replaced with a single marker noting how many were omitted. Zero means unlimited.
+	MaxInitErrors int
+
+	// ResolveProviderRef, if non-nil, rewrites each resource's provider reference before the provider is looked up
+	// in the registry. This allows references to be redirected to a canonical provider without rewriting state.
+	ResolveProviderRef func(providers.Reference) providers.Reference
+
+	// ProviderRecorder, if non-nil, records or replays the responses of the providers used by this deployment's
+	// steps.
+	ProviderRecorder *ProviderRecorder
+
+	// RefreshConcurrency limits the number of provider Read calls that refresh steps may make at once. Zero means
+	// unlimited.
+	RefreshConcurrency int
+	// RefreshConcurrencyPerProvider applies RefreshConcurrency to each provider reference separately rather than to
+	// the deployment as a whole.
+	RefreshConcurrencyPerProvider bool
+
+	// NormalizeRefreshInputs makes refresh steps run the inputs read from each provider through the provider's Check
+	// method, so that refreshed inputs include the same defaults and normalization as the inputs of a program. This
+	// avoids spurious diffs on the next update.
+	NormalizeRefreshInputs bool
+
+	// OperationID, if non-empty, identifies this deployment operation. Each step attaches it, along with the step's
+	// URN and operation, to the calls it makes to providers that implement OperationAwareProvider.
+	OperationID string
+
+	// StepProgress, if non-nil, receives intermediate progress reports from providers while create, update, and delete
+	// steps are applied. Only in-process providers that implement ProgressProvider report progress; provider plugins
+	// never do, as progress is not part of the provider protocol. It may be called concurrently.
+	StepProgress func(urn resource.URN, message string, fraction float64)
+
+	// TargetStateOverride, if non-nil, maps resource URNs to saved target states. During a preview, update and create
+	// steps for these resources compute their diffs against the target state's inputs rather than the old state,
+	// which allows a plan to be reviewed against a known-good state file. It is ignored when steps are applied.
+	TargetStateOverride map[resource.URN]*resource.State
+
+	// VerifyAfterCreate makes create steps read each custom resource back from its provider after creating it. If the
+	// read does not find the resource, the step fails. This guards against providers whose creates are eventually
+	// consistent and may report success before the resource can be queried.
+	VerifyAfterCreate bool
+
+	// VerifyAfterDelete makes delete steps read each custom resource from its provider after deleting it, polling until
+	// the read no longer finds the resource. If the resource can still be read once the resource's delete timeout, or
+	// five minutes if it has none, has elapsed, the step fails. This guards against providers whose deletes are
+	// eventually consistent and may report success before the resource is gone.
+	VerifyAfterDelete bool
+
+	// AllowDeleteProtected permits delete steps to delete protected resources. Each such deletion is reported with a
+	// warning naming the resource. This is intended for administrative workflows and defaults to false.
+	AllowDeleteProtected bool
+
+	// DeleteGracePeriod, if positive, makes delete steps refuse to delete resources that were created less than this
+	// long ago, which guards against accidentally destroying resources that were only just created. Such deletions fail
+	// with a DeleteTooSoonError. Replacements are not affected. It defaults to zero, which disables the check.
+	DeleteGracePeriod time.Duration
+
+	// AllowDeleteWithinGracePeriod permits delete steps to delete resources regardless of DeleteGracePeriod.
+	AllowDeleteWithinGracePeriod bool
+
+	// RetryConfig, if non-nil, is the policy used to retry the provider calls of create, update, and delete steps that
+	// fail transiently. If nil, provider calls are not retried.
+	RetryConfig *RetryConfig
+
+	// StepGate, if non-nil, is called with each step immediately before the step is applied. If it returns an error, the
+	// step is not applied and fails with a StepVetoedError that wraps the returned error. It may be called concurrently.
+	StepGate func(Step) error
+
+	// AuditSink, if non-nil, receives a record of each create, update, adopt, and delete step immediately before it is
+	// applied, with secret inputs redacted. Steps are not audited during previews. It may be called concurrently.
+	AuditSink func(record AuditRecord)
+
+	// ReadCache, if non-nil, memoizes the results of read steps, so that a resource that is read by several URNs with
+	// the same provider, ID, and inputs is only read from its provider once. It should not outlive the deployment.
+	ReadCache *ReadCache
+
+	// ImportDiffCache, if non-nil, memoizes the diffs computed by import steps, so that an import that is diffed
+	// more than once with the same inputs and actual state, e.g. by a preview and then an update, is only diffed by its
+	// provider once.
+	ImportDiffCache *ImportDiffCache
+
+	// ImportLenientCheck makes imports that are not part of an import deployment report the failures of their
+	// provider's Check as warnings and proceed, rather than failing. The tolerated failures are available from
+	// ImportStep.CheckFailures.
+	ImportLenientCheck bool
+
+	// CompletionBarrier, if non-nil, defers the completion of create and update steps until the resources that they
+	// have been configured to wait for have been registered.
+	CompletionBarrier *CompletionBarrier
+
+	// AutoTags, if non-empty, are merged into the goal inputs of each registered resource before they are checked and
+	// diffed, in the input property that the resource's provider reports for tags from its TagPropertyPaths method.
+	// Tags that the program sets explicitly take precedence. Resources whose providers do not report a tag property
+	// are not tagged.
+	AutoTags map[string]string
+
+	// ApprovalPredicate, if non-nil, decides which steps require approval before they are applied, overriding the
+	// defaults of DefaultApprovalPredicate for steps that implement ApprovalStep. It does not affect how steps are
+	// applied; interactive callers may use it to decide which steps to prompt for.
+	ApprovalPredicate func(Step) bool
+
+	// FreezeTimestamps makes steps leave the Created and Modified timestamps of resources as they are rather than
+	// setting them to the time of the step. Steps that keep a resource's old state still carry its old timestamps
+	// forward. This keeps the state of a deployment reproducible, e.g. for reviewing changes to a state file in
+	// source control. It defaults to false.
+	FreezeTimestamps bool
+
+	// BatchRegisterResults makes same steps deliver their registration results in batches rather than individually.
+	// Results are delivered in the order in which their steps completed.
+	BatchRegisterResults bool
+
+	refreshLock       sync.Mutex                     // protects refreshSemaphores.
+	refreshSemaphores map[string]*semaphore.Weighted // the refresh read limits, keyed by provider if per-provider.
+
+	providerCapsLock sync.Mutex                             // protects providerCaps.
+	providerCaps     map[string]map[ProviderCapability]bool // the capabilities of each provider, keyed by reference.
+
+	registerBatch registerBatcher // the pending registration results, if results are batched.
+
+	deletionsLock    sync.Mutex            // protects deletionOutcomes.
+	deletionOutcomes map[resource.URN]bool // the outcome of each applied deletion; true if the deletion succeeded.
+
+	replacementsLock  sync.Mutex              // protects replacementCauses.
+	replacementCauses map[resource.URN]string // the cause of each replacement planned by this deployment.
+
+	retainedComponentsLock sync.Mutex                       // protects retainedComponents.
+	retainedComponents     map[resource.URN]*resource.State // the components that retained their old outputs.
+
+	verifyDeleteInterval time.Duration // the time between reads when verifying deletes; defaults to one second.
 }
 
 // addDefaultProviders adds any necessary default provider definitions and references to the given snapshot. Version
@@ -499,6 +632,110 @@ func (d *Deployment) SameProvider(res *resource.State) error {
 	return d.providers.Same(res)
 }
 
+// SameProviderWithDiff diffs the inputs of the provider state new against those of old, the provider's prior state,
+// and returns the keys of any provider configuration, such as credentials or region, that changed. If no keys changed,
+// the provider is kept the same as by SameProvider. Otherwise the provider is not registered, and the caller must
+// update or replace it instead. Inputs that only select the provider's plugin, e.g. its version, and inputs whose new
+// value is unknown are not compared. If old is nil there is nothing to compare against, and no keys are returned.
+func (d *Deployment) SameProviderWithDiff(old, new *resource.State) ([]resource.PropertyKey, error) {
+	if changed := providerConfigChanges(old, new); len(changed) > 0 {
+		return changed, nil
+	}
+	return nil, d.SameProvider(new)
+}
+
+// providerConfigChanges returns the keys of the provider configuration that differ between old and new, in sorted
+// order. See SameProviderWithDiff.
+func providerConfigChanges(old, new *resource.State) []resource.PropertyKey {
+	if old == nil {
+		return nil
+	}
+	diff := old.Inputs.Diff(new.Inputs)
+	if diff == nil {
+		return nil
+	}
+
+	var changed []resource.PropertyKey
+	for _, k := range diff.ChangedKeys() {
+		if providers.IsPluginKey(k) || new.Inputs[k].ContainsUnknowns() {
+			continue
+		}
+		changed = append(changed, k)
+	}
+	return changed
+}
+
+// FindOrphanedPending returns the resources in the previous snapshot that are stuck in a pending state without a live
+// counterpart, e.g. because the deployment that would have resolved them was aborted. A resource is stuck if it is
+// pending deletion or pending replacement, and it is live if it is neither. Resources are returned in snapshot order.
+// Resources that are pending replacement can be cleaned up with a RemovePendingReplaceStep.
+func (d *Deployment) FindOrphanedPending() []*resource.State {
+	if d.prev == nil {
+		return nil
+	}
+
+	live := map[resource.URN]bool{}
+	for _, res := range d.prev.Resources {
+		if !res.Delete && !res.PendingReplacement {
+			live[res.URN] = true
+		}
+	}
+
+	var orphaned []*resource.State
+	for _, res := range d.prev.Resources {
+		if (res.Delete || res.PendingReplacement) && !live[res.URN] {
+			orphaned = append(orphaned, res)
+		}
+	}
+	return orphaned
+}
+
+// acquireRefreshRead blocks until a refresh step for a resource that uses the given provider reference is permitted
+// to call the provider's Read method under the deployment's RefreshConcurrency limit. It returns a function that must
+// be called once the Read has completed.
+func (d *Deployment) acquireRefreshRead(provider string) func() {
+	if d.RefreshConcurrency <= 0 {
+		return func() {}
+	}
+
+	key := ""
+	if d.RefreshConcurrencyPerProvider {
+		key = provider
+	}
+
+	d.refreshLock.Lock()
+	sem, ok := d.refreshSemaphores[key]
+	if !ok {
+		if d.refreshSemaphores == nil {
+			d.refreshSemaphores = make(map[string]*semaphore.Weighted)
+		}
+		sem = semaphore.NewWeighted(int64(d.RefreshConcurrency))
+		d.refreshSemaphores[key] = sem
+	}
+	d.refreshLock.Unlock()
+
+	err := sem.Acquire(context.Background(), 1)
+	contract.AssertNoErrorf(err, "acquiring a semaphore without a deadline cannot fail")
+	return func() { sem.Release(1) }
+}
+
+// truncateInitErrors limits the given initialization error reasons to the deployment's MaxInitErrors, if any.
+func (d *Deployment) truncateInitErrors(reasons []string) []string {
+	if d == nil || d.MaxInitErrors <= 0 || len(reasons) <= d.MaxInitErrors {
+		return reasons
+	}
+
+	omitted := len(reasons) - d.MaxInitErrors
+	truncated := make([]string, 0, d.MaxInitErrors+1)
+	truncated = append(truncated, reasons[:d.MaxInitErrors]...)
+	return append(truncated, fmt.Sprintf("(%d more omitted)", omitted))
+}
+
+// timestampsFrozen returns true if steps should not set the Created and Modified timestamps of resources.
+func (d *Deployment) timestampsFrozen() bool {
+	return d != nil && d.FreezeTimestamps
+}
+
 // EnsureProvider ensures that the provider for the given resource is available in the registry. It assumes
 // the provider is available in the previous snapshot.
 func (d *Deployment) EnsureProvider(provider string) error {
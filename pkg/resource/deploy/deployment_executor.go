@@ -179,6 +179,9 @@ func (ex *deploymentExecutor) Execute(callerCtx context.Context, opts Options, p
 	// Set up a step generator and executor for this deployment.
 	ex.stepExec = newStepExecutor(ctx, cancel, ex.deployment, opts, preview, false)
 
+	// Release any steps that are waiting for their dependents if the deployment is canceled.
+	ex.deployment.CompletionBarrier.ReleaseOnDone(ctx)
+
 	// We iterate the source in its own goroutine because iteration is blocking and we want the main loop to be able to
 	// respond to cancellation requests promptly.
 	type nextEvent struct {
@@ -228,6 +231,10 @@ func (ex *deploymentExecutor) Execute(callerCtx context.Context, opts Options, p
 				}
 
 				if event.Event == nil {
+					// The program has finished, so no more resources will be registered. Release any steps that are
+					// still waiting for their dependents before waiting for deletes.
+					ex.deployment.CompletionBarrier.Release()
+
 					// Check targets before performDeletes mutates the initial Snapshot.
 					targetErr := ex.checkTargets(opts.Targets)
 
@@ -265,6 +272,10 @@ func (ex *deploymentExecutor) Execute(callerCtx context.Context, opts Options, p
 		}
 	}()
 
+	// No more resources will be registered, so release any steps that are still waiting for their dependents. This
+	// also stops the goroutine that releases the barrier on cancellation.
+	ex.deployment.CompletionBarrier.Release()
+
 	ex.stepExec.WaitForCompletion()
 	logging.V(4).Infof("deploymentExecutor.Execute(...): step executor has completed")
 
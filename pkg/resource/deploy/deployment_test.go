@@ -4,6 +4,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/blang/semver"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
 	"github.com/pulumi/pulumi/pkg/v3/secrets/b64"
 	"github.com/pulumi/pulumi/pkg/v3/version"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
@@ -48,6 +52,28 @@ func TestPendingOperationsDeployment(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestFindOrphanedPending(t *testing.T) {
+	t.Parallel()
+
+	live := newResource("live")
+	replaced := newResource("replaced")
+	replaced.Delete = true
+	replacement := newResource("replaced")
+	stuckReplace := newResource("stuck-replace")
+	stuckReplace.PendingReplacement = true
+	stuckDelete := newResource("stuck-delete")
+	stuckDelete.Delete = true
+	snap := newSnapshot([]*resource.State{live, replaced, replacement, stuckReplace, stuckDelete}, nil)
+
+	d, err := NewDeployment(&plugin.Context{}, &Target{}, snap, nil, NewNullSource("test"), nil, false, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []*resource.State{stuckReplace, stuckDelete}, d.FindOrphanedPending())
+
+	d, err = NewDeployment(&plugin.Context{}, &Target{}, nil, nil, NewNullSource("test"), nil, false, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, d.FindOrphanedPending())
+}
+
 func TestGlobUrn(t *testing.T) {
 	t.Parallel()
 
@@ -110,3 +136,104 @@ func TestGlobUrn(t *testing.T) {
 		})
 	}
 }
+
+func newProviderResource(pkg, name, id string, inputs resource.PropertyMap) *resource.State {
+	ty := providers.MakeProviderType(tokens.Package(pkg))
+	return &resource.State{
+		Type:    ty,
+		URN:     resource.NewURN(tokens.QName("teststack"), tokens.PackageName("pkg"), "", ty, name),
+		Custom:  true,
+		ID:      resource.ID(id),
+		Inputs:  inputs,
+		Outputs: inputs,
+	}
+}
+
+func TestSameProviderWithDiff(t *testing.T) {
+	t.Parallel()
+
+	newDeployment := func() *Deployment {
+		loaders := []*deploytest.ProviderLoader{
+			deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+				return &deploytest.Provider{}, nil
+			}),
+		}
+		host := deploytest.NewPluginHost(nil, nil, nil, loaders...)
+		return &Deployment{providers: providers.NewRegistry(host, false, nil)}
+	}
+
+	config := resource.PropertyMap{
+		"region":    resource.NewStringProperty("us-west-2"),
+		"accessKey": resource.NewStringProperty("key-1"),
+	}
+
+	t.Run("no prior state", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeployment()
+		new := newProviderResource("pkgA", "prov", "id", config)
+		changed, err := d.SameProviderWithDiff(nil, new)
+		assert.NoError(t, err)
+		assert.Empty(t, changed)
+
+		ref, err := providers.NewReference(new.URN, new.ID)
+		assert.NoError(t, err)
+		_, ok := d.GetProvider(ref)
+		assert.True(t, ok)
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeployment()
+		changed, err := d.SameProviderWithDiff(newProviderResource("pkgA", "prov", "id", config),
+			newProviderResource("pkgA", "prov", "id", config.Copy()))
+		assert.NoError(t, err)
+		assert.Empty(t, changed)
+	})
+
+	t.Run("changed credentials and region", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeployment()
+		new := newProviderResource("pkgA", "prov", "id", resource.PropertyMap{
+				"region":    resource.NewStringProperty("us-east-1"),
+				"accessKey": resource.NewStringProperty("key-2"),
+		})
+		changed, err := d.SameProviderWithDiff(newProviderResource("pkgA", "prov", "id", config), new)
+		assert.NoError(t, err)
+		assert.Equal(t, []resource.PropertyKey{"accessKey", "region"}, changed)
+
+		// The provider must be replaced, so it is not registered with its old ID.
+		ref, err := providers.NewReference(new.URN, new.ID)
+		assert.NoError(t, err)
+		_, ok := d.GetProvider(ref)
+		assert.False(t, ok)
+	})
+
+	t.Run("changed region only", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeployment()
+		new := newProviderResource("pkgA", "prov", "id", resource.PropertyMap{
+				"region":    resource.NewStringProperty("eu-west-1"),
+				"accessKey": resource.NewStringProperty("key-1"),
+		})
+		changed, err := d.SameProviderWithDiff(newProviderResource("pkgA", "prov", "id", config), new)
+		assert.NoError(t, err)
+		assert.Equal(t, []resource.PropertyKey{"region"}, changed)
+	})
+
+	t.Run("changed version only", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeployment()
+		old := newProviderResource("pkgA", "prov", "id", config.Copy())
+		new := newProviderResource("pkgA", "prov", "id", config.Copy())
+		old.Inputs["version"] = resource.NewStringProperty("0.9.0")
+		new.Inputs["version"] = resource.NewStringProperty("1.0.0")
+		changed, err := d.SameProviderWithDiff(old, new)
+		assert.NoError(t, err)
+		assert.Empty(t, changed)
+	})
+}
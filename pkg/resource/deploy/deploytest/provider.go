@@ -44,6 +44,10 @@ type Provider struct {
 		ignoreChanges []string) (plugin.DiffResult, error)
 	ConfigureF func(news resource.PropertyMap) error
 
+	CapabilitiesF          func() ([]string, error)
+	CompositeIDSeparatorsF func() (map[tokens.Type]string, error)
+	TagPropertyPathsF      func() (map[tokens.Type]resource.PropertyPath, error)
+
 	CheckF func(urn resource.URN,
 		olds, news resource.PropertyMap, randomSeed []byte) (resource.PropertyMap, []plugin.CheckFailure, error)
 	DiffF func(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap,
@@ -70,6 +74,10 @@ type Provider struct {
 
 	GetMappingF  func(key, provider string) ([]byte, string, error)
 	GetMappingsF func(key string) ([]string, error)
+
+	HealthF func() error
+
+	AnnotateF func(urn resource.URN, id resource.ID, annotations map[string]string) error
 }
 
 func (prov *Provider) SignalCancellation() error {
@@ -130,6 +138,27 @@ func (prov *Provider) Configure(inputs resource.PropertyMap) error {
 	return prov.ConfigureF(inputs)
 }
 
+func (prov *Provider) Capabilities() ([]string, error) {
+	if prov.CapabilitiesF == nil {
+		return nil, nil
+	}
+	return prov.CapabilitiesF()
+}
+
+func (prov *Provider) CompositeIDSeparators() (map[tokens.Type]string, error) {
+	if prov.CompositeIDSeparatorsF == nil {
+		return nil, nil
+	}
+	return prov.CompositeIDSeparatorsF()
+}
+
+func (prov *Provider) TagPropertyPaths() (map[tokens.Type]resource.PropertyPath, error) {
+	if prov.TagPropertyPathsF == nil {
+		return nil, nil
+	}
+	return prov.TagPropertyPathsF()
+}
+
 func (prov *Provider) Check(urn resource.URN,
 	olds, news resource.PropertyMap, _ bool, randomSeed []byte,
 ) (resource.PropertyMap, []plugin.CheckFailure, error) {
@@ -165,11 +194,12 @@ func (prov *Provider) Diff(urn resource.URN, id resource.ID,
 
 func (prov *Provider) Update(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap,
 	timeout float64, ignoreChanges []string, preview bool,
-) (resource.PropertyMap, resource.Status, error) {
+) (plugin.UpdateResult, resource.Status, error) {
 	if prov.UpdateF == nil {
-		return newInputs, resource.StatusOK, nil
+		return plugin.UpdateResult{Outputs: newInputs}, resource.StatusOK, nil
 	}
-	return prov.UpdateF(urn, id, oldInputs, oldOutputs, newInputs, timeout, ignoreChanges, preview)
+	outs, status, err := prov.UpdateF(urn, id, oldInputs, oldOutputs, newInputs, timeout, ignoreChanges, preview)
+	return plugin.UpdateResult{Outputs: outs}, status, err
 }
 
 func (prov *Provider) Delete(urn resource.URN,
@@ -250,3 +280,17 @@ func (prov *Provider) GetMappings(key string) ([]string, error) {
 	}
 	return prov.GetMappingsF(key)
 }
+
+func (prov *Provider) Health() error {
+	if prov.HealthF == nil {
+		return nil
+	}
+	return prov.HealthF()
+}
+
+func (prov *Provider) Annotate(urn resource.URN, id resource.ID, annotations map[string]string) error {
+	if prov.AnnotateF == nil {
+		return nil
+	}
+	return prov.AnnotateF(urn, id, annotations)
+}
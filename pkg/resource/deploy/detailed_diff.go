@@ -0,0 +1,189 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"sort"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+)
+
+// DetailedDiffStep is a step that carries the detailed diff that was computed for its resource.
+type DetailedDiffStep interface {
+	Step
+
+	// DetailedDiff returns the detailed diff for the step, keyed by property path. The result is nil if the provider
+	// did not return a detailed diff.
+	DetailedDiff() map[string]plugin.PropertyDiff
+	// DiffSummary counts the entries in the step's detailed diff by kind, as SummarizeDetailedDiff does.
+	DiffSummary() (adds, updates, deletes, replaces int)
+}
+
+var (
+	_ DetailedDiffStep = (*CreateStep)(nil)
+	_ DetailedDiffStep = (*UpdateStep)(nil)
+	_ DetailedDiffStep = (*ReplaceStep)(nil)
+	_ DetailedDiffStep = (*ImportStep)(nil)
+)
+
+// SummarizeDetailedDiff counts the entries in a detailed diff by kind. Each property path is counted exactly once:
+// paths whose diff requires a replacement are counted as replaces regardless of whether the property was added,
+// updated, or deleted, and all other paths are counted by their kind.
+func SummarizeDetailedDiff(d map[string]plugin.PropertyDiff) (adds, updates, deletes, replaces int) {
+	for _, diff := range d {
+		switch {
+		case diff.Kind.IsReplace():
+			replaces++
+		case diff.Kind == plugin.DiffAdd:
+			adds++
+		case diff.Kind == plugin.DiffDelete:
+			deletes++
+		default:
+			updates++
+		}
+	}
+	return adds, updates, deletes, replaces
+}
+
+// DiffPathsByKind returns the property paths in a detailed diff whose diff is exactly the given kind, in sorted order.
+func DiffPathsByKind(d map[string]plugin.PropertyDiff, kind plugin.DiffKind) []string {
+	var paths []string
+	for path, diff := range d {
+		if diff.Kind == kind {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// replacePaths returns the property paths in a detailed diff whose diff requires a replacement, in sorted order.
+func replacePaths(d map[string]plugin.PropertyDiff) []string {
+	var paths []string
+	for _, kind := range []plugin.DiffKind{plugin.DiffAddReplace, plugin.DiffDeleteReplace, plugin.DiffUpdateReplace} {
+		paths = append(paths, DiffPathsByKind(d, kind)...)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func (s *CreateStep) DiffSummary() (adds, updates, deletes, replaces int) {
+	return SummarizeDetailedDiff(s.detailedDiff)
+}
+
+func (s *UpdateStep) DiffSummary() (adds, updates, deletes, replaces int) {
+	return SummarizeDetailedDiff(s.detailedDiff)
+}
+
+func (s *ReplaceStep) DiffSummary() (adds, updates, deletes, replaces int) {
+	return SummarizeDetailedDiff(s.detailedDiff)
+}
+
+func (s *ImportStep) DiffSummary() (adds, updates, deletes, replaces int) {
+	return SummarizeDetailedDiff(s.detailedDiff)
+}
+
+// ReplacementReason describes a property change that caused a resource to be replaced.
+type ReplacementReason struct {
+	Path string                 // the path of the property that changed.
+	Kind plugin.DiffKind        // the kind of the change.
+	Old  resource.PropertyValue // the old value of the property, or null if it had none.
+	New  resource.PropertyValue // the new value of the property, or null if it has none.
+}
+
+// ReplacementReasons returns the property changes that caused this create to replace an existing resource, sorted by
+// path. Each reason pairs a detailed diff entry with the replacement key that it falls under. If there is no detailed
+// diff for a replacement key, the key itself is reported as an update that requires replacement. The result is nil if
+// this create is not a replacement.
+func (s *CreateStep) ReplacementReasons() []ReplacementReason {
+	if !s.replacing || len(s.keys) == 0 {
+		return nil
+	}
+
+	keys := map[resource.PropertyKey]bool{}
+	for _, k := range s.keys {
+		keys[k] = true
+	}
+
+	var reasons []ReplacementReason
+	covered := map[resource.PropertyKey]bool{}
+	for path, diff := range s.detailedDiff {
+		parsed, err := resource.ParsePropertyPath(path)
+		if err != nil || len(parsed) == 0 {
+			continue
+		}
+		key, ok := parsed[0].(string)
+		if !ok || !keys[resource.PropertyKey(key)] {
+			continue
+		}
+		covered[resource.PropertyKey(key)] = true
+
+		olds := s.old.Inputs
+		if !diff.InputDiff {
+			olds = s.old.Outputs
+		}
+		reasons = append(reasons, ReplacementReason{
+			Path: path,
+			Kind: diff.Kind,
+			Old:  propertyAtPath(olds, parsed),
+			New:  propertyAtPath(s.new.Inputs, parsed),
+		})
+	}
+	for _, k := range s.keys {
+		if covered[k] {
+			continue
+		}
+		reasons = append(reasons, ReplacementReason{
+			Path: string(k),
+			Kind: plugin.DiffUpdateReplace,
+			Old:  propertyAtPath(s.old.Inputs, resource.PropertyPath{string(k)}),
+			New:  propertyAtPath(s.new.Inputs, resource.PropertyPath{string(k)}),
+		})
+	}
+
+	sort.Slice(reasons, func(i, j int) bool { return reasons[i].Path < reasons[j].Path })
+	return reasons
+}
+
+// ReplacementKeys returns the top-level properties whose detailed diff requires a replacement, in sorted order. These
+// are the properties that would force the resource to be replaced if the import were applied as an update. The result
+// is empty if the detailed diff does not require a replacement.
+func (s *ImportStep) ReplacementKeys() []resource.PropertyKey {
+	seen := map[resource.PropertyKey]bool{}
+	var keys []resource.PropertyKey
+	for _, path := range replacePaths(s.detailedDiff) {
+		key := resource.PropertyKey(path)
+		if parsed, err := resource.ParsePropertyPath(path); err == nil && len(parsed) > 0 {
+			if name, ok := parsed[0].(string); ok {
+				key = resource.PropertyKey(name)
+			}
+		}
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+// propertyAtPath returns the value at the given path in a property map, or null if there is no such value.
+func propertyAtPath(props resource.PropertyMap, path resource.PropertyPath) resource.PropertyValue {
+	if v, ok := path.Get(resource.NewObjectProperty(props)); ok {
+		return v
+	}
+	return resource.NewNullProperty()
+}
@@ -0,0 +1,156 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+)
+
+func TestSummarizeDetailedDiff(t *testing.T) {
+	t.Parallel()
+
+	d := map[string]plugin.PropertyDiff{
+		"tags.env":              {Kind: plugin.DiffAdd},
+		"tags.owner":            {Kind: plugin.DiffAdd},
+		"rules[0].port":         {Kind: plugin.DiffUpdate},
+		"rules[1]":              {Kind: plugin.DiffDelete},
+		"nested.inner[\"a.b\"]": {Kind: plugin.DiffUpdate, InputDiff: true},
+		"name":                  {Kind: plugin.DiffUpdateReplace},
+		"zone":                  {Kind: plugin.DiffAddReplace},
+		"vpc.subnets[2].cidr":   {Kind: plugin.DiffDeleteReplace},
+	}
+
+	adds, updates, deletes, replaces := SummarizeDetailedDiff(d)
+	assert.Equal(t, 2, adds)
+	assert.Equal(t, 2, updates)
+	assert.Equal(t, 1, deletes)
+	assert.Equal(t, 3, replaces)
+
+	assert.Equal(t, []string{"tags.env", "tags.owner"}, DiffPathsByKind(d, plugin.DiffAdd))
+	assert.Equal(t, []string{"nested.inner[\"a.b\"]", "rules[0].port"}, DiffPathsByKind(d, plugin.DiffUpdate))
+	assert.Equal(t, []string{"rules[1]"}, DiffPathsByKind(d, plugin.DiffDelete))
+	assert.Equal(t, []string{"vpc.subnets[2].cidr"}, DiffPathsByKind(d, plugin.DiffDeleteReplace))
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		adds, updates, deletes, replaces := SummarizeDetailedDiff(nil)
+		assert.Zero(t, adds+updates+deletes+replaces)
+		assert.Empty(t, DiffPathsByKind(nil, plugin.DiffAdd))
+	})
+
+	t.Run("steps", func(t *testing.T) {
+		t.Parallel()
+
+		ref, err := providers.NewReference("urn:pulumi:teststack::pkg::pulumi:providers:pkgA::default", "provider-id")
+		require.NoError(t, err)
+		old, new := newStepTestResource("res", ref), newStepTestResource("res", ref)
+		old.ID = "id"
+
+		steps := []DetailedDiffStep{
+			NewCreateReplacementStep(nil, doneEvent{}, old, new, nil, nil, d, true).(*CreateStep),
+			NewUpdateStep(nil, doneEvent{}, old, new, nil, nil, d, nil).(*UpdateStep),
+			NewReplaceStep(nil, old, new, nil, nil, d, true).(*ReplaceStep),
+			&ImportStep{old: old, new: new, detailedDiff: d},
+		}
+		for _, step := range steps {
+			adds, updates, deletes, replaces := step.DiffSummary()
+			assert.Equal(t, []int{2, 2, 1, 3}, []int{adds, updates, deletes, replaces}, "%v", step.Op())
+		}
+	})
+}
+
+func TestCreateStepReplacementReasons(t *testing.T) {
+	t.Parallel()
+
+	ref, err := providers.NewReference("urn:pulumi:teststack::pkg::pulumi:providers:pkgA::default", "provider-id")
+	require.NoError(t, err)
+
+	newStates := func() (*resource.State, *resource.State) {
+		old := newStepTestResource("res", ref)
+		old.ID = "id"
+		old.Inputs = resource.NewPropertyMapFromMap(map[string]interface{}{
+			"zone": "us-east-1a",
+			"vpc":  map[string]interface{}{"cidr": "10.0.0.0/16"},
+			"tags": map[string]interface{}{"env": "dev"},
+		})
+		new := newStepTestResource("res", ref)
+		new.Inputs = resource.NewPropertyMapFromMap(map[string]interface{}{
+			"zone": "us-west-2a",
+			"vpc":  map[string]interface{}{"cidr": "10.1.0.0/16"},
+			"tags": map[string]interface{}{"env": "prod"},
+		})
+		return old, new
+	}
+
+	t.Run("detailed diff", func(t *testing.T) {
+		t.Parallel()
+
+		old, new := newStates()
+		step := NewCreateReplacementStep(nil, doneEvent{}, old, new,
+			[]resource.PropertyKey{"vpc", "zone"}, []resource.PropertyKey{"tags", "vpc", "zone"},
+			map[string]plugin.PropertyDiff{
+				"zone":     {Kind: plugin.DiffUpdateReplace, InputDiff: true},
+				"vpc.cidr": {Kind: plugin.DiffUpdateReplace, InputDiff: true},
+				"tags.env": {Kind: plugin.DiffUpdate, InputDiff: true},
+			}, true).(*CreateStep)
+
+		assert.Equal(t, []ReplacementReason{
+			{
+				Path: "vpc.cidr",
+				Kind: plugin.DiffUpdateReplace,
+				Old:  resource.NewStringProperty("10.0.0.0/16"),
+				New:  resource.NewStringProperty("10.1.0.0/16"),
+			},
+			{
+				Path: "zone",
+				Kind: plugin.DiffUpdateReplace,
+				Old:  resource.NewStringProperty("us-east-1a"),
+				New:  resource.NewStringProperty("us-west-2a"),
+			},
+		}, step.ReplacementReasons())
+	})
+
+	t.Run("no detailed diff", func(t *testing.T) {
+		t.Parallel()
+
+		old, new := newStates()
+		delete(new.Inputs, "zone")
+		step := NewCreateReplacementStep(nil, doneEvent{}, old, new,
+			[]resource.PropertyKey{"zone"}, []resource.PropertyKey{"zone"}, nil, true).(*CreateStep)
+
+		assert.Equal(t, []ReplacementReason{{
+			Path: "zone",
+			Kind: plugin.DiffUpdateReplace,
+			Old:  resource.NewStringProperty("us-east-1a"),
+			New:  resource.NewNullProperty(),
+		}}, step.ReplacementReasons())
+	})
+
+	t.Run("not a replacement", func(t *testing.T) {
+		t.Parallel()
+
+		_, new := newStates()
+		step := NewCreateStep(nil, doneEvent{}, new).(*CreateStep)
+		assert.Nil(t, step.ReplacementReasons())
+	})
+}
@@ -16,7 +16,6 @@ package deploy
 
 import (
 	"context"
-	cryptorand "crypto/rand"
 	"fmt"
 	"sort"
 
@@ -410,39 +409,18 @@ func (i *importer) importResources(ctx context.Context) error {
 					randomSeed = resourcePlan.Seed
 				}
 			} else {
-				randomSeed = make([]byte, 32)
-				n, err := cryptorand.Read(randomSeed)
-				contract.AssertNoErrorf(err, "could not read random bytes")
-				contract.Assertf(n == len(randomSeed), "read %d random bytes, expected %d", n, len(randomSeed))
+				// Otherwise derive the seed from the URN so that re-running the import produces the same inputs.
+				randomSeed = DeriveRandomSeed(urn)
 			}
 
 			steps = append(steps, newImportDeploymentStep(i.deployment, new, randomSeed))
 		}
 	}
 
-	// We've created all the steps above but we need to execute them in parallel batches which don't depend on each other
-	for len(urns) > 0 {
-		// Find all the steps that can be executed in parallel. `urns` is a map of every resource we still
-		// need to import so if we need a resource from that map we can't yet build this resource.
-		parallelSteps := []Step{}
-		for _, step := range steps {
-			// If we've already done this step don't do it again
-			if _, ok := urns[step.New().URN]; !ok {
-				continue
-			}
-
-			// If the step has no dependencies (we actually only need to look at parent), it can be executed in parallel
-			if _, ok := urns[step.New().Parent]; !ok {
-				parallelSteps = append(parallelSteps, step)
-			}
-		}
-
-		// Remove all the urns we're about to import
-		for _, step := range parallelSteps {
-			delete(urns, step.New().URN)
-		}
-
-		if !i.executeParallel(ctx, parallelSteps...) {
+	// We've created all the steps above but we need to execute them in parallel batches which don't depend on each
+	// other, so that each resource's parent has been registered before the resource itself is imported.
+	for _, batch := range importBatches(steps) {
+		if !i.executeParallel(ctx, batch...) {
 			return nil
 		}
 	}
@@ -453,3 +431,37 @@ func (i *importer) importResources(ctx context.Context) error {
 
 	return nil
 }
+
+// importBatches groups the given import steps into batches that can each be executed in parallel. A step whose parent
+// is also being imported is placed in a later batch than its parent's step, as an import checks that its parent has
+// already been registered. Steps retain their relative order within each batch.
+func importBatches(steps []Step) [][]Step {
+	// pending holds every resource that has not yet been placed in a batch. A step cannot be placed while its parent
+	// is still pending.
+	pending := make(map[resource.URN]struct{}, len(steps))
+	for _, step := range steps {
+		pending[step.New().URN] = struct{}{}
+	}
+
+	var batches [][]Step
+	for len(pending) > 0 {
+		var batch []Step
+		for _, step := range steps {
+			new := step.New()
+			if _, ok := pending[new.URN]; !ok {
+				continue
+			}
+			if _, ok := pending[new.Parent]; !ok {
+				batch = append(batch, step)
+			}
+		}
+		// A resource's URN is derived from its parent's, so parents cannot form a cycle.
+		contract.Assertf(len(batch) != 0, "no import steps are ready among %d remaining", len(pending))
+
+		for _, step := range batch {
+			delete(pending, step.New().URN)
+		}
+		batches = append(batches, batch)
+	}
+	return batches
+}
@@ -0,0 +1,49 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+func TestImportBatches(t *testing.T) {
+	t.Parallel()
+
+	stack := resource.NewURN("test", "test", "", resource.RootStackType, "test")
+	component := func(name string, parent resource.URN) Step {
+		var pt tokens.Type
+		if parent != stack {
+			pt = parent.QualifiedType()
+		}
+		urn := resource.NewURN("test", "test", pt, "my:component:Type", name)
+		return newImportDeploymentStep(nil, &resource.State{Type: urn.Type(), URN: urn, Parent: parent}, nil)
+	}
+
+	// The steps are listed children first: c is a child of b, which is a child of a. d has no parent being imported.
+	a := component("a", stack)
+	b := component("b", a.URN())
+	c := component("c", b.URN())
+	d := component("d", stack)
+
+	batches := importBatches([]Step{c, b, d, a})
+	assert.Equal(t, [][]Step{{d, a}, {b}, {c}}, batches)
+
+	assert.Empty(t, importBatches(nil))
+}
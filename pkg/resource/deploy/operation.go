@@ -0,0 +1,154 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"github.com/pulumi/pulumi/pkg/v3/display"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// OperationMetadata identifies the step on whose behalf a provider is being called. It allows provider logs to be
+// correlated with the engine's traces.
+type OperationMetadata struct {
+	OperationID string         // the ID of the deployment operation.
+	URN         resource.URN   // the URN of the resource that the step operates on.
+	Op          display.StepOp // the operation that the step performs.
+}
+
+// OperationAwareProvider is implemented by providers that accept the metadata of the operation that calls them.
+type OperationAwareProvider interface {
+	plugin.Provider
+
+	// WithOperation returns a provider that attaches the given metadata to each of its calls. The original provider
+	// must not be modified, as it may be shared by concurrently executing steps.
+	WithOperation(meta OperationMetadata) plugin.Provider
+}
+
+// operationID returns the deployment's operation ID, or the empty string if there is no deployment.
+func (d *Deployment) operationID() string {
+	if d == nil {
+		return ""
+	}
+	return d.OperationID
+}
+
+// withOperation binds the metadata of the given step to the provider if the step's deployment has an operation ID
+// and the provider accepts operation metadata. Otherwise, the provider is returned as-is. The returned provider
+// implements the same optional interfaces as the original provider, even if the one that binds the metadata does not.
+func withOperation(s Step, prov plugin.Provider) plugin.Provider {
+	id := s.OperationID()
+	if id == "" {
+		return prov
+	}
+	aware, ok := prov.(OperationAwareProvider)
+	if !ok {
+		return prov
+	}
+	bound := aware.WithOperation(OperationMetadata{OperationID: id, URN: s.URN(), Op: s.Op()})
+	return &operationBoundProvider{Provider: bound, original: prov}
+}
+
+// operationBoundProvider is a provider that has operation metadata bound to it. Each optional provider interface is
+// forwarded to the bound provider if it implements the interface, and otherwise to the original provider, so that
+// binding metadata never hides the capabilities of the original provider.
+type operationBoundProvider struct {
+	plugin.Provider // the provider that the metadata is bound to.
+
+	original plugin.Provider // the provider that the metadata was bound from.
+}
+
+var (
+	_ ProgressProvider            = (*operationBoundProvider)(nil)
+	_ plugin.CapabilitiesProvider = (*operationBoundProvider)(nil)
+	_ plugin.CompositeIDProvider  = (*operationBoundProvider)(nil)
+	_ plugin.TaggingProvider      = (*operationBoundProvider)(nil)
+	_ plugin.HealthCheckProvider  = (*operationBoundProvider)(nil)
+	_ plugin.AnnotatingProvider   = (*operationBoundProvider)(nil)
+)
+
+// forward returns a provider that forwards optional interface calls to the bound provider if implements reports that
+// it implements the interface, or to the original provider if only the original does.
+func (p *operationBoundProvider) forward(implements func(plugin.Provider) bool) forwardingProvider {
+	if !implements(p.Provider) && implements(p.original) {
+		return forwardingProvider{Provider: p.original}
+	}
+	return forwardingProvider{Provider: p.Provider}
+}
+
+func isProgressProvider(prov plugin.Provider) bool { _, ok := prov.(ProgressProvider); return ok }
+
+func isCapabilitiesProvider(prov plugin.Provider) bool {
+	_, ok := prov.(plugin.CapabilitiesProvider)
+	return ok
+}
+
+func isCompositeIDProvider(prov plugin.Provider) bool {
+	_, ok := prov.(plugin.CompositeIDProvider)
+	return ok
+}
+
+func isTaggingProvider(prov plugin.Provider) bool { _, ok := prov.(plugin.TaggingProvider); return ok }
+
+func isHealthCheckProvider(prov plugin.Provider) bool {
+	_, ok := prov.(plugin.HealthCheckProvider)
+	return ok
+}
+
+func isAnnotatingProvider(prov plugin.Provider) bool {
+	_, ok := prov.(plugin.AnnotatingProvider)
+	return ok
+}
+
+func (p *operationBoundProvider) CreateWithProgress(urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool, progress ProgressFunc,
+) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	return p.forward(isProgressProvider).CreateWithProgress(urn, news, timeout, preview, progress)
+}
+
+func (p *operationBoundProvider) UpdateWithProgress(urn resource.URN, id resource.ID,
+	oldInputs, oldOutputs, newInputs resource.PropertyMap, timeout float64, ignoreChanges []string, preview bool,
+	progress ProgressFunc,
+) (plugin.UpdateResult, resource.Status, error) {
+	return p.forward(isProgressProvider).UpdateWithProgress(urn, id, oldInputs, oldOutputs, newInputs, timeout,
+		ignoreChanges, preview, progress)
+}
+
+func (p *operationBoundProvider) DeleteWithProgress(urn resource.URN, id resource.ID,
+	oldInputs, oldOutputs resource.PropertyMap, timeout float64, progress ProgressFunc,
+) (resource.Status, error) {
+	return p.forward(isProgressProvider).DeleteWithProgress(urn, id, oldInputs, oldOutputs, timeout, progress)
+}
+
+func (p *operationBoundProvider) Capabilities() ([]string, error) {
+	return p.forward(isCapabilitiesProvider).Capabilities()
+}
+
+func (p *operationBoundProvider) CompositeIDSeparators() (map[tokens.Type]string, error) {
+	return p.forward(isCompositeIDProvider).CompositeIDSeparators()
+}
+
+func (p *operationBoundProvider) TagPropertyPaths() (map[tokens.Type]resource.PropertyPath, error) {
+	return p.forward(isTaggingProvider).TagPropertyPaths()
+}
+
+func (p *operationBoundProvider) Health() error {
+	return p.forward(isHealthCheckProvider).Health()
+}
+
+func (p *operationBoundProvider) Annotate(urn resource.URN, id resource.ID, annotations map[string]string) error {
+	return p.forward(isAnnotatingProvider).Annotate(urn, id, annotations)
+}
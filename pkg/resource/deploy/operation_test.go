@@ -0,0 +1,139 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+)
+
+// operationProvider is a test provider that records the operation metadata attached to each call to Create.
+type operationProvider struct {
+	*deploytest.Provider
+
+	meta  OperationMetadata
+	calls *[]OperationMetadata
+}
+
+var _ OperationAwareProvider = (*operationProvider)(nil)
+
+func (p *operationProvider) WithOperation(meta OperationMetadata) plugin.Provider {
+	return &operationProvider{Provider: p.Provider, meta: meta, calls: p.calls}
+}
+
+func (p *operationProvider) Create(urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool,
+) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	*p.calls = append(*p.calls, p.meta)
+	return "created-id", news, resource.StatusOK, nil
+}
+
+func TestStepOperationID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("set", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []OperationMetadata
+		deployment, ref := newStepTestDeployment(t, &operationProvider{Provider: &deploytest.Provider{}, calls: &calls})
+		deployment.OperationID = "op-123"
+
+		res := newStepTestResource("res", ref)
+		step := NewCreateStep(deployment, doneEvent{}, res)
+		assert.Equal(t, "op-123", step.OperationID())
+
+		_, _, err := step.Apply(false)
+		require.NoError(t, err)
+		assert.Equal(t, []OperationMetadata{{OperationID: "op-123", URN: res.URN, Op: OpCreate}}, calls)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []OperationMetadata
+		deployment, ref := newStepTestDeployment(t, &operationProvider{Provider: &deploytest.Provider{}, calls: &calls})
+
+		step := NewCreateStep(deployment, doneEvent{}, newStepTestResource("res", ref))
+		assert.Empty(t, step.OperationID())
+
+		_, _, err := step.Apply(false)
+		require.NoError(t, err)
+		assert.Equal(t, []OperationMetadata{{}}, calls)
+	})
+
+	t.Run("no deployment", func(t *testing.T) {
+		t.Parallel()
+
+		ref, err := providers.NewReference("urn:pulumi:teststack::pkg::pulumi:providers:pkgA::default", "provider-id")
+		require.NoError(t, err)
+
+		old := newStepTestResource("res", ref)
+		old.ID = "id"
+		assert.Empty(t, NewDeleteStep(nil, map[resource.URN]bool{}, old).OperationID())
+	})
+}
+
+// progressOperationProvider is a test provider that reports progress, but whose WithOperation returns a provider that
+// does not.
+type progressOperationProvider struct {
+	*progressProvider
+
+	calls *[]OperationMetadata
+}
+
+var _ OperationAwareProvider = (*progressOperationProvider)(nil)
+
+func (p *progressOperationProvider) WithOperation(meta OperationMetadata) plugin.Provider {
+	return &operationProvider{Provider: p.Provider, meta: meta, calls: p.calls}
+}
+
+func TestWithOperationOptionalInterfaces(t *testing.T) {
+	t.Parallel()
+
+	deployment, ref := newStepTestDeployment(t, &deploytest.Provider{})
+	deployment.OperationID = "op-123"
+	res := newStepTestResource("res", ref)
+	step := NewCreateStep(deployment, doneEvent{}, res)
+
+	var calls []OperationMetadata
+	bound := withOperation(step, &progressOperationProvider{
+		progressProvider: &progressProvider{Provider: &deploytest.Provider{}},
+		calls:            &calls,
+	})
+
+	// The provider that the metadata is bound to does not report progress, so the original provider does.
+	pp, ok := bound.(ProgressProvider)
+	require.True(t, ok)
+	var messages []string
+	id, _, _, err := pp.CreateWithProgress(res.URN, nil, 0, false, func(message string, fraction float64) {
+		messages = append(messages, message)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.ID("created-id"), id)
+	assert.Equal(t, []string{"creating", "created"}, messages)
+	assert.Empty(t, calls)
+
+	// Plain calls are made with the metadata bound.
+	_, _, _, err = bound.Create(res.URN, nil, 0, false)
+	require.NoError(t, err)
+	assert.Equal(t, []OperationMetadata{{OperationID: "op-123", URN: res.URN, Op: OpCreate}}, calls)
+}
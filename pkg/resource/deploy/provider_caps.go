@@ -0,0 +1,76 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+// ProviderCapability names an optional capability that a provider may support.
+type ProviderCapability string
+
+const (
+	// ProviderCapabilityBatchDelete indicates that the provider can delete several resources in a single call.
+	ProviderCapabilityBatchDelete ProviderCapability = "batchDelete"
+	// ProviderCapabilityPreDelete indicates that the provider wants to be notified before a resource is deleted.
+	ProviderCapabilityPreDelete ProviderCapability = "preDelete"
+	// ProviderCapabilityIdempotentCreate indicates that the provider's creates may be safely retried.
+	ProviderCapabilityIdempotentCreate ProviderCapability = "idempotentCreate"
+	// ProviderCapabilityAnnotate indicates that the provider can annotate resources without updating them.
+	ProviderCapabilityAnnotate ProviderCapability = "annotate"
+)
+
+// ProviderSupports returns true if the provider with the given reference supports the given capability. The
+// provider's capabilities are requested at most once per reference; subsequent checks are answered from a cache. A
+// provider that is not yet registered is reported as not supporting the capability, and is not cached.
+func (d *Deployment) ProviderSupports(ref providers.Reference, capability ProviderCapability) bool {
+	if d.ResolveProviderRef != nil {
+		ref = d.ResolveProviderRef(ref)
+	}
+
+	d.providerCapsLock.Lock()
+	defer d.providerCapsLock.Unlock()
+
+	caps, ok := d.providerCaps[ref.String()]
+	if !ok {
+		prov, has := d.GetProvider(ref)
+		if !has {
+			return false
+		}
+		caps = getProviderCapabilities(ref, prov)
+		if d.providerCaps == nil {
+			d.providerCaps = make(map[string]map[ProviderCapability]bool)
+		}
+		d.providerCaps[ref.String()] = caps
+	}
+	return caps[capability]
+}
+
+// getProviderCapabilities asks the given provider for its capabilities. Providers that do not implement
+// plugin.CapabilitiesProvider support no optional capabilities, and errors are logged and treated the same way.
+func getProviderCapabilities(ref providers.Reference, prov plugin.Provider) map[ProviderCapability]bool {
+	caps := map[ProviderCapability]bool{}
+	supported, err := capabilities(prov)
+	if err != nil {
+		logging.V(7).Infof("failed to get capabilities for provider %v: %v", ref, err)
+		return caps
+	}
+	for _, c := range supported {
+		caps[ProviderCapability(c)] = true
+	}
+	return caps
+}
@@ -0,0 +1,136 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// capableProvider is a test provider that reports a fixed set of capabilities and counts how often it is asked.
+type capableProvider struct {
+	*deploytest.Provider
+
+	caps  []string
+	err   error
+	calls int32
+}
+
+func (p *capableProvider) Capabilities() ([]string, error) {
+	atomic.AddInt32(&p.calls, 1)
+	return p.caps, p.err
+}
+
+func TestProviderSupports(t *testing.T) {
+	t.Parallel()
+
+	// Each provider reference gets its own provider instance, each of which supports a different capability.
+	provs := map[string]*capableProvider{
+		"default": {Provider: &deploytest.Provider{}, caps: []string{string(ProviderCapabilityBatchDelete)}},
+		"other":   {Provider: &deploytest.Provider{}, caps: []string{string(ProviderCapabilityPreDelete)}},
+		"broken":  {Provider: &deploytest.Provider{}, err: errors.New("capabilities unavailable")},
+	}
+	order := []string{"default", "other", "broken"}
+	loaded := 0
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			prov := provs[order[loaded]]
+			loaded++
+			return prov, nil
+		}, deploytest.WithoutGrpc),
+	}
+	host := deploytest.NewPluginHost(nil, nil, nil, loaders...)
+	t.Cleanup(func() { contract.IgnoreClose(host) })
+
+	registry := providers.NewRegistry(host, false, nil)
+	refs := map[string]providers.Reference{}
+	for _, name := range order {
+		provState := newProviderResource("pkgA", name, name+"-id", resource.PropertyMap{})
+		require.NoError(t, registry.Same(provState))
+		ref, err := providers.NewReference(provState.URN, provState.ID)
+		require.NoError(t, err)
+		refs[name] = ref
+	}
+	deployment := &Deployment{providers: registry}
+
+	// The first check for each reference misses the cache and asks the provider; later checks are cache hits.
+	for i := 0; i < 3; i++ {
+		assert.True(t, deployment.ProviderSupports(refs["default"], ProviderCapabilityBatchDelete))
+		assert.False(t, deployment.ProviderSupports(refs["default"], ProviderCapabilityPreDelete))
+		assert.False(t, deployment.ProviderSupports(refs["other"], ProviderCapabilityBatchDelete))
+		assert.True(t, deployment.ProviderSupports(refs["other"], ProviderCapabilityPreDelete))
+		assert.False(t, deployment.ProviderSupports(refs["broken"], ProviderCapabilityBatchDelete))
+	}
+	for name, prov := range provs {
+		assert.Equal(t, int32(1), atomic.LoadInt32(&prov.calls), name)
+	}
+
+	t.Run("unregistered", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, _ := newStepTestDeployment(t, &deploytest.Provider{})
+		ref, err := providers.NewReference("urn:pulumi:teststack::pkg::pulumi:providers:pkgA::missing", "missing-id")
+		require.NoError(t, err)
+
+		assert.False(t, deployment.ProviderSupports(ref, ProviderCapabilityBatchDelete))
+		assert.NotContains(t, deployment.providerCaps, ref.String())
+	})
+
+	t.Run("grpc", func(t *testing.T) {
+		t.Parallel()
+
+		// Capabilities are reported over gRPC when the provider is configured.
+		loaders := []*deploytest.ProviderLoader{
+			deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+				return &deploytest.Provider{
+					CapabilitiesF: func() ([]string, error) {
+						return []string{string(ProviderCapabilityIdempotentCreate)}, nil
+					},
+				}, nil
+			}, deploytest.WithGrpc),
+		}
+		host := deploytest.NewPluginHost(nil, nil, nil, loaders...)
+		t.Cleanup(func() { contract.IgnoreClose(host) })
+
+		registry := providers.NewRegistry(host, false, nil)
+		provState := newProviderResource("pkgA", "grpc", "grpc-id", resource.PropertyMap{})
+		require.NoError(t, registry.Same(provState))
+		ref, err := providers.NewReference(provState.URN, provState.ID)
+		require.NoError(t, err)
+
+		deployment := &Deployment{providers: registry}
+		assert.True(t, deployment.ProviderSupports(ref, ProviderCapabilityIdempotentCreate))
+		assert.False(t, deployment.ProviderSupports(ref, ProviderCapabilityBatchDelete))
+	})
+
+	t.Run("not capable", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, ref := newStepTestDeployment(t, &deploytest.Provider{})
+		assert.False(t, deployment.ProviderSupports(ref, ProviderCapabilityIdempotentCreate))
+		assert.Contains(t, deployment.providerCaps, ref.String())
+	})
+}
@@ -0,0 +1,65 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+)
+
+// ProviderHealthError is returned by PreflightProviders for each provider that failed its health check.
+type ProviderHealthError struct {
+	Provider providers.Reference // the reference of the unhealthy provider.
+	Err      error               // the error returned by the provider's health check.
+}
+
+func (e *ProviderHealthError) Error() string {
+	return fmt.Sprintf("provider '%v' is unhealthy: %v", e.Provider, e.Err)
+}
+
+func (e *ProviderHealthError) Unwrap() error {
+	return e.Err
+}
+
+// PreflightProviders checks the health of the providers referenced by the given steps before the steps are applied,
+// so that a deployment can fail fast rather than discovering an unreachable provider partway through. Each provider
+// is checked once, regardless of how many steps reference it. Providers that do not implement health checks are
+// assumed to be healthy. The returned error joins the error of each step whose provider could not be resolved and a
+// ProviderHealthError for each provider that failed its health check, in the order in which they were referenced.
+func (d *Deployment) PreflightProviders(steps []Step) error {
+	checked := map[providers.Reference]bool{}
+	var errs []error
+	for _, step := range steps {
+		if step.Provider() == "" || providers.IsProviderType(step.Type()) {
+			continue
+		}
+		ref, prov, err := lookupProvider(step)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if checked[ref] {
+			continue
+		}
+		checked[ref] = true
+
+		if err := checkHealth(prov); err != nil {
+			errs = append(errs, &ProviderHealthError{Provider: ref, Err: err})
+		}
+	}
+	return errors.Join(errs...)
+}
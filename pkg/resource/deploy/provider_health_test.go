@@ -0,0 +1,101 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// newHealthProvider returns a test provider whose health check returns the given error and counts how often it is
+// called.
+func newHealthProvider(err error, calls *int32) *deploytest.Provider {
+	return &deploytest.Provider{
+		HealthF: func() error {
+			atomic.AddInt32(calls, 1)
+			return err
+		},
+	}
+}
+
+func TestPreflightProviders(t *testing.T) {
+	t.Parallel()
+
+	unreachable := errors.New("service unreachable")
+	var healthyCalls, failingCalls int32
+	deployment := NewTestDeployment(map[tokens.Package]plugin.Provider{
+		"pkgA": newHealthProvider(nil, &healthyCalls),
+		"pkgB": newHealthProvider(unreachable, &failingCalls),
+		// Providers that do not implement health checks are assumed to be healthy.
+		"pkgC": struct{ plugin.Provider }{&deploytest.Provider{}},
+	}, nil)
+
+	newStep := func(name string, pkg tokens.Package) Step {
+		return NewCreateStep(deployment, doneEvent{}, newTestDeploymentResource(name, TestProviderRef(pkg)))
+	}
+	component := &resource.State{
+		Type: "pkgA:m:component",
+		URN:  resource.NewURN("test", "test", "", "pkgA:m:component", "component"),
+	}
+	missing, err := providers.NewReference("urn:pulumi:test::test::pulumi:providers:pkgA::missing", "missing-id")
+	require.NoError(t, err)
+
+	steps := []Step{
+		newStep("a1", "pkgA"),
+		newStep("b1", "pkgB"),
+		newStep("a2", "pkgA"),
+		newStep("c1", "pkgC"),
+		newStep("b2", "pkgB"),
+		NewCreateStep(deployment, doneEvent{}, component),
+		NewCreateStep(deployment, doneEvent{}, newTestDeploymentResource("unknown", missing.String())),
+	}
+
+	err = deployment.PreflightProviders(steps)
+	var healthErr *ProviderHealthError
+	require.ErrorAs(t, err, &healthErr)
+	assert.Equal(t, TestProviderRef("pkgB"), healthErr.Provider.String())
+	assert.ErrorIs(t, err, unreachable)
+	assert.EqualError(t, err, "provider '"+TestProviderRef("pkgB")+"' is unhealthy: service unreachable\n"+
+		"unknown provider '"+missing.String()+"' for resource "+string(steps[6].URN()))
+
+	// Each provider is checked once, no matter how many steps refer to it.
+	assert.Equal(t, int32(1), atomic.LoadInt32(&healthyCalls))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&failingCalls))
+
+	t.Run("bad reference", func(t *testing.T) {
+		t.Parallel()
+
+		bad := NewCreateStep(deployment, doneEvent{}, newTestDeploymentResource("bad", "not-a-reference"))
+		err := deployment.PreflightProviders([]Step{bad})
+		assert.ErrorContains(t, err, "bad provider reference 'not-a-reference'")
+	})
+
+	t.Run("healthy", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NoError(t, deployment.PreflightProviders(steps[:1]))
+		assert.NoError(t, deployment.PreflightProviders(nil))
+	})
+}
@@ -0,0 +1,437 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// ProviderRecorder records the responses to provider Create, Update, Delete, and Read calls in a file, or replays
+// previously recorded responses from such a file. Responses are keyed by resource URN, operation, and the number of
+// times that operation has been called for the resource, so that repeated calls, e.g. the reads of a refresh and a
+// later import or the attempts of a retried call, are each replayed in turn.
+//
+// When a deployment has a ProviderRecorder, each resource provider returned by getProvider is wrapped in either a
+// RecordingProvider or a ReplayProvider. This allows steps to be exercised deterministically against recorded
+// provider interactions.
+type ProviderRecorder struct {
+	path    string                     // the file that responses are recorded to or replayed from.
+	replay  bool                       // true if responses are replayed rather than recorded.
+	m       sync.Mutex                 // protects the fields below.
+	file    *os.File                   // the file that responses are appended to, once the first has been recorded.
+	calls   map[string]int             // the number of calls made so far, keyed by URN and operation.
+	records map[string]*providerRecord // the recorded responses to replay, keyed by URN, operation, and call.
+}
+
+// providerRecord is the recorded response to a single provider call. The recording file holds one JSON-encoded
+// record per line.
+type providerRecord struct {
+	Key        string          `json:"key"`
+	ID         resource.ID     `json:"id,omitempty"`
+	Inputs     json.RawMessage `json:"inputs,omitempty"`
+	Outputs    json.RawMessage `json:"outputs,omitempty"`
+	Status     resource.Status `json:"status"`
+	Error      string          `json:"error,omitempty"`
+	InitErrors []string        `json:"initErrors,omitempty"`
+}
+
+// NewProviderRecorder returns a ProviderRecorder that records provider responses to the file at the given path. The
+// file is truncated when the first response is recorded, and each response is appended to it as it is recorded. The
+// recorder must be closed once the deployment has finished.
+func NewProviderRecorder(path string) *ProviderRecorder {
+	return &ProviderRecorder{
+		path:  path,
+		calls: make(map[string]int),
+	}
+}
+
+// NewProviderReplayer returns a ProviderRecorder that replays the provider responses recorded in the file at the
+// given path.
+func NewProviderReplayer(path string) (*ProviderRecorder, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading provider recording: %w", err)
+	}
+	defer contract.IgnoreClose(f)
+
+	records := make(map[string]*providerRecord)
+	dec := json.NewDecoder(f)
+	for {
+		var rec providerRecord
+		if err := dec.Decode(&rec); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("decoding provider recording %v: %w", path, err)
+		}
+		records[rec.Key] = &rec
+	}
+	return &ProviderRecorder{
+		path:    path,
+		replay:  true,
+		calls:   make(map[string]int),
+		records: records,
+	}, nil
+}
+
+// Close closes the file that responses are recorded to, if any.
+func (r *ProviderRecorder) Close() error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// Wrap returns a provider that records or replays the responses of the given provider.
+func (r *ProviderRecorder) Wrap(prov plugin.Provider) plugin.Provider {
+	if r.replay {
+		return &ReplayProvider{forwardingProvider: forwardingProvider{Provider: prov}, recorder: r}
+	}
+	return &RecordingProvider{forwardingProvider: forwardingProvider{Provider: prov}, recorder: r}
+}
+
+// nextKey returns the key of the next call of the given operation for the resource with the given URN.
+func (r *ProviderRecorder) nextKey(urn resource.URN, op string, preview bool) string {
+	if preview {
+		op = "preview-" + op
+	}
+	call := string(urn) + "::" + op
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.calls[call]++
+	return call + "::" + strconv.Itoa(r.calls[call])
+}
+
+func (r *ProviderRecorder) record(key string, rec *providerRecord, err error) error {
+	rec.Key = key
+	if err != nil {
+		rec.Error = err.Error()
+		var initErr *plugin.InitError
+		if errors.As(err, &initErr) {
+			rec.InitErrors = initErr.Reasons
+		}
+	}
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("encoding provider recording: %w", err)
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if r.file == nil {
+		f, err := os.OpenFile(r.path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+		if err != nil {
+			return fmt.Errorf("writing provider recording: %w", err)
+		}
+		r.file = f
+	}
+	if _, err := r.file.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("writing provider recording: %w", err)
+	}
+	return nil
+}
+
+func (r *ProviderRecorder) replayed(key string) (*providerRecord, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	rec, ok := r.records[key]
+	if !ok {
+		return nil, fmt.Errorf("no recorded provider response for %v", key)
+	}
+	return rec, nil
+}
+
+// err returns the error recorded for the call, if any.
+func (rec *providerRecord) err() error {
+	switch {
+	case len(rec.InitErrors) != 0:
+		return &plugin.InitError{Reasons: rec.InitErrors}
+	case rec.Error != "":
+		return errors.New(rec.Error)
+	default:
+		return nil
+	}
+}
+
+var recordedPropertiesOptions = plugin.MarshalOptions{
+	Label:            "recording",
+	KeepUnknowns:     true,
+	KeepSecrets:      true,
+	KeepResources:    true,
+	KeepOutputValues: true,
+}
+
+func marshalRecordedProperties(props resource.PropertyMap) (json.RawMessage, error) {
+	if props == nil {
+		return nil, nil
+	}
+	s, err := plugin.MarshalProperties(props, recordedPropertiesOptions)
+	if err != nil {
+		return nil, err
+	}
+	return protojson.Marshal(s)
+}
+
+func unmarshalRecordedProperties(raw json.RawMessage) (resource.PropertyMap, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var s structpb.Struct
+	if err := protojson.Unmarshal(raw, &s); err != nil {
+		return nil, err
+	}
+	return plugin.UnmarshalProperties(&s, recordedPropertiesOptions)
+}
+
+// RecordingProvider is a provider that records the responses of its underlying provider's Create, Update, Delete,
+// and Read calls, including those made through the optional ProgressProvider interface. All other calls are passed
+// through to the underlying provider.
+type RecordingProvider struct {
+	forwardingProvider
+
+	recorder *ProviderRecorder
+}
+
+var _ ProgressProvider = (*RecordingProvider)(nil)
+
+func (p *RecordingProvider) recordCreate(urn resource.URN, preview bool,
+	create func() (resource.ID, resource.PropertyMap, resource.Status, error),
+) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	key := p.recorder.nextKey(urn, "create", preview)
+	id, outs, status, err := create()
+
+	rec := &providerRecord{ID: id, Status: status}
+	outputs, merr := marshalRecordedProperties(outs)
+	if merr != nil {
+		return id, outs, status, fmt.Errorf("recording outputs of %v: %w", urn, merr)
+	}
+	rec.Outputs = outputs
+	if rerr := p.recorder.record(key, rec, err); rerr != nil {
+		return id, outs, status, rerr
+	}
+	return id, outs, status, err
+}
+
+func (p *RecordingProvider) recordUpdate(urn resource.URN, preview bool,
+	update func() (plugin.UpdateResult, resource.Status, error),
+) (plugin.UpdateResult, resource.Status, error) {
+	key := p.recorder.nextKey(urn, "update", preview)
+	result, status, err := update()
+
+	rec := &providerRecord{ID: result.ID, Status: status}
+	outputs, merr := marshalRecordedProperties(result.Outputs)
+	if merr != nil {
+		return result, status, fmt.Errorf("recording outputs of %v: %w", urn, merr)
+	}
+	rec.Outputs = outputs
+	if rerr := p.recorder.record(key, rec, err); rerr != nil {
+		return result, status, rerr
+	}
+	return result, status, err
+}
+
+func (p *RecordingProvider) recordDelete(urn resource.URN,
+	del func() (resource.Status, error),
+) (resource.Status, error) {
+	key := p.recorder.nextKey(urn, "delete", false)
+	status, err := del()
+
+	rec := &providerRecord{Status: status}
+	if rerr := p.recorder.record(key, rec, err); rerr != nil {
+		return status, rerr
+	}
+	return status, err
+}
+
+func (p *RecordingProvider) Create(urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool,
+) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	return p.recordCreate(urn, preview, func() (resource.ID, resource.PropertyMap, resource.Status, error) {
+		return p.Provider.Create(urn, news, timeout, preview)
+	})
+}
+
+func (p *RecordingProvider) CreateWithProgress(urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool, progress ProgressFunc,
+) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	return p.recordCreate(urn, preview, func() (resource.ID, resource.PropertyMap, resource.Status, error) {
+		return createWithProgress(p.Provider, urn, news, timeout, preview, progress)
+	})
+}
+
+func (p *RecordingProvider) Read(urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap,
+) (plugin.ReadResult, resource.Status, error) {
+	key := p.recorder.nextKey(urn, "read", false)
+	result, status, err := p.Provider.Read(urn, id, inputs, state)
+
+	rec := &providerRecord{ID: result.ID, Status: status}
+	ins, merr := marshalRecordedProperties(result.Inputs)
+	if merr != nil {
+		return result, status, fmt.Errorf("recording inputs of %v: %w", urn, merr)
+	}
+	outs, merr := marshalRecordedProperties(result.Outputs)
+	if merr != nil {
+		return result, status, fmt.Errorf("recording outputs of %v: %w", urn, merr)
+	}
+	rec.Inputs, rec.Outputs = ins, outs
+	if rerr := p.recorder.record(key, rec, err); rerr != nil {
+		return result, status, rerr
+	}
+	return result, status, err
+}
+
+func (p *RecordingProvider) Update(urn resource.URN, id resource.ID,
+	oldInputs, oldOutputs, newInputs resource.PropertyMap, timeout float64,
+	ignoreChanges []string, preview bool,
+) (plugin.UpdateResult, resource.Status, error) {
+	return p.recordUpdate(urn, preview, func() (plugin.UpdateResult, resource.Status, error) {
+		return p.Provider.Update(urn, id, oldInputs, oldOutputs, newInputs, timeout, ignoreChanges, preview)
+	})
+}
+
+func (p *RecordingProvider) UpdateWithProgress(urn resource.URN, id resource.ID,
+	oldInputs, oldOutputs, newInputs resource.PropertyMap, timeout float64, ignoreChanges []string, preview bool,
+	progress ProgressFunc,
+) (plugin.UpdateResult, resource.Status, error) {
+	return p.recordUpdate(urn, preview, func() (plugin.UpdateResult, resource.Status, error) {
+		return updateWithProgress(p.Provider, urn, id, oldInputs, oldOutputs, newInputs, timeout, ignoreChanges,
+			preview, progress)
+	})
+}
+
+func (p *RecordingProvider) Delete(urn resource.URN, id resource.ID,
+	inputs, outputs resource.PropertyMap, timeout float64,
+) (resource.Status, error) {
+	return p.recordDelete(urn, func() (resource.Status, error) {
+		return p.Provider.Delete(urn, id, inputs, outputs, timeout)
+	})
+}
+
+func (p *RecordingProvider) DeleteWithProgress(urn resource.URN, id resource.ID,
+	inputs, outputs resource.PropertyMap, timeout float64, progress ProgressFunc,
+) (resource.Status, error) {
+	return p.recordDelete(urn, func() (resource.Status, error) {
+		return deleteWithProgress(p.Provider, urn, id, inputs, outputs, timeout, progress)
+	})
+}
+
+// ReplayProvider is a provider that replays recorded responses to Create, Update, Delete, and Read calls, including
+// those made through the optional ProgressProvider interface, instead of calling its underlying provider. No progress
+// is reported for replayed calls. All other calls are passed through to the underlying provider.
+type ReplayProvider struct {
+	forwardingProvider
+
+	recorder *ProviderRecorder
+}
+
+var _ ProgressProvider = (*ReplayProvider)(nil)
+
+func (p *ReplayProvider) Create(urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool,
+) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	rec, err := p.recorder.replayed(p.recorder.nextKey(urn, "create", preview))
+	if err != nil {
+		return "", nil, resource.StatusUnknown, err
+	}
+	outs, err := unmarshalRecordedProperties(rec.Outputs)
+	if err != nil {
+		return "", nil, resource.StatusUnknown, fmt.Errorf("replaying outputs of %v: %w", urn, err)
+	}
+	return rec.ID, outs, rec.Status, rec.err()
+}
+
+func (p *ReplayProvider) CreateWithProgress(urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool, progress ProgressFunc,
+) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	return p.Create(urn, news, timeout, preview)
+}
+
+func (p *ReplayProvider) Read(urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap,
+) (plugin.ReadResult, resource.Status, error) {
+	rec, err := p.recorder.replayed(p.recorder.nextKey(urn, "read", false))
+	if err != nil {
+		return plugin.ReadResult{}, resource.StatusUnknown, err
+	}
+	ins, err := unmarshalRecordedProperties(rec.Inputs)
+	if err != nil {
+		return plugin.ReadResult{}, resource.StatusUnknown, fmt.Errorf("replaying inputs of %v: %w", urn, err)
+	}
+	outs, err := unmarshalRecordedProperties(rec.Outputs)
+	if err != nil {
+		return plugin.ReadResult{}, resource.StatusUnknown, fmt.Errorf("replaying outputs of %v: %w", urn, err)
+	}
+	return plugin.ReadResult{ID: rec.ID, Inputs: ins, Outputs: outs}, rec.Status, rec.err()
+}
+
+func (p *ReplayProvider) Update(urn resource.URN, id resource.ID,
+	oldInputs, oldOutputs, newInputs resource.PropertyMap, timeout float64,
+	ignoreChanges []string, preview bool,
+) (plugin.UpdateResult, resource.Status, error) {
+	rec, err := p.recorder.replayed(p.recorder.nextKey(urn, "update", preview))
+	if err != nil {
+		return plugin.UpdateResult{}, resource.StatusUnknown, err
+	}
+	outs, err := unmarshalRecordedProperties(rec.Outputs)
+	if err != nil {
+		return plugin.UpdateResult{}, resource.StatusUnknown, fmt.Errorf("replaying outputs of %v: %w", urn, err)
+	}
+	return plugin.UpdateResult{ID: rec.ID, Outputs: outs}, rec.Status, rec.err()
+}
+
+func (p *ReplayProvider) UpdateWithProgress(urn resource.URN, id resource.ID,
+	oldInputs, oldOutputs, newInputs resource.PropertyMap, timeout float64, ignoreChanges []string, preview bool,
+	progress ProgressFunc,
+) (plugin.UpdateResult, resource.Status, error) {
+	return p.Update(urn, id, oldInputs, oldOutputs, newInputs, timeout, ignoreChanges, preview)
+}
+
+func (p *ReplayProvider) Delete(urn resource.URN, id resource.ID,
+	inputs, outputs resource.PropertyMap, timeout float64,
+) (resource.Status, error) {
+	rec, err := p.recorder.replayed(p.recorder.nextKey(urn, "delete", false))
+	if err != nil {
+		return resource.StatusUnknown, err
+	}
+	return rec.Status, rec.err()
+}
+
+func (p *ReplayProvider) DeleteWithProgress(urn resource.URN, id resource.ID,
+	inputs, outputs resource.PropertyMap, timeout float64, progress ProgressFunc,
+) (resource.Status, error) {
+	return p.Delete(urn, id, inputs, outputs, timeout)
+}
@@ -0,0 +1,229 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+func TestProviderRecorder(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "recording.json")
+
+	outs := resource.PropertyMap{
+		"arn":    resource.NewStringProperty("arn:pkgA:::res"),
+		"secret": resource.MakeSecret(resource.NewStringProperty("shh")),
+	}
+	updated := resource.PropertyMap{"arn": resource.NewStringProperty("arn:pkgA:::res-v2")}
+
+	// Apply a sequence of steps against a live provider, recording its responses.
+	live := &deploytest.Provider{
+		CreateF: func(urn resource.URN, inputs resource.PropertyMap, timeout float64,
+			preview bool,
+		) (resource.ID, resource.PropertyMap, resource.Status, error) {
+			return "created-id", outs, resource.StatusOK, nil
+		},
+		UpdateF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap,
+			timeout float64, ignoreChanges []string, preview bool,
+		) (resource.PropertyMap, resource.Status, error) {
+			return updated, resource.StatusPartialFailure, &plugin.InitError{Reasons: []string{"unhealthy"}}
+		},
+		DeleteF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs resource.PropertyMap,
+			timeout float64,
+		) (resource.Status, error) {
+			return resource.StatusOK, nil
+		},
+		ReadF: func(urn resource.URN, id resource.ID,
+			inputs, state resource.PropertyMap,
+		) (plugin.ReadResult, resource.Status, error) {
+			return plugin.ReadResult{ID: id, Inputs: inputs, Outputs: updated}, resource.StatusOK, nil
+		},
+	}
+
+	type results struct {
+		created *resource.State
+		updated *resource.State
+		read    *resource.State
+	}
+	run := func(t *testing.T, prov plugin.Provider, recorder *ProviderRecorder) results {
+		deployment, ref := newStepTestDeployment(t, prov)
+		deployment.ProviderRecorder = recorder
+
+		created := newStepTestResource("res", ref)
+		_, _, err := NewCreateStep(deployment, doneEvent{}, created).Apply(false)
+		require.NoError(t, err)
+
+		newState := newStepTestResource("res", ref)
+		_, _, err = NewUpdateStep(deployment, doneEvent{}, created, newState, nil, nil, nil, nil).Apply(false)
+		assert.Equal(t, &plugin.InitError{Reasons: []string{"unhealthy"}}, err)
+
+		refreshed := newStepTestResource("res", ref)
+		refreshed.ID = created.ID
+		read := NewRefreshStep(deployment, refreshed, nil)
+		_, _, err = read.Apply(false)
+		require.NoError(t, err)
+
+		_, _, err = NewDeleteStep(deployment, map[resource.URN]bool{}, newState).Apply(false)
+		require.NoError(t, err)
+
+		return results{created: created, updated: newState, read: read.New()}
+	}
+
+	recorder := NewProviderRecorder(path)
+	recorded := run(t, live, recorder)
+	require.NoError(t, recorder.Close())
+	assert.Equal(t, resource.ID("created-id"), recorded.created.ID)
+	assert.Equal(t, outs, recorded.created.Outputs)
+
+	// Each call is appended to the recording as a line of its own.
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Len(t, strings.Split(strings.TrimSpace(string(b)), "\n"), 4)
+
+	// Replay the same steps against a provider that must not be called. The results should be identical.
+	unreachable := &deploytest.Provider{
+		CreateF: func(urn resource.URN, inputs resource.PropertyMap, timeout float64,
+			preview bool,
+		) (resource.ID, resource.PropertyMap, resource.Status, error) {
+			assert.Fail(t, "Create was called")
+			return "", nil, resource.StatusUnknown, nil
+		},
+		UpdateF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap,
+			timeout float64, ignoreChanges []string, preview bool,
+		) (resource.PropertyMap, resource.Status, error) {
+			assert.Fail(t, "Update was called")
+			return nil, resource.StatusUnknown, nil
+		},
+		DeleteF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs resource.PropertyMap,
+			timeout float64,
+		) (resource.Status, error) {
+			assert.Fail(t, "Delete was called")
+			return resource.StatusUnknown, nil
+		},
+		ReadF: func(urn resource.URN, id resource.ID,
+			inputs, state resource.PropertyMap,
+		) (plugin.ReadResult, resource.Status, error) {
+			assert.Fail(t, "Read was called")
+			return plugin.ReadResult{}, resource.StatusUnknown, nil
+		},
+	}
+	replayer, err := NewProviderReplayer(path)
+	require.NoError(t, err)
+	replayed := run(t, unreachable, replayer)
+
+	assert.Equal(t, recorded.created.ID, replayed.created.ID)
+	assert.Equal(t, recorded.created.Outputs, replayed.created.Outputs)
+	assert.Equal(t, recorded.updated.Outputs, replayed.updated.Outputs)
+	assert.Equal(t, recorded.updated.InitErrors, replayed.updated.InitErrors)
+	assert.Equal(t, recorded.read.Outputs, replayed.read.Outputs)
+
+	t.Run("missing record", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, ref := newStepTestDeployment(t, &deploytest.Provider{})
+		deployment.ProviderRecorder = replayer
+
+		res := newStepTestResource("other", ref)
+		_, _, err := NewCreateStep(deployment, doneEvent{}, res).Apply(false)
+		assert.ErrorContains(t, err, "no recorded provider response for "+string(res.URN)+"::create::1")
+	})
+}
+
+func TestProviderRecorderRepeatedCalls(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "recording.json")
+	reads := 0
+	live := &deploytest.Provider{
+		ReadF: func(urn resource.URN, id resource.ID,
+			inputs, state resource.PropertyMap,
+		) (plugin.ReadResult, resource.Status, error) {
+			reads++
+			outs := resource.PropertyMap{"read": resource.NewNumberProperty(float64(reads))}
+			return plugin.ReadResult{ID: id, Outputs: outs}, resource.StatusOK, nil
+		},
+	}
+
+	// Refresh the same resource twice, and check that each refresh replays its own response.
+	refresh := func(t *testing.T, prov plugin.Provider, recorder *ProviderRecorder) []resource.PropertyMap {
+		deployment, ref := newStepTestDeployment(t, prov)
+		deployment.ProviderRecorder = recorder
+
+		var outputs []resource.PropertyMap
+		for i := 0; i < 2; i++ {
+			old := newStepTestResource("res", ref)
+			old.ID = "res-id"
+			step := NewRefreshStep(deployment, old, nil)
+			_, _, err := step.Apply(false)
+			require.NoError(t, err)
+			outputs = append(outputs, step.New().Outputs)
+		}
+		return outputs
+	}
+
+	recorder := NewProviderRecorder(path)
+	recorded := refresh(t, live, recorder)
+	require.NoError(t, recorder.Close())
+	assert.Equal(t, []resource.PropertyMap{
+		{"read": resource.NewNumberProperty(1)},
+		{"read": resource.NewNumberProperty(2)},
+	}, recorded)
+
+	replayer, err := NewProviderReplayer(path)
+	require.NoError(t, err)
+	assert.Equal(t, recorded, refresh(t, &deploytest.Provider{}, replayer))
+	assert.Equal(t, 2, reads)
+}
+
+func TestProviderRecorderOptionalInterfaces(t *testing.T) {
+	t.Parallel()
+
+	// Wrapping a provider in a recorder or replayer preserves the optional interfaces that it implements.
+	path := filepath.Join(t.TempDir(), "recording.json")
+	recorder := NewProviderRecorder(path)
+	defer contract.IgnoreClose(recorder)
+	prov := &compositeIDProvider{Provider: &deploytest.Provider{}, separator: "/"}
+	for _, wrapped := range []plugin.Provider{recorder.Wrap(prov), (&ProviderRecorder{replay: true}).Wrap(prov)} {
+		separators, err := wrapped.(plugin.CompositeIDProvider).CompositeIDSeparators()
+		require.NoError(t, err)
+		assert.Equal(t, map[tokens.Type]string{"pkgA:m:typA": "/"}, separators)
+	}
+
+	// Progress is still streamed for recorded calls.
+	deployment, ref := newStepTestDeployment(t, &progressProvider{Provider: &deploytest.Provider{}})
+	deployment.ProviderRecorder = recorder
+	var events []progressEvent
+	deployment.StepProgress = func(urn resource.URN, message string, fraction float64) {
+		events = append(events, progressEvent{urn, message, fraction})
+	}
+	res := newStepTestResource("res", ref)
+	_, _, err := NewCreateStep(deployment, doneEvent{}, res).Apply(false)
+	require.NoError(t, err)
+	assert.Equal(t, resource.ID("created-id"), res.ID)
+	assert.Equal(t, []progressEvent{{res.URN, "creating", 0.5}, {res.URN, "created", 1}}, events)
+}
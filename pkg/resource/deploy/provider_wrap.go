@@ -0,0 +1,163 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// The engine wraps the providers that steps call, e.g. to record their responses. A wrapper cannot know which of the
+// optional provider interfaces the provider it wraps implements, so it implements all of them and forwards each call
+// using the functions below. Each function behaves as the engine does when the provider does not implement the
+// interface, so that a wrapped provider behaves exactly like the provider it wraps.
+
+// createWithProgress calls the provider's CreateWithProgress if it implements ProgressProvider, and its Create
+// otherwise.
+func createWithProgress(prov plugin.Provider, urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool, progress ProgressFunc,
+) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	if pp, ok := prov.(ProgressProvider); ok {
+		return pp.CreateWithProgress(urn, news, timeout, preview, progress)
+	}
+	return prov.Create(urn, news, timeout, preview)
+}
+
+// updateWithProgress calls the provider's UpdateWithProgress if it implements ProgressProvider, and its Update
+// otherwise.
+func updateWithProgress(prov plugin.Provider, urn resource.URN, id resource.ID,
+	oldInputs, oldOutputs, newInputs resource.PropertyMap, timeout float64, ignoreChanges []string, preview bool,
+	progress ProgressFunc,
+) (plugin.UpdateResult, resource.Status, error) {
+	if pp, ok := prov.(ProgressProvider); ok {
+		return pp.UpdateWithProgress(urn, id, oldInputs, oldOutputs, newInputs, timeout, ignoreChanges, preview,
+			progress)
+	}
+	return prov.Update(urn, id, oldInputs, oldOutputs, newInputs, timeout, ignoreChanges, preview)
+}
+
+// deleteWithProgress calls the provider's DeleteWithProgress if it implements ProgressProvider, and its Delete
+// otherwise.
+func deleteWithProgress(prov plugin.Provider, urn resource.URN, id resource.ID,
+	oldInputs, oldOutputs resource.PropertyMap, timeout float64, progress ProgressFunc,
+) (resource.Status, error) {
+	if pp, ok := prov.(ProgressProvider); ok {
+		return pp.DeleteWithProgress(urn, id, oldInputs, oldOutputs, timeout, progress)
+	}
+	return prov.Delete(urn, id, oldInputs, oldOutputs, timeout)
+}
+
+// capabilities calls the provider's Capabilities if it implements plugin.CapabilitiesProvider, and reports no
+// capabilities otherwise.
+func capabilities(prov plugin.Provider) ([]string, error) {
+	if cp, ok := prov.(plugin.CapabilitiesProvider); ok {
+		return cp.Capabilities()
+	}
+	return nil, nil
+}
+
+// compositeIDSeparators calls the provider's CompositeIDSeparators if it implements plugin.CompositeIDProvider, and
+// reports that no resource types support composite IDs otherwise.
+func compositeIDSeparators(prov plugin.Provider) (map[tokens.Type]string, error) {
+	if cp, ok := prov.(plugin.CompositeIDProvider); ok {
+		return cp.CompositeIDSeparators()
+	}
+	return nil, nil
+}
+
+// tagPropertyPaths calls the provider's TagPropertyPaths if it implements plugin.TaggingProvider, and reports that no
+// resource types can be tagged otherwise.
+func tagPropertyPaths(prov plugin.Provider) (map[tokens.Type]resource.PropertyPath, error) {
+	if tp, ok := prov.(plugin.TaggingProvider); ok {
+		return tp.TagPropertyPaths()
+	}
+	return nil, nil
+}
+
+// checkHealth calls the provider's Health if it implements plugin.HealthCheckProvider, and reports that the provider
+// is healthy otherwise.
+func checkHealth(prov plugin.Provider) error {
+	if hp, ok := prov.(plugin.HealthCheckProvider); ok {
+		return hp.Health()
+	}
+	return nil
+}
+
+// annotate calls the provider's Annotate if it implements plugin.AnnotatingProvider, and returns an error otherwise.
+// Steps only annotate resources whose providers declare ProviderCapabilityAnnotate.
+func annotate(prov plugin.Provider, urn resource.URN, id resource.ID, annotations map[string]string) error {
+	if ap, ok := prov.(plugin.AnnotatingProvider); ok {
+		return ap.Annotate(urn, id, annotations)
+	}
+	return fmt.Errorf("the provider for resource %v does not support annotations", urn)
+}
+
+// forwardingProvider is embedded by provider wrappers to forward each of the optional provider interfaces to the
+// provider they wrap. Wrappers override the methods whose calls they intercept.
+type forwardingProvider struct {
+	plugin.Provider
+}
+
+var (
+	_ ProgressProvider            = forwardingProvider{}
+	_ plugin.CapabilitiesProvider = forwardingProvider{}
+	_ plugin.CompositeIDProvider  = forwardingProvider{}
+	_ plugin.TaggingProvider      = forwardingProvider{}
+	_ plugin.HealthCheckProvider  = forwardingProvider{}
+	_ plugin.AnnotatingProvider   = forwardingProvider{}
+)
+
+func (p forwardingProvider) CreateWithProgress(urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool, progress ProgressFunc,
+) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	return createWithProgress(p.Provider, urn, news, timeout, preview, progress)
+}
+
+func (p forwardingProvider) UpdateWithProgress(urn resource.URN, id resource.ID,
+	oldInputs, oldOutputs, newInputs resource.PropertyMap, timeout float64, ignoreChanges []string, preview bool,
+	progress ProgressFunc,
+) (plugin.UpdateResult, resource.Status, error) {
+	return updateWithProgress(p.Provider, urn, id, oldInputs, oldOutputs, newInputs, timeout, ignoreChanges, preview,
+		progress)
+}
+
+func (p forwardingProvider) DeleteWithProgress(urn resource.URN, id resource.ID,
+	oldInputs, oldOutputs resource.PropertyMap, timeout float64, progress ProgressFunc,
+) (resource.Status, error) {
+	return deleteWithProgress(p.Provider, urn, id, oldInputs, oldOutputs, timeout, progress)
+}
+
+func (p forwardingProvider) Capabilities() ([]string, error) {
+	return capabilities(p.Provider)
+}
+
+func (p forwardingProvider) CompositeIDSeparators() (map[tokens.Type]string, error) {
+	return compositeIDSeparators(p.Provider)
+}
+
+func (p forwardingProvider) TagPropertyPaths() (map[tokens.Type]resource.PropertyPath, error) {
+	return tagPropertyPaths(p.Provider)
+}
+
+func (p forwardingProvider) Health() error {
+	return checkHealth(p.Provider)
+}
+
+func (p forwardingProvider) Annotate(urn resource.URN, id resource.ID, annotations map[string]string) error {
+	return annotate(p.Provider, urn, id, annotations)
+}
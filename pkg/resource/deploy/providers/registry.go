@@ -101,6 +101,12 @@ func SetProviderVersion(inputs resource.PropertyMap, value *semver.Version) {
 	inputs[versionKey] = resource.NewStringProperty(value.String())
 }
 
+// IsPluginKey returns true if the given provider input selects the plugin that implements the provider, such as its
+// version or download URL, rather than configuring the provider itself.
+func IsPluginKey(k resource.PropertyKey) bool {
+	return k == versionKey || k == pluginDownloadKey || k == pluginChecksumsKey
+}
+
 // GetProviderVersion fetches and parses a provider version from the given property map. If the
 // version property is not present, this function returns nil.
 func GetProviderVersion(inputs resource.PropertyMap) (*semver.Version, error) {
@@ -135,6 +141,7 @@ type Registry struct {
 	host      plugin.Host
 	isPreview bool
 	providers map[Reference]plugin.Provider
+	inputs    map[Reference]resource.PropertyMap
 	builtins  plugin.Provider
 	aliases   map[resource.URN]resource.URN
 	m         sync.RWMutex
@@ -197,6 +204,7 @@ func NewRegistry(host plugin.Host, isPreview bool, builtins plugin.Provider) *Re
 		host:      host,
 		isPreview: isPreview,
 		providers: make(map[Reference]plugin.Provider),
+		inputs:    make(map[Reference]resource.PropertyMap),
 		builtins:  builtins,
 		aliases:   make(map[resource.URN]resource.URN),
 	}
@@ -213,16 +221,35 @@ func (r *Registry) GetProvider(ref Reference) (plugin.Provider, bool) {
 	return provider, ok
 }
 
-func (r *Registry) setProvider(ref Reference, provider plugin.Provider) {
+// GetProviderInputs returns the inputs that the provider currently registered under the given reference was
+// configured with, if any.
+func (r *Registry) GetProviderInputs(ref Reference) (resource.PropertyMap, bool) {
+	r.m.RLock()
+	defer r.m.RUnlock()
+
+	inputs, ok := r.inputs[ref]
+	return inputs, ok
+}
+
+// setProvider registers the given provider under the given reference. If the provider has been configured, inputs
+// should be the configuration it was configured with; otherwise it should be nil.
+func (r *Registry) setProvider(ref Reference, provider plugin.Provider, inputs resource.PropertyMap) {
 	r.m.Lock()
 	defer r.m.Unlock()
 
 	logging.V(7).Infof("setProvider(%v)", ref)
 
 	r.providers[ref] = provider
+	if inputs != nil {
+		r.inputs[ref] = inputs
+	}
 
 	if alias, ok := r.aliases[ref.URN()]; ok {
-		r.providers[mustNewReference(alias, ref.ID())] = provider
+		aliasRef := mustNewReference(alias, ref.ID())
+		r.providers[aliasRef] = provider
+		if inputs != nil {
+			r.inputs[aliasRef] = inputs
+		}
 	}
 }
 
@@ -235,6 +262,7 @@ func (r *Registry) deleteProvider(ref Reference) (plugin.Provider, bool) {
 		return nil, false
 	}
 	delete(r.providers, ref)
+	delete(r.inputs, ref)
 	return provider, true
 }
 
@@ -335,7 +363,7 @@ func (r *Registry) Check(urn resource.URN, olds, news resource.PropertyMap,
 	}
 
 	// Create a provider reference using the URN and the unconfigured ID and register the provider.
-	r.setProvider(mustNewReference(urn, UnconfiguredID), provider)
+	r.setProvider(mustNewReference(urn, UnconfiguredID), provider, nil)
 
 	return inputs, nil, nil
 }
@@ -455,7 +483,7 @@ func (r *Registry) Same(res *resource.State) error {
 
 	logging.V(7).Infof("loaded provider %v", ref)
 
-	r.setProvider(ref, provider)
+	r.setProvider(ref, provider, res.Inputs)
 
 	return nil
 }
@@ -517,7 +545,7 @@ func (r *Registry) Create(urn resource.URN, news resource.PropertyMap, timeout f
 		contract.Assertf(id != UnknownID, "resource ID must not be unknown")
 	}
 
-	r.setProvider(mustNewReference(urn, id), provider)
+	r.setProvider(mustNewReference(urn, id), provider, news)
 	return id, news, resource.StatusOK, nil
 }
 
@@ -528,7 +556,7 @@ func (r *Registry) Create(urn resource.URN, news resource.PropertyMap, timeout f
 func (r *Registry) Update(urn resource.URN, id resource.ID,
 	oldInputs, oldOutputs, newInputs resource.PropertyMap, timeout float64,
 	ignoreChanges []string, preview bool,
-) (resource.PropertyMap, resource.Status, error) {
+) (plugin.UpdateResult, resource.Status, error) {
 	label := fmt.Sprintf("%s.Update(%s,%s)", r.label(), id, urn)
 	logging.V(7).Infof("%s: executing (#oldInputs=%d#oldOutputs=%d,#newInputs=%d)",
 		label, len(oldInputs), len(oldOutputs), len(newInputs))
@@ -539,12 +567,12 @@ func (r *Registry) Update(urn resource.URN, id resource.ID,
 	contract.Assertf(ok, "'Check' and 'Diff' must be called before 'Update' (%v)", urn)
 
 	if err := provider.Configure(newInputs); err != nil {
-		return nil, resource.StatusUnknown, err
+		return plugin.UpdateResult{}, resource.StatusUnknown, err
 	}
 
 	// Publish the configured provider.
-	r.setProvider(mustNewReference(urn, id), provider)
-	return newInputs, resource.StatusOK, nil
+	r.setProvider(mustNewReference(urn, id), provider, newInputs)
+	return plugin.UpdateResult{Outputs: newInputs}, resource.StatusOK, nil
 }
 
 // Delete unregisters and unloads the provider with the given URN and ID. If the provider was never loaded
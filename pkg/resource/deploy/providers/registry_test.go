@@ -430,9 +430,9 @@ func TestCRUD(t *testing.T) {
 		assert.Equal(t, old, p2)
 
 		// Update
-		outs, status, err := r.Update(urn, id, nil, olds, inputs, timeout, nil, false)
+		result, status, err := r.Update(urn, id, nil, olds, inputs, timeout, nil, false)
 		assert.NoError(t, err)
-		assert.Equal(t, resource.PropertyMap{}, outs)
+		assert.Equal(t, resource.PropertyMap{}, result.Outputs)
 		assert.Equal(t, resource.StatusOK, status)
 
 		p3, ok := r.GetProvider(Reference{urn: urn, id: id})
@@ -797,3 +797,28 @@ func TestConcurrentRegistryUsage(t *testing.T) {
 		}(i)
 	}
 }
+
+func TestGetProviderInputs(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*providerLoader{
+		newSimpleLoader(t, "pkgA", "", nil),
+	}
+	host := newPluginHost(t, loaders)
+	r := NewRegistry(host, false, nil)
+
+	inputs := resource.PropertyMap{"region": resource.NewStringProperty("us-west-2")}
+	old := newProviderState("pkgA", "a", "id1", false, inputs)
+	ref, err := NewReference(old.URN, old.ID)
+	assert.NoError(t, err)
+
+	_, ok := r.GetProviderInputs(ref)
+	assert.False(t, ok)
+
+	err = r.Same(old)
+	assert.NoError(t, err)
+
+	actual, ok := r.GetProviderInputs(ref)
+	assert.True(t, ok)
+	assert.Equal(t, inputs, actual)
+}
@@ -0,0 +1,73 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import "sync"
+
+// pendingRegistration is a registration result that has yet to be delivered to its event.
+type pendingRegistration struct {
+	reg    RegisterResourceEvent
+	result *RegisterResult
+}
+
+// registerBatcher delivers registration results in batches. The first caller to enqueue a result while no batch is
+// being delivered becomes the flusher: it delivers every result that is queued, including those enqueued while it is
+// delivering, until the queue is empty. Other callers only append to the queue, which keeps contention on the
+// registration path to a single short critical section. As there is at most one flusher at a time, results are
+// delivered in the order in which they were enqueued.
+type registerBatcher struct {
+	lock     sync.Mutex            // protects pending, spare, and flushing.
+	pending  []pendingRegistration // the results waiting to be delivered.
+	spare    []pendingRegistration // a delivered batch whose storage can be reused.
+	flushing bool                  // true if a caller is delivering results.
+}
+
+// enqueue queues a result for delivery, delivering it and any other queued results itself if no other caller is
+// already doing so.
+func (b *registerBatcher) enqueue(reg RegisterResourceEvent, result *RegisterResult) {
+	b.lock.Lock()
+	b.pending = append(b.pending, pendingRegistration{reg: reg, result: result})
+	if b.flushing {
+		b.lock.Unlock()
+		return
+	}
+
+	b.flushing = true
+	for len(b.pending) > 0 {
+		batch := b.pending
+		b.pending, b.spare = b.spare[:0], nil
+		b.lock.Unlock()
+
+		for i, p := range batch {
+			p.reg.Done(p.result)
+			batch[i] = pendingRegistration{}
+		}
+
+		b.lock.Lock()
+		b.spare = batch
+	}
+	b.flushing = false
+	b.lock.Unlock()
+}
+
+// completeRegistration delivers a registration result to its event, batching it with other results if the deployment
+// batches registration results.
+func (d *Deployment) completeRegistration(reg RegisterResourceEvent, result *RegisterResult) {
+	if d == nil || !d.BatchRegisterResults {
+		reg.Done(result)
+		return
+	}
+	d.registerBatch.enqueue(reg, result)
+}
@@ -0,0 +1,146 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// recordingEvent is a RegisterResourceEvent that records the order in which results are delivered.
+type recordingEvent struct {
+	lock    *sync.Mutex
+	results *[]resource.URN
+}
+
+var _ RegisterResourceEvent = recordingEvent{}
+
+func (recordingEvent) event()               {}
+func (recordingEvent) Goal() *resource.Goal { return nil }
+func (e recordingEvent) Done(result *RegisterResult) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	*e.results = append(*e.results, result.State.URN)
+}
+
+func newSameSteps(t testing.TB, deployment *Deployment, reg RegisterResourceEvent, n int) []Step {
+	ref, err := providers.NewReference("urn:pulumi:teststack::pkg::pulumi:providers:pkgA::default", "provider-id")
+	require.NoError(t, err)
+
+	steps := make([]Step, n)
+	for i := range steps {
+		old := newStepTestResource(fmt.Sprintf("res-%d", i), ref)
+		old.ID = "id"
+		steps[i] = NewSameStep(deployment, reg, old, newStepTestResource(fmt.Sprintf("res-%d", i), ref))
+	}
+	return steps
+}
+
+func TestBatchRegisterResults(t *testing.T) {
+	t.Parallel()
+
+	for _, batch := range []bool{false, true} {
+		batch := batch
+		t.Run(fmt.Sprintf("batch=%v", batch), func(t *testing.T) {
+			t.Parallel()
+
+			var lock sync.Mutex
+			var results []resource.URN
+			reg := recordingEvent{lock: &lock, results: &results}
+
+			deployment := &Deployment{BatchRegisterResults: batch}
+			steps := newSameSteps(t, deployment, reg, 100)
+
+			// Completing the steps in order delivers their results in order.
+			for _, step := range steps {
+				_, complete, err := step.Apply(false)
+				require.NoError(t, err)
+				complete()
+			}
+			require.Len(t, results, len(steps))
+			for i, step := range steps {
+				assert.Equal(t, step.URN(), results[i])
+			}
+
+			// Every result is delivered, and has been delivered by the time its completion returns, even when steps
+			// complete concurrently.
+			results = nil
+			var wg sync.WaitGroup
+			for _, step := range steps {
+				step := step
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, complete, err := step.Apply(false)
+					assert.NoError(t, err)
+					complete()
+				}()
+			}
+			wg.Wait()
+			assert.Len(t, results, len(steps))
+		})
+	}
+}
+
+// registryEvent is a RegisterResourceEvent that, like the resource monitor, records each result in a shared registry
+// guarded by a lock.
+type registryEvent struct {
+	lock     *sync.Mutex
+	registry map[resource.URN]*resource.State
+}
+
+var _ RegisterResourceEvent = registryEvent{}
+
+func (registryEvent) event()               {}
+func (registryEvent) Goal() *resource.Goal { return nil }
+func (e registryEvent) Done(result *RegisterResult) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.registry[result.State.URN] = result.State
+}
+
+func BenchmarkSameStepCompletion(b *testing.B) {
+	for _, batch := range []bool{false, true} {
+		batch := batch
+		b.Run(fmt.Sprintf("batch=%v", batch), func(b *testing.B) {
+			reg := registryEvent{lock: &sync.Mutex{}, registry: map[resource.URN]*resource.State{}}
+
+			deployment := &Deployment{BatchRegisterResults: batch}
+			steps := newSameSteps(b, deployment, reg, 1000)
+			completes := make([]StepCompleteFunc, len(steps))
+			for i, step := range steps {
+				_, complete, err := step.Apply(false)
+				require.NoError(b, err)
+				completes[i] = complete
+			}
+
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					completes[i%len(completes)]()
+					i++
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+const (
+	// ReplacementCauseOwnership is the cause of a replacement that moves a resource between being external to Pulumi
+	// and being managed by it, in either direction.
+	ReplacementCauseOwnership = "ownership"
+	// ReplacementCauseImport is the cause of a replacement that imports a different resource in place of a managed one.
+	ReplacementCauseImport = "import"
+	// ReplacementCauseDiff is the cause of a replacement due to changes to the resource's properties. If the properties
+	// that required the replacement are known, they are appended to the cause, e.g. "diff:bar,foo".
+	ReplacementCauseDiff = "diff"
+)
+
+// diffReplacementCause returns the cause of a replacement due to changes to the given properties. The properties are
+// sorted so that the cause does not depend on the order in which the provider reported them.
+func diffReplacementCause(keys []resource.PropertyKey) string {
+	if len(keys) == 0 {
+		return ReplacementCauseDiff
+	}
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		names[i] = string(k)
+	}
+	sort.Strings(names)
+	return ReplacementCauseDiff + ":" + strings.Join(names, ",")
+}
+
+// describeReplacementCause returns a human-readable description of the given replacement cause.
+func describeReplacementCause(cause string) string {
+	switch {
+	case cause == ReplacementCauseOwnership:
+		return "it moved between being external and being managed"
+	case cause == ReplacementCauseImport:
+		return "a different resource was imported in its place"
+	case strings.HasPrefix(cause, ReplacementCauseDiff+":"):
+		return fmt.Sprintf("of changes to %s", strings.ReplaceAll(cause[len(ReplacementCauseDiff)+1:], ",", ", "))
+	default:
+		return "of changes to its properties"
+	}
+}
+
+// recordReplacement records the cause of the replacement of old by new in new's state, so that the next deployment
+// can tell whether it replaces the resource for the same reason. If the previous deployment also replaced the resource
+// for this reason, a warning is issued: the program is likely to replace the resource on every deployment.
+func (d *Deployment) recordReplacement(old, new *resource.State, cause string) {
+	new.ReplacementCause = cause
+	if d == nil {
+		return
+	}
+
+	d.replacementsLock.Lock()
+	if d.replacementCauses == nil {
+		d.replacementCauses = map[resource.URN]string{}
+	}
+	d.replacementCauses[new.URN] = cause
+	d.replacementsLock.Unlock()
+
+	if old.ReplacementCause == cause {
+		d.Diag().Warningf(diag.RawMessage(new.URN, fmt.Sprintf(
+			"resource %v is being replaced because %s, as it was by the previous deployment; "+
+				"this may be a replacement loop", new.URN, describeReplacementCause(cause))))
+	}
+}
+
+// ReplacementCauses returns the cause of each replacement that this deployment has planned so far, keyed by URN.
+func (d *Deployment) ReplacementCauses() map[resource.URN]string {
+	d.replacementsLock.Lock()
+	defer d.replacementsLock.Unlock()
+
+	causes := make(map[resource.URN]string, len(d.replacementCauses))
+	for urn, cause := range d.replacementCauses {
+		causes[urn] = cause
+	}
+	return causes
+}
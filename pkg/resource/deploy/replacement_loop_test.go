@@ -0,0 +1,102 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/pkg/v3/util/testutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+func TestReplacementLoop(t *testing.T) {
+	t.Parallel()
+
+	newDeployment := func() *Deployment {
+		return NewTestDeployment(map[tokens.Package]plugin.Provider{"pkgA": &deploytest.Provider{}}, nil)
+	}
+	warnings := func(t *testing.T, deployment *Deployment) []string {
+		sink, ok := deployment.Diag().(*testutil.TestDiagSink)
+		require.True(t, ok)
+		return sink.WarningMsgs()
+	}
+	// persisted returns the state that a deployment would pass to the next one in place of the given new state.
+	persisted := func(new *resource.State, id resource.ID) *resource.State {
+		old := *new
+		old.ID = id
+		return &old
+	}
+
+	t.Run("diff", func(t *testing.T) {
+		t.Parallel()
+
+		// The first run replaces the resource because foo changed.
+		first := newDeployment()
+		old := newTestDeploymentResource("res", TestProviderRef("pkgA"))
+		old.ID = "id-0"
+		new := newTestDeploymentResource("res", TestProviderRef("pkgA"))
+		NewCreateReplacementStep(first, doneEvent{}, old, new, []resource.PropertyKey{"foo"}, nil, nil, true)
+		assert.Equal(t, "diff:foo", new.ReplacementCause)
+		assert.Equal(t, map[resource.URN]string{new.URN: "diff:foo"}, first.ReplacementCauses())
+		assert.Empty(t, warnings(t, first))
+
+		// The second run replaces it for a different reason, which is not a loop.
+		second := newDeployment()
+		old = persisted(new, "id-1")
+		new = newTestDeploymentResource("res", TestProviderRef("pkgA"))
+		NewCreateReplacementStep(second, doneEvent{}, old, new, []resource.PropertyKey{"foo", "bar"}, nil, nil, true)
+		assert.Equal(t, "diff:bar,foo", new.ReplacementCause)
+		assert.Empty(t, warnings(t, second))
+
+		// The third run replaces it for the same reason as the second, regardless of the order of the keys.
+		third := newDeployment()
+		old = persisted(new, "id-2")
+		new = newTestDeploymentResource("res", TestProviderRef("pkgA"))
+		NewCreateReplacementStep(third, doneEvent{}, old, new, []resource.PropertyKey{"bar", "foo"}, nil, nil, true)
+		assert.Equal(t, []string{
+			"warning: resource " + string(new.URN) + " is being replaced because of changes to bar, foo, " +
+				"as it was by the previous deployment; this may be a replacement loop\n",
+		}, warnings(t, third))
+	})
+
+	t.Run("ownership", func(t *testing.T) {
+		t.Parallel()
+
+		// The first run relinquishes a managed resource by reading a different resource in its place.
+		first := newDeployment()
+		managed := newTestDeploymentResource("res", TestProviderRef("pkgA"))
+		managed.ID = "managed-id"
+		external := newTestDeploymentResource("res", TestProviderRef("pkgA"))
+		external.ID = "external-id"
+		external.External = true
+		NewReadReplacementStep(first, nil, managed, external)
+		assert.Equal(t, ReplacementCauseOwnership, external.ReplacementCause)
+		assert.Empty(t, warnings(t, first))
+
+		// The second run takes ownership of the resource again.
+		second := newDeployment()
+		new := newTestDeploymentResource("res", TestProviderRef("pkgA"))
+		NewCreateReplacementStep(second, doneEvent{}, persisted(external, external.ID), new, nil, nil, nil, true)
+		assert.Equal(t, ReplacementCauseOwnership, new.ReplacementCause)
+		require.Len(t, warnings(t, second), 1)
+		assert.Contains(t, warnings(t, second)[0], "because it moved between being external and being managed")
+	})
+}
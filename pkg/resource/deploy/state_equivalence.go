@@ -0,0 +1,102 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// EquivOptions controls which parts of two resource states StatesEquivalent compares.
+type EquivOptions struct {
+	// IgnoreIDs ignores differences between the states' IDs.
+	IgnoreIDs bool
+	// IgnoreInputs ignores differences between the states' inputs.
+	IgnoreInputs bool
+	// IncludeTimestamps compares the states' Created and Modified timestamps, which are ignored by default.
+	IncludeTimestamps bool
+	// NormalizeSecrets treats a secret value as equal to the plain value that it wraps.
+	NormalizeSecrets bool
+}
+
+// StatesEquivalent returns true if the two states describe the same resource with the same values, according to the
+// given options. Outputs are always compared. Two nil states are equivalent; a nil state is never equivalent to a
+// non-nil one.
+func StatesEquivalent(a, b *resource.State, opts EquivOptions) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a == b {
+		return true
+	}
+
+	if a.URN != b.URN || a.Type != b.Type {
+		return false
+	}
+	if !opts.IgnoreIDs && a.ID != b.ID {
+		return false
+	}
+	if opts.IncludeTimestamps && (!timestampsEqual(a.Created, b.Created) || !timestampsEqual(a.Modified, b.Modified)) {
+		return false
+	}
+	if !opts.IgnoreInputs && !propertiesEquivalent(a.Inputs, b.Inputs, opts) {
+		return false
+	}
+	return propertiesEquivalent(a.Outputs, b.Outputs, opts)
+}
+
+func timestampsEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func propertiesEquivalent(a, b resource.PropertyMap, opts EquivOptions) bool {
+	if opts.NormalizeSecrets {
+		a, b = unwrapSecrets(a), unwrapSecrets(b)
+	}
+	return a.DeepEquals(b)
+}
+
+// unwrapSecrets returns a copy of the given properties with every secret replaced by the value it wraps.
+func unwrapSecrets(props resource.PropertyMap) resource.PropertyMap {
+	if props == nil {
+		return nil
+	}
+	result := make(resource.PropertyMap, len(props))
+	for k, v := range props {
+		result[k] = unwrapSecretValue(v)
+	}
+	return result
+}
+
+func unwrapSecretValue(v resource.PropertyValue) resource.PropertyValue {
+	switch {
+	case v.IsSecret():
+		return unwrapSecretValue(v.SecretValue().Element)
+	case v.IsArray():
+		elements := make([]resource.PropertyValue, len(v.ArrayValue()))
+		for i, e := range v.ArrayValue() {
+			elements[i] = unwrapSecretValue(e)
+		}
+		return resource.NewArrayProperty(elements)
+	case v.IsObject():
+		return resource.NewObjectProperty(unwrapSecrets(v.ObjectValue()))
+	default:
+		return v
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestStatesEquivalent(t *testing.T) {
+	t.Parallel()
+
+	ref, err := providers.NewReference("urn:pulumi:teststack::pkg::pulumi:providers:pkgA::default", "provider-id")
+	require.NoError(t, err)
+
+	created := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	modified := created.Add(time.Hour)
+	newState := func() *resource.State {
+		res := newStepTestResource("res", ref)
+		res.ID = "id"
+		res.Inputs = resource.PropertyMap{"name": resource.NewStringProperty("res")}
+		res.Outputs = resource.PropertyMap{
+			"name": resource.NewStringProperty("res"),
+			"nested": resource.NewObjectProperty(resource.PropertyMap{
+				"password": resource.NewStringProperty("hunter2"),
+			}),
+		}
+		res.Created, res.Modified = &created, &created
+		return res
+	}
+
+	a := newState()
+	assert.True(t, StatesEquivalent(a, newState(), EquivOptions{}))
+	assert.True(t, StatesEquivalent(nil, nil, EquivOptions{}))
+	assert.False(t, StatesEquivalent(a, nil, EquivOptions{}))
+
+	t.Run("timestamps", func(t *testing.T) {
+		t.Parallel()
+
+		b := newState()
+		b.Modified = &modified
+		assert.True(t, StatesEquivalent(a, b, EquivOptions{}))
+		assert.False(t, StatesEquivalent(a, b, EquivOptions{IncludeTimestamps: true}))
+
+		// Timestamps that denote the same instant in different locations are equal.
+		c := newState()
+		inLocal := created.In(time.FixedZone("UTC+1", 60*60))
+		c.Created = &inLocal
+		assert.True(t, StatesEquivalent(a, c, EquivOptions{IncludeTimestamps: true}))
+	})
+
+	t.Run("ids", func(t *testing.T) {
+		t.Parallel()
+
+		b := newState()
+		b.ID = "other-id"
+		assert.False(t, StatesEquivalent(a, b, EquivOptions{}))
+		assert.True(t, StatesEquivalent(a, b, EquivOptions{IgnoreIDs: true}))
+	})
+
+	t.Run("inputs", func(t *testing.T) {
+		t.Parallel()
+
+		b := newState()
+		b.Inputs["name"] = resource.NewStringProperty("renamed")
+		assert.False(t, StatesEquivalent(a, b, EquivOptions{}))
+		assert.True(t, StatesEquivalent(a, b, EquivOptions{IgnoreInputs: true}))
+
+		// Outputs are always compared.
+		b.Outputs["name"] = resource.NewStringProperty("renamed")
+		assert.False(t, StatesEquivalent(a, b, EquivOptions{IgnoreInputs: true}))
+	})
+
+	t.Run("secrets", func(t *testing.T) {
+		t.Parallel()
+
+		b := newState()
+		b.Outputs["nested"] = resource.NewObjectProperty(resource.PropertyMap{
+			"password": resource.MakeSecret(resource.NewStringProperty("hunter2")),
+		})
+		assert.False(t, StatesEquivalent(a, b, EquivOptions{}))
+		assert.True(t, StatesEquivalent(a, b, EquivOptions{NormalizeSecrets: true}))
+
+		b.Outputs["nested"] = resource.NewObjectProperty(resource.PropertyMap{
+			"password": resource.MakeSecret(resource.NewStringProperty("changed")),
+		})
+		assert.False(t, StatesEquivalent(a, b, EquivOptions{NormalizeSecrets: true}))
+	})
+}
@@ -15,6 +15,7 @@
 package deploy
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"strings"
@@ -55,6 +56,46 @@ type Step interface {
 	Res() *resource.State    // the latest state for the resource that is known (worst case, old).
 	Logical() bool           // true if this step represents a logical operation in the program.
 	Deployment() *Deployment // the owning deployment.
+	OperationID() string     // the ID of the deployment operation that owns this step, if any.
+}
+
+// TimingStep is a step that records how long its most recent application took.
+type TimingStep interface {
+	Step
+
+	// LastDuration returns the wall-clock time spent in the most recent call to Apply. This excludes any time the
+	// step spent queued before it was applied. It returns zero if the step has not been applied.
+	LastDuration() time.Duration
+}
+
+// TimestampedStep is a step that records when it was applied.
+type TimestampedStep interface {
+	Step
+
+	// AppliedAt returns the time, in UTC, at which the most recent call to Apply started, or nil if the step has not
+	// been applied. This is the engine's timing, which is distinct from the Created and Modified timestamps of the
+	// resource's state.
+	AppliedAt() *time.Time
+}
+
+// SkippableStep is a step that can report whether applying it is a no-op, and why.
+type SkippableStep interface {
+	Step
+
+	// SkipReason returns true and a human-readable reason if applying this step does not perform any operation on
+	// the resource, e.g. because the resource was not targeted or because Pulumi does not own its lifecycle.
+	SkipReason() (bool, string)
+}
+
+// TargetedStep is a step that can report whether its resource was explicitly targeted by the user, e.g. with
+// --target.
+type TargetedStep interface {
+	Step
+
+	// Targeted returns true if the deployment was constrained to a set of targets and this step's resource was one of
+	// them. Resources that are operated upon only because they are providers or dependents of targets are not
+	// explicitly targeted.
+	Targeted() bool
 }
 
 // SameStep is a mutating step that does nothing.
@@ -67,9 +108,20 @@ type SameStep struct {
 	// If this is a same-step for a resource being created but which was not --target'ed by the user
 	// (and thus was skipped).
 	skippedCreate bool
+	// The reason the create was skipped, if this is a skipped create.
+	targetReason string
+	// True if the resource was explicitly targeted by the user.
+	targeted bool
+	// The annotations to apply to the resource in its provider, if this is an annotate step.
+	annotations map[string]string
+	// True if the resource's provider applied the annotations.
+	annotated bool
 }
 
-var _ Step = (*SameStep)(nil)
+var (
+	_ SkippableStep = (*SameStep)(nil)
+	_ TargetedStep  = (*SameStep)(nil)
+)
 
 func NewSameStep(deployment *Deployment, reg RegisterResourceEvent, old, new *resource.State) Step {
 	contract.Requiref(old != nil, "old", "must not be nil")
@@ -98,7 +150,11 @@ func NewSameStep(deployment *Deployment, reg RegisterResourceEvent, old, new *re
 // by the user (and thus was skipped). These act as no-op steps (hence 'same') since we are not
 // actually creating the resource, but ensure that we complete resource-registration and convey the
 // right information downstream. For example, we will not write these into the checkpoint file.
-func NewSkippedCreateStep(deployment *Deployment, reg RegisterResourceEvent, new *resource.State) Step {
+//
+// The reason describes which target filter caused the create to be skipped, e.g. "not in --target set".
+func NewSkippedCreateStep(deployment *Deployment, reg RegisterResourceEvent, new *resource.State,
+	reason string,
+) Step {
 	contract.Requiref(new != nil, "new", "must not be nil")
 	contract.Requiref(new.URN != "", "new", "must have a URN")
 	contract.Requiref(new.ID == "", "new", "must not have an ID")
@@ -114,11 +170,13 @@ func NewSkippedCreateStep(deployment *Deployment, reg RegisterResourceEvent, new
 		old:           &old,
 		new:           new,
 		skippedCreate: true,
+		targetReason:  reason,
 	}
 }
 
 func (s *SameStep) Op() display.StepOp      { return OpSame }
 func (s *SameStep) Deployment() *Deployment { return s.deployment }
+func (s *SameStep) OperationID() string     { return s.deployment.operationID() }
 func (s *SameStep) Type() tokens.Type       { return s.new.Type }
 func (s *SameStep) Provider() string        { return s.new.Provider }
 func (s *SameStep) URN() resource.URN       { return s.new.URN }
@@ -131,6 +189,13 @@ func (s *SameStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error
 	// Retain the ID and outputs
 	s.new.ID = s.old.ID
 	s.new.Outputs = s.old.Outputs
+	s.Deployment().retainComponentOutputs(s)
+
+	if len(s.annotations) > 0 && !preview {
+		if err := s.annotate(); err != nil {
+			return resource.StatusOK, nil, err
+		}
+	}
 
 	// If the resource is a provider, ensure that it is present in the registry under the appropriate URNs.
 	// We can only do this if the provider is actually a same, not a skipped create.
@@ -144,7 +209,7 @@ func (s *SameStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error
 		}
 	}
 
-	complete := func() { s.reg.Done(&RegisterResult{State: s.new}) }
+	complete := func() { s.deployment.completeRegistration(s.reg, &RegisterResult{State: s.new}) }
 	return resource.StatusOK, complete, nil
 }
 
@@ -152,6 +217,20 @@ func (s *SameStep) IsSkippedCreate() bool {
 	return s.skippedCreate
 }
 
+// SkippedCreateReason returns the reason the create was skipped, or the empty string if this is not a skipped create.
+func (s *SameStep) SkippedCreateReason() string {
+	return s.targetReason
+}
+
+func (s *SameStep) Targeted() bool { return s.targeted }
+
+func (s *SameStep) SkipReason() (bool, string) {
+	if s.skippedCreate {
+		return true, "the resource was not targeted for creation"
+	}
+	return false, ""
+}
+
 // CreateStep is a mutating step that creates an entirely new resource.
 type CreateStep struct {
 	deployment    *Deployment                    // the current deployment.
@@ -163,9 +242,21 @@ type CreateStep struct {
 	detailedDiff  map[string]plugin.PropertyDiff // the structured property diff (only for replacements).
 	replacing     bool                           // true if this is a create due to a replacement.
 	pendingDelete bool                           // true if this replacement should create a pending delete.
+	duration      time.Duration                  // the time spent in the most recent call to Apply.
+	appliedAt     *time.Time                     // the time at which the most recent call to Apply started.
+	previewOuts   resource.PropertyMap           // the outputs computed by the provider during preview, if any.
+	inputsChecked bool                           // true if the inputs have already been validated by Check.
+	targeted      bool                           // true if the resource was explicitly targeted by the user.
+
+	// the provider's explanations of why each replacement key forces a replacement (only for replacements).
+	replacementMessages map[resource.PropertyKey]string
 }
 
-var _ Step = (*CreateStep)(nil)
+var (
+	_ TimingStep      = (*CreateStep)(nil)
+	_ TimestampedStep = (*CreateStep)(nil)
+	_ TargetedStep    = (*CreateStep)(nil)
+)
 
 func NewCreateStep(deployment *Deployment, reg RegisterResourceEvent, new *resource.State) Step {
 	contract.Requiref(reg != nil, "reg", "must not be nil")
@@ -203,6 +294,12 @@ func NewCreateReplacementStep(deployment *Deployment, reg RegisterResourceEvent,
 	contract.Requiref(!new.Delete, "new", "must not be marked for deletion")
 	contract.Requiref(!new.External, "new", "must not be external")
 
+	cause := diffReplacementCause(keys)
+	if old.External {
+		cause = ReplacementCauseOwnership
+	}
+	deployment.recordReplacement(old, new, cause)
+
 	return &CreateStep{
 		deployment:    deployment,
 		reg:           reg,
@@ -223,6 +320,7 @@ func (s *CreateStep) Op() display.StepOp {
 	return OpCreate
 }
 func (s *CreateStep) Deployment() *Deployment                      { return s.deployment }
+func (s *CreateStep) OperationID() string                          { return s.deployment.operationID() }
 func (s *CreateStep) Type() tokens.Type                            { return s.new.Type }
 func (s *CreateStep) Provider() string                             { return s.new.Provider }
 func (s *CreateStep) URN() resource.URN                            { return s.new.URN }
@@ -233,8 +331,60 @@ func (s *CreateStep) Keys() []resource.PropertyKey                 { return s.ke
 func (s *CreateStep) Diffs() []resource.PropertyKey                { return s.diffs }
 func (s *CreateStep) DetailedDiff() map[string]plugin.PropertyDiff { return s.detailedDiff }
 func (s *CreateStep) Logical() bool                                { return !s.replacing }
+func (s *CreateStep) LastDuration() time.Duration                  { return s.duration }
+func (s *CreateStep) AppliedAt() *time.Time                        { return s.appliedAt }
+func (s *CreateStep) Targeted() bool                               { return s.targeted }
+
+// ReplacementMessages returns the provider's explanations of why changes to the step's replacement keys force the
+// resource to be replaced, keyed by replacement key. Keys that the provider did not explain are omitted. This is nil
+// if the step is not a replacement or the provider did not explain any of its replacement keys.
+func (s *CreateStep) ReplacementMessages() map[resource.PropertyKey]string {
+	return s.replacementMessages
+}
+
+// setReplacementMessages records the given provider explanations for the step's replacement keys. Explanations for
+// keys that do not cause the replacement are ignored.
+func (s *CreateStep) setReplacementMessages(reasons map[resource.PropertyKey]string) {
+	for _, k := range s.keys {
+		if reason, ok := reasons[k]; ok && reason != "" {
+			if s.replacementMessages == nil {
+				s.replacementMessages = map[resource.PropertyKey]string{}
+			}
+			s.replacementMessages[k] = reason
+		}
+	}
+}
+
+// PreviewOutputs returns the outputs the provider computed for this resource during preview, or nil if the step has
+// not been applied in preview. Outputs the provider reported as unknown are computed values; all other outputs are
+// known.
+func (s *CreateStep) PreviewOutputs() resource.PropertyMap { return s.previewOuts }
+
+// CreateBeforeUpdate returns true if this step creates the new resource of a resource that is marked to be created
+// before it is updated. The old resource is removed only after this step succeeds.
+func (s *CreateStep) CreateBeforeUpdate() bool { return s.replacing && s.new.CreateBeforeUpdate }
+
+// MissingCreateIDError is returned by a create step when the provider's Create method succeeds without returning an
+// ID for the new resource.
+type MissingCreateIDError struct {
+	URN      resource.URN   // the URN of the resource that was created.
+	Provider tokens.Package // the package of the provider that created the resource.
+}
+
+func (e *MissingCreateIDError) Error() string {
+	return fmt.Sprintf("provider for package '%s' did not return an ID from Create", e.Provider)
+}
 
 func (s *CreateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
+	start := time.Now()
+	defer func() { s.duration = time.Since(start) }()
+	s.appliedAt = appliedAt(start)
+
+	if preview {
+		s.applyTargetStateOverride()
+	}
+	s.deployment.audit(s, s.new, preview)
+
 	var resourceError error
 	resourceStatus := resource.StatusOK
 	if s.new.Custom {
@@ -244,46 +394,91 @@ func (s *CreateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 			return resource.StatusOK, nil, err
 		}
 
-		id, outs, rst, err := prov.Create(s.URN(), s.new.Inputs, s.new.CustomTimeouts.Create, s.deployment.preview)
-		if err != nil {
-			if rst != resource.StatusPartialFailure {
-				return rst, nil, err
-			}
+		// If the inputs have not already been validated, check them now so that any failures, such as missing
+		// required inputs, are reported during preview rather than when the resource is actually created.
+		if preview && !s.inputsChecked && !providers.IsProviderType(s.new.Type) {
+			s.checkPreviewInputs(prov)
+		}
 
+		id, outs, rst, err := s.deployment.createResource(prov, s.URN(), s.new.Inputs, s.new.CustomTimeouts.Create,
+			s.deployment.preview)
+		switch ClassifyStepError(rst, err) {
+		case StepErrorNone:
+		case StepErrorPartial:
 			resourceError = err
 			resourceStatus = rst
 
-			if initErr, isInitErr := err.(*plugin.InitError); isInitErr {
-				s.new.InitErrors = initErr.Reasons
+			if reasons, isInitErr := initErrorReasons(err); isInitErr {
+				s.new.InitErrors = s.deployment.truncateInitErrors(reasons)
 			}
+		default:
+			return rst, nil, err
 		}
 
 		if !preview && id == "" {
-			return resourceStatus, nil, fmt.Errorf("provider did not return an ID from Create")
+			return resourceStatus, nil, &MissingCreateIDError{URN: s.URN(), Provider: s.new.Type.Package()}
+		}
+
+		// During preview, the provider may have been able to compute some outputs. Make sure that any outputs it
+		// reported as unknown are distinguishable from the known ones.
+		if preview {
+			outs = markUnknownOutputs(outs)
+			s.previewOuts = outs
 		}
 
 		// Copy any of the default and output properties on the live object state.
 		s.new.ID = id
 		s.new.Outputs = outs
+
+		// If requested, confirm that the resource can be read back before considering it created. A resource that
+		// fails verification has still been created, so it is recorded as a partial failure rather than dropped.
+		if s.deployment.VerifyAfterCreate && !preview && resourceError == nil &&
+			!providers.IsProviderType(s.new.Type) {
+			if err := s.verifyCreated(prov); err != nil {
+				resourceError = err
+				resourceStatus = resource.StatusPartialFailure
+			}
+		}
 	}
 
 	// Create should set the Create and Modified timestamps as the resource state has been created.
-	now := time.Now().UTC()
-	s.new.Created = &now
-	s.new.Modified = &now
+	if !s.deployment.timestampsFrozen() {
+		now := time.Now().UTC()
+		s.new.Created = &now
+		s.new.Modified = &now
+	}
 
 	// Mark the old resource as pending deletion if necessary.
 	if s.replacing && s.pendingDelete {
 		s.old.Delete = true
 	}
 
-	complete := func() { s.reg.Done(&RegisterResult{State: s.new}) }
+	complete := s.deployment.deferCompletion(s.URN(), func() { s.reg.Done(&RegisterResult{State: s.new}) })
 	if resourceError == nil {
 		return resourceStatus, complete, nil
 	}
 	return resourceStatus, complete, resourceError
 }
 
+// verifyCreated reads the newly created resource back from its provider, failing if the provider does not find it.
+// Any outputs returned by the read are merged into the new resource's outputs.
+func (s *CreateStep) verifyCreated(prov plugin.Provider) error {
+	result, _, err := prov.Read(s.URN(), s.new.ID, nil, s.new.Outputs)
+	if err != nil {
+		return fmt.Errorf("verifying created resource: %w", err)
+	}
+	if result.Outputs == nil {
+		return fmt.Errorf("resource %v was created but could not be read back", s.new.ID)
+	}
+
+	outs := s.new.Outputs.Copy()
+	for k, v := range result.Outputs {
+		outs[k] = v
+	}
+	s.new.Outputs = outs
+	return nil
+}
+
 // DeleteStep is a mutating step that deletes an existing resource. If `old` is marked "External",
 // DeleteStep is a no-op.
 type DeleteStep struct {
@@ -291,9 +486,15 @@ type DeleteStep struct {
 	old            *resource.State       // the state of the existing resource.
 	replacing      bool                  // true if part of a replacement.
 	otherDeletions map[resource.URN]bool // other resources that are planned to delete
+	duration       time.Duration         // the time spent in the most recent call to Apply.
+	appliedAt      *time.Time            // the time at which the most recent call to Apply started.
+	targeted       bool                  // true if the resource was explicitly targeted by the user.
 }
 
-var _ Step = (*DeleteStep)(nil)
+var _ TimingStep = (*DeleteStep)(nil)
+var _ TimestampedStep = (*DeleteStep)(nil)
+var _ SkippableStep = (*DeleteStep)(nil)
+var _ TargetedStep = (*DeleteStep)(nil)
 
 func NewDeleteStep(deployment *Deployment, otherDeletions map[resource.URN]bool, old *resource.State) Step {
 	contract.Requiref(old != nil, "old", "must not be nil")
@@ -359,24 +560,104 @@ func (s *DeleteStep) Op() display.StepOp {
 	}
 	return OpDelete
 }
-func (s *DeleteStep) Deployment() *Deployment { return s.deployment }
-func (s *DeleteStep) Type() tokens.Type       { return s.old.Type }
-func (s *DeleteStep) Provider() string        { return s.old.Provider }
-func (s *DeleteStep) URN() resource.URN       { return s.old.URN }
-func (s *DeleteStep) Old() *resource.State    { return s.old }
-func (s *DeleteStep) New() *resource.State    { return nil }
-func (s *DeleteStep) Res() *resource.State    { return s.old }
-func (s *DeleteStep) Logical() bool           { return !s.replacing }
-
-func isDeletedWith(with resource.URN, otherDeletions map[resource.URN]bool) bool {
-	if with == "" {
-		return false
+func (s *DeleteStep) Deployment() *Deployment     { return s.deployment }
+func (s *DeleteStep) OperationID() string         { return s.deployment.operationID() }
+func (s *DeleteStep) Type() tokens.Type           { return s.old.Type }
+func (s *DeleteStep) Provider() string            { return s.old.Provider }
+func (s *DeleteStep) URN() resource.URN           { return s.old.URN }
+func (s *DeleteStep) Old() *resource.State        { return s.old }
+func (s *DeleteStep) New() *resource.State        { return nil }
+func (s *DeleteStep) Res() *resource.State        { return s.old }
+func (s *DeleteStep) Logical() bool               { return !s.replacing }
+func (s *DeleteStep) LastDuration() time.Duration { return s.duration }
+func (s *DeleteStep) AppliedAt() *time.Time       { return s.appliedAt }
+func (s *DeleteStep) Targeted() bool              { return s.targeted }
+
+// DependsOnDeletions returns the URNs of the resources that this resource's old state refers to via its parent or
+// dependencies and that are also being deleted by this deployment. These resources must not be deleted until this
+// resource has been deleted, so the executor can use them to delete leaves before their parents when deleting in
+// parallel. The parent, if any, is listed first, followed by the dependencies in order.
+func (s *DeleteStep) DependsOnDeletions() []resource.URN {
+	var urns []resource.URN
+	seen := map[resource.URN]bool{}
+	add := func(urn resource.URN) {
+		if urn != "" && urn != s.old.URN && s.otherDeletions[urn] && !seen[urn] {
+			seen[urn] = true
+			urns = append(urns, urn)
+		}
 	}
-	r, ok := otherDeletions[with]
+
+	add(s.old.Parent)
+	for _, dep := range s.old.Dependencies {
+		add(dep)
+	}
+	return urns
+}
+
+// ResolveDeletedWith returns true if deleting the resources in otherDeletions will also delete the old resource with
+// the given URN. DeletedWith relationships are followed transitively using the deployment's old resources: if A is
+// deleted with B and B is deleted with C, then deleting C also deletes A, even if B itself is not being deleted. The
+// walk stops if it encounters a cycle.
+func (d *Deployment) ResolveDeletedWith(urn resource.URN, otherDeletions map[resource.URN]bool) bool {
+	old, ok := d.oldResource(urn)
 	if !ok {
 		return false
 	}
-	return r
+	return d.resolveDeletedWith(urn, old.DeletedWith, otherDeletions)
+}
+
+// resolveDeletedWith walks the chain of DeletedWith relationships starting at with, the resource the resource with
+// the given URN is deleted with, and returns true if any resource in the chain is in otherDeletions and its deletion
+// has not failed. A resource whose deletion failed cannot be relied upon to delete the resources deleted with it.
+func (d *Deployment) resolveDeletedWith(urn, with resource.URN, otherDeletions map[resource.URN]bool) bool {
+	visited := map[resource.URN]bool{urn: true}
+	for with != "" && !visited[with] {
+		if otherDeletions[with] && !d.deletionFailed(with) {
+			return true
+		}
+		visited[with] = true
+
+		owner, ok := d.oldResource(with)
+		if !ok {
+			break
+		}
+		with = owner.DeletedWith
+	}
+	return false
+}
+
+// recordDeletion records whether the deletion of the resource with the given URN succeeded.
+func (d *Deployment) recordDeletion(urn resource.URN, succeeded bool) {
+	if d == nil {
+		return
+	}
+	d.deletionsLock.Lock()
+	defer d.deletionsLock.Unlock()
+	if d.deletionOutcomes == nil {
+		d.deletionOutcomes = map[resource.URN]bool{}
+	}
+	d.deletionOutcomes[urn] = succeeded
+}
+
+// deletionFailed returns true if the deletion of the resource with the given URN was applied and failed. It returns
+// false if the deletion succeeded or has not yet been applied.
+func (d *Deployment) deletionFailed(urn resource.URN) bool {
+	if d == nil {
+		return false
+	}
+	d.deletionsLock.Lock()
+	defer d.deletionsLock.Unlock()
+	succeeded, applied := d.deletionOutcomes[urn]
+	return applied && !succeeded
+}
+
+// oldResource returns the old state of the resource with the given URN, if any.
+func (d *Deployment) oldResource(urn resource.URN) (*resource.State, bool) {
+	if d == nil {
+		return nil, false
+	}
+	old, ok := d.olds[urn]
+	return old, ok
 }
 
 type deleteProtectedError struct {
@@ -391,12 +672,67 @@ func (d deleteProtectedError) Error() string {
 		"`pulumi state unprotect %[2]s`", d.urn, d.urn.Quote())
 }
 
+// DeleteTooSoonError is returned by a delete step when the resource to delete was created within the deployment's
+// DeleteGracePeriod.
+type DeleteTooSoonError struct {
+	URN         resource.URN  // the URN of the resource that was not deleted.
+	Created     time.Time     // the time at which the resource was created.
+	GracePeriod time.Duration // the period after its creation in which the resource may not be deleted.
+}
+
+func (e *DeleteTooSoonError) Error() string {
+	return fmt.Sprintf("resource %v cannot be deleted because it was created at %v, within the delete grace period of %v",
+		e.URN, e.Created.Format(time.RFC3339), e.GracePeriod)
+}
+
+// checkGracePeriod returns a DeleteTooSoonError if the resource to delete was created within the deployment's
+// DeleteGracePeriod. Replacements and resources that the step does not actually delete are not checked, nor are
+// resources whose creation time is unknown.
+func (s *DeleteStep) checkGracePeriod() error {
+	d := s.deployment
+	if d == nil || d.DeleteGracePeriod <= 0 || d.AllowDeleteWithinGracePeriod {
+		return nil
+	}
+	if s.replacing || s.old.Delete || s.old.External || s.old.RetainOnDelete || s.old.Created == nil {
+		return nil
+	}
+	if time.Since(*s.old.Created) >= d.DeleteGracePeriod {
+		return nil
+	}
+	return &DeleteTooSoonError{URN: s.old.URN, Created: *s.old.Created, GracePeriod: d.DeleteGracePeriod}
+}
+
+func (s *DeleteStep) SkipReason() (bool, string) {
+	switch {
+	case s.old.External:
+		return true, "the resource is external, so Pulumi does not own its lifecycle"
+	case s.old.RetainOnDelete:
+		return true, "the resource is retained on delete"
+	case s.deployment.resolveDeletedWith(s.URN(), s.old.DeletedWith, s.otherDeletions):
+		return true, fmt.Sprintf("the resource will be deleted along with %v", s.old.DeletedWith)
+	default:
+		return false, ""
+	}
+}
+
 func (s *DeleteStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
+	start := time.Now()
+	defer func() { s.duration = time.Since(start) }()
+	s.appliedAt = appliedAt(start)
+
 	// Refuse to delete protected resources (unless we're replacing them in
 	// which case we will of checked protect elsewhere)
 	if !s.replacing && s.old.Protect {
-		return resource.StatusOK, nil, deleteProtectedError{urn: s.old.URN}
+		if s.deployment == nil || !s.deployment.AllowDeleteProtected {
+			return resource.StatusOK, nil, deleteProtectedError{urn: s.old.URN}
+		}
+		s.deployment.Diag().Warningf(diag.RawMessage(s.URN(), fmt.Sprintf(
+			"deleting protected resource %v because deletion of protected resources is allowed", s.URN())))
+	}
+	if err := s.checkGracePeriod(); err != nil {
+		return resource.StatusOK, nil, err
 	}
+	s.deployment.audit(s, s.old, preview)
 
 	if preview {
 		// Do nothing in preview
@@ -404,10 +740,14 @@ func (s *DeleteStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 		// Deleting an External resource is a no-op, since Pulumi does not own the lifecycle.
 	} else if s.old.RetainOnDelete {
 		// Deleting a "drop on delete" is a no-op as the user has explicitly asked us to not delete the resource.
-	} else if isDeletedWith(s.old.DeletedWith, s.otherDeletions) {
+	} else if s.deployment.resolveDeletedWith(s.URN(), s.old.DeletedWith, s.otherDeletions) {
 		// No need to delete this resource since this resource will be deleted by the another deletion
 	} else if s.old.Custom {
 		// Not preview and not external and not Drop and is custom, do the actual delete
+		if s.old.DeletedWith != "" && s.otherDeletions[s.old.DeletedWith] {
+			s.deployment.Diag().Infof(diag.RawMessage(s.URN(), fmt.Sprintf(
+				"deleting %v directly because the deletion of %v failed", s.URN(), s.old.DeletedWith)))
+		}
 
 		// Invoke the Delete RPC function for this provider:
 		prov, err := getProvider(s)
@@ -415,14 +755,63 @@ func (s *DeleteStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 			return resource.StatusOK, nil, err
 		}
 
-		if rst, err := prov.Delete(s.URN(), s.old.ID, s.old.Inputs, s.old.Outputs, s.old.CustomTimeouts.Delete); err != nil {
+		rst, err := s.deployment.deleteResource(prov, s.URN(), s.old.ID, s.old.Inputs, s.old.Outputs,
+			s.old.CustomTimeouts.Delete)
+		switch class := ClassifyStepError(rst, err); {
+		case rst == resource.StatusNotFound || class == StepErrorNotFound:
+			// The resource has already been deleted, e.g. by hand, so there is nothing left to do.
+			s.deployment.Diag().Infof(diag.RawMessage(s.URN(), fmt.Sprintf(
+				"resource %v was not found, so it is assumed to have already been deleted", s.old.ID)))
+		case class != StepErrorNone:
 			return rst, nil, err
+		case s.deployment.VerifyAfterDelete && !providers.IsProviderType(s.old.Type):
+			// If requested, confirm that the resource is gone before considering it deleted. A resource that is still
+			// present is left in the state so that a later deployment will try to delete it again.
+			if err := s.verifyDeleted(prov); err != nil {
+				return resource.StatusOK, nil, err
+			}
 		}
 	}
 
 	return resource.StatusOK, func() {}, nil
 }
 
+// defaultVerifyDeleteTimeout is the time allowed for a deleted resource to disappear if the resource has no delete
+// timeout.
+const defaultVerifyDeleteTimeout = 5 * time.Minute
+
+// verifyDeleted reads the deleted resource from its provider until the provider no longer finds it, failing if the
+// resource can still be read once the resource's delete timeout has elapsed.
+func (s *DeleteStep) verifyDeleted(prov plugin.Provider) error {
+	timeout := defaultVerifyDeleteTimeout
+	if s.old.CustomTimeouts.Delete > 0 {
+		timeout = time.Duration(s.old.CustomTimeouts.Delete * float64(time.Second))
+	}
+	interval := s.deployment.verifyDeleteInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		result, rst, err := prov.Read(s.URN(), s.old.ID, nil, s.old.Outputs)
+		switch class := ClassifyStepError(rst, err); {
+		case rst == resource.StatusNotFound || class == StepErrorNotFound:
+			return nil
+		case err != nil:
+			return fmt.Errorf("verifying deleted resource: %w", err)
+		case result.Outputs == nil:
+			return nil
+		}
+
+		if !time.Now().Add(interval).Before(deadline) {
+			return fmt.Errorf("resource %v was deleted but could still be read after %v", s.old.ID, timeout)
+		}
+		logging.V(7).Infof("Resource %v still exists after it was deleted; reading it again in %v", s.URN(), interval)
+		time.Sleep(interval)
+	}
+}
+
 type RemovePendingReplaceStep struct {
 	deployment *Deployment     // the current deployment.
 	old        *resource.State // the state of the existing resource.
@@ -441,6 +830,7 @@ func (s *RemovePendingReplaceStep) Op() display.StepOp {
 	return OpRemovePendingReplace
 }
 func (s *RemovePendingReplaceStep) Deployment() *Deployment { return s.deployment }
+func (s *RemovePendingReplaceStep) OperationID() string     { return s.deployment.operationID() }
 func (s *RemovePendingReplaceStep) Type() tokens.Type       { return s.old.Type }
 func (s *RemovePendingReplaceStep) Provider() string        { return s.old.Provider }
 func (s *RemovePendingReplaceStep) URN() resource.URN       { return s.old.URN }
@@ -463,9 +853,17 @@ type UpdateStep struct {
 	diffs         []resource.PropertyKey         // the keys causing a diff.
 	detailedDiff  map[string]plugin.PropertyDiff // the structured diff.
 	ignoreChanges []string                       // a list of property paths to ignore when updating.
+	duration      time.Duration                  // the time spent in the most recent call to Apply.
+	appliedAt     *time.Time                     // the time at which the most recent call to Apply started.
+	targeted      bool                           // true if the resource was explicitly targeted by the user.
+	unchanged     bool                           // true if the provider reported no change to the outputs.
 }
 
-var _ Step = (*UpdateStep)(nil)
+var (
+	_ TimingStep      = (*UpdateStep)(nil)
+	_ TimestampedStep = (*UpdateStep)(nil)
+	_ TargetedStep    = (*UpdateStep)(nil)
+)
 
 func NewUpdateStep(deployment *Deployment, reg RegisterResourceEvent, old, new *resource.State,
 	stables, diffs []resource.PropertyKey, detailedDiff map[string]plugin.PropertyDiff,
@@ -486,6 +884,8 @@ func NewUpdateStep(deployment *Deployment, reg RegisterResourceEvent, old, new *
 		"new", "must have or be a provider if it is a custom resource")
 	contract.Requiref(!new.Delete, "new", "must not be marked for deletion")
 	contract.Requiref(!new.External, "new", "must not be an external resource")
+	contract.Requiref(!new.AlwaysReplace, "new", "must not be marked to always be replaced")
+	contract.Requiref(!new.CreateBeforeUpdate, "new", "must not be marked to be created before it is updated")
 
 	return &UpdateStep{
 		deployment:    deployment,
@@ -501,6 +901,7 @@ func NewUpdateStep(deployment *Deployment, reg RegisterResourceEvent, old, new *
 
 func (s *UpdateStep) Op() display.StepOp                           { return OpUpdate }
 func (s *UpdateStep) Deployment() *Deployment                      { return s.deployment }
+func (s *UpdateStep) OperationID() string                          { return s.deployment.operationID() }
 func (s *UpdateStep) Type() tokens.Type                            { return s.new.Type }
 func (s *UpdateStep) Provider() string                             { return s.new.Provider }
 func (s *UpdateStep) URN() resource.URN                            { return s.new.URN }
@@ -510,12 +911,24 @@ func (s *UpdateStep) Res() *resource.State                         { return s.ne
 func (s *UpdateStep) Logical() bool                                { return true }
 func (s *UpdateStep) Diffs() []resource.PropertyKey                { return s.diffs }
 func (s *UpdateStep) DetailedDiff() map[string]plugin.PropertyDiff { return s.detailedDiff }
+func (s *UpdateStep) LastDuration() time.Duration                  { return s.duration }
+func (s *UpdateStep) AppliedAt() *time.Time                        { return s.appliedAt }
+func (s *UpdateStep) Targeted() bool                               { return s.targeted }
 
 func (s *UpdateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
+	start := time.Now()
+	defer func() { s.duration = time.Since(start) }()
+	s.appliedAt = appliedAt(start)
+
+	if preview {
+		s.applyTargetStateOverride()
+	}
+
 	// Always propagate the ID and timestamps even in previews and refreshes.
 	s.new.ID = s.old.ID
 	s.new.Created = s.old.Created
 	s.new.Modified = s.old.Modified
+	s.deployment.audit(s, s.new, preview)
 
 	var resourceError error
 	resourceStatus := resource.StatusOK
@@ -527,38 +940,58 @@ func (s *UpdateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 		}
 
 		// Update to the combination of the old "all" state, but overwritten with new inputs.
-		outs, rst, upderr := prov.Update(s.URN(), s.old.ID, s.old.Inputs, s.old.Outputs, s.new.Inputs,
-			s.new.CustomTimeouts.Update, s.ignoreChanges, s.deployment.preview)
-		if upderr != nil {
-			if rst != resource.StatusPartialFailure {
-				return rst, nil, upderr
-			}
-
+		result, rst, upderr := s.deployment.updateResource(prov, s.URN(), s.old.ID, s.old.Inputs, s.old.Outputs,
+			s.new.Inputs, s.new.CustomTimeouts.Update, s.ignoreChanges, s.deployment.preview)
+		switch ClassifyStepError(rst, upderr) {
+		case StepErrorNone:
+		case StepErrorPartial:
 			resourceError = upderr
 			resourceStatus = rst
 
-			if initErr, isInitErr := upderr.(*plugin.InitError); isInitErr {
-				s.new.InitErrors = initErr.Reasons
+			if reasons, isInitErr := initErrorReasons(upderr); isInitErr {
+				s.new.InitErrors = s.deployment.truncateInitErrors(reasons)
 			}
+		default:
+			return rst, nil, upderr
 		}
 
 		// Now copy any output state back in case the update triggered cascading updates to other properties.
-		s.new.Outputs = outs
+		s.new.Outputs = result.Outputs
 
-		// UpdateStep doesn't create, but does modify state.
-		// Change the Modified timestamp.
-		now := time.Now().UTC()
-		s.new.Modified = &now
+		// If the provider recreated the resource behind our back, adopt the ID it reissued.
+		if id := result.ID; id != "" && id != s.old.ID && !preview {
+			s.deployment.Diag().Warningf(diag.RawMessage(s.URN(), fmt.Sprintf(
+				"provider recreated the resource during update; its ID changed from '%s' to '%s'", s.old.ID, id)))
+			s.new.ID = id
+		}
+
+		// UpdateStep doesn't create, but does modify state, so change the Modified timestamp. If the provider reported
+		// that the update had no effect on the resource's outputs, though, leave the timestamp alone.
+		s.unchanged = !preview && resourceError == nil && s.new.ID == s.old.ID &&
+			result.Outputs.DeepEquals(s.old.Outputs)
+		if !s.unchanged && !s.deployment.timestampsFrozen() {
+			now := time.Now().UTC()
+			s.new.Modified = &now
+		}
 	}
 
 	// Finally, mark this operation as complete.
-	complete := func() { s.reg.Done(&RegisterResult{State: s.new}) }
+	complete := s.deployment.deferCompletion(s.URN(), func() { s.reg.Done(&RegisterResult{State: s.new}) })
 	if resourceError == nil {
 		return resourceStatus, complete, nil
 	}
 	return resourceStatus, complete, resourceError
 }
 
+// EffectiveOp returns the operation that this step effectively performed. This is OpSame if the provider reported
+// that the update left the resource's outputs unchanged, and OpUpdate otherwise.
+func (s *UpdateStep) EffectiveOp() display.StepOp {
+	if s.unchanged {
+		return OpSame
+	}
+	return OpUpdate
+}
+
 // ReplaceStep is a logical step indicating a resource will be replaced.  This is comprised of three physical steps:
 // a creation of the new resource, any number of intervening updates of dependents to the new resource, and then
 // a deletion of the now-replaced old resource.  This logical step is primarily here for tools and visualization.
@@ -599,6 +1032,7 @@ func NewReplaceStep(deployment *Deployment, old, new *resource.State, keys, diff
 
 func (s *ReplaceStep) Op() display.StepOp                           { return OpReplace }
 func (s *ReplaceStep) Deployment() *Deployment                      { return s.deployment }
+func (s *ReplaceStep) OperationID() string                          { return s.deployment.operationID() }
 func (s *ReplaceStep) Type() tokens.Type                            { return s.new.Type }
 func (s *ReplaceStep) Provider() string                             { return s.new.Provider }
 func (s *ReplaceStep) URN() resource.URN                            { return s.new.URN }
@@ -610,6 +1044,13 @@ func (s *ReplaceStep) Diffs() []resource.PropertyKey                { return s.d
 func (s *ReplaceStep) DetailedDiff() map[string]plugin.PropertyDiff { return s.detailedDiff }
 func (s *ReplaceStep) Logical() bool                                { return true }
 
+// PendingDelete returns true if the old resource is deleted after its replacement has been created, i.e. the
+// replacement uses create-before-delete semantics and the old resource is left pending deletion.
+func (s *ReplaceStep) PendingDelete() bool { return s.pendingDelete }
+
+// DeleteBeforeReplace returns true if the old resource is deleted before its replacement is created.
+func (s *ReplaceStep) DeleteBeforeReplace() bool { return !s.pendingDelete }
+
 func (s *ReplaceStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
 	// If this is a pending delete, we should have marked the old resource for deletion in the CreateReplacement step.
 	contract.Assertf(!s.pendingDelete || s.old.Delete,
@@ -633,8 +1074,13 @@ type ReadStep struct {
 	old        *resource.State   // the old resource state, if one exists for this urn
 	new        *resource.State   // the new resource state, to be used to query the provider
 	replacing  bool              // whether or not the new resource is replacing the old resource
+	duration   time.Duration     // the time spent in the most recent call to Apply.
+	appliedAt  *time.Time        // the time at which the most recent call to Apply started.
 }
 
+var _ TimingStep = (*ReadStep)(nil)
+var _ TimestampedStep = (*ReadStep)(nil)
+
 // NewReadStep creates a new Read step.
 func NewReadStep(deployment *Deployment, event ReadResourceEvent, old, new *resource.State) Step {
 	contract.Requiref(new != nil, "new", "must not be nil")
@@ -671,6 +1117,8 @@ func NewReadReplacementStep(deployment *Deployment, event ReadResourceEvent, old
 	contract.Requiref(old != nil, "old", "must not be nil")
 	contract.Requiref(!old.External, "old", "must not be marked as external")
 
+	deployment.recordReplacement(old, new, ReplacementCauseOwnership)
+
 	return &ReadStep{
 		deployment: deployment,
 		event:      event,
@@ -688,24 +1136,46 @@ func (s *ReadStep) Op() display.StepOp {
 	return OpRead
 }
 
-func (s *ReadStep) Deployment() *Deployment { return s.deployment }
-func (s *ReadStep) Type() tokens.Type       { return s.new.Type }
-func (s *ReadStep) Provider() string        { return s.new.Provider }
-func (s *ReadStep) URN() resource.URN       { return s.new.URN }
-func (s *ReadStep) Old() *resource.State    { return s.old }
-func (s *ReadStep) New() *resource.State    { return s.new }
-func (s *ReadStep) Res() *resource.State    { return s.new }
-func (s *ReadStep) Logical() bool           { return !s.replacing }
+func (s *ReadStep) Deployment() *Deployment     { return s.deployment }
+func (s *ReadStep) OperationID() string         { return s.deployment.operationID() }
+func (s *ReadStep) Type() tokens.Type           { return s.new.Type }
+func (s *ReadStep) Provider() string            { return s.new.Provider }
+func (s *ReadStep) URN() resource.URN           { return s.new.URN }
+func (s *ReadStep) Old() *resource.State        { return s.old }
+func (s *ReadStep) New() *resource.State        { return s.new }
+func (s *ReadStep) Res() *resource.State        { return s.new }
+func (s *ReadStep) Logical() bool               { return !s.replacing }
+func (s *ReadStep) LastDuration() time.Duration { return s.duration }
+func (s *ReadStep) AppliedAt() *time.Time       { return s.appliedAt }
+
+// ResourceNotFoundError is returned by a read step when the resource to read does not exist. Callers can use errors.As
+// to distinguish a resource that has vanished from other read failures.
+type ResourceNotFoundError struct {
+	URN resource.URN // the URN of the resource that was read
+	ID  resource.ID  // the ID of the resource that was read
+}
+
+func (e *ResourceNotFoundError) Error() string {
+	return fmt.Sprintf("resource '%s' does not exist", e.ID)
+}
 
 func (s *ReadStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
+	start := time.Now()
+	defer func() { s.duration = time.Since(start) }()
+	s.appliedAt = appliedAt(start)
+
 	urn := s.new.URN
 	id := s.new.ID
 
 	var resourceError error
 	resourceStatus := resource.StatusOK
 	// Unlike most steps, Read steps run during previews. The only time
-	// we can't run is if the ID we are given is unknown.
+	// we can't run is if the ID we are given is unknown. Outside of a preview
+	// every ID has been resolved, so an unknown ID cannot refer to any resource.
 	if id == plugin.UnknownStringValue {
+		if !preview {
+			return resource.StatusNotFound, nil, &ResourceNotFoundError{URN: urn, ID: id}
+		}
 		s.new.Outputs = resource.PropertyMap{}
 	} else {
 		prov, err := getProvider(s)
@@ -713,23 +1183,23 @@ func (s *ReadStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error
 			return resource.StatusOK, nil, err
 		}
 
-		result, rst, err := prov.Read(urn, id, nil, s.new.Inputs)
-		if err != nil {
-			if rst != resource.StatusPartialFailure {
-				return rst, nil, err
-			}
-
+		result, rst, err := s.read(prov, urn, id)
+		switch ClassifyStepError(rst, err) {
+		case StepErrorNone:
+		case StepErrorPartial:
 			resourceError = err
 			resourceStatus = rst
 
-			if initErr, isInitErr := err.(*plugin.InitError); isInitErr {
-				s.new.InitErrors = initErr.Reasons
+			if reasons, isInitErr := initErrorReasons(err); isInitErr {
+				s.new.InitErrors = s.deployment.truncateInitErrors(reasons)
 			}
+		default:
+			return rst, nil, err
 		}
 
 		// If there is no such resource, return an error indicating as such.
 		if result.Outputs == nil {
-			return resource.StatusOK, nil, fmt.Errorf("resource '%s' does not exist", id)
+			return resource.StatusNotFound, nil, &ResourceNotFoundError{URN: urn, ID: id}
 		}
 		s.new.Outputs = result.Outputs
 
@@ -748,14 +1218,9 @@ func (s *ReadStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error
 		s.new.Created = s.old.Created
 		s.new.Modified = s.old.Modified
 	}
-	var inputsChange, outputsChange bool
-	if s.old != nil {
-		inputsChange = !s.new.Inputs.DeepEquals(s.old.Inputs)
-		outputsChange = !s.new.Outputs.DeepEquals(s.old.Outputs)
-	}
 	// Only update the Modified timestamp if read provides new values that differ
 	// from the old state.
-	if inputsChange || outputsChange {
+	if s.old != nil && !s.deployment.timestampsFrozen() && !StatesEquivalent(s.old, s.new, EquivOptions{IgnoreIDs: true}) {
 		now := time.Now().UTC()
 		s.new.Modified = &now
 	}
@@ -767,6 +1232,114 @@ func (s *ReadStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error
 	return resourceStatus, complete, resourceError
 }
 
+// AdoptStep is a step indicating that a resource that was previously marked "External" will become owned by the
+// engine without being replaced. Unlike the CreateReplacement step that is otherwise issued for the transition from
+// external to owned, an adopt step keeps the resource's ID: it reads the resource's current state from its provider
+// and clears the "External" bit so that the engine manages the resource's lifecycle from then on.
+type AdoptStep struct {
+	deployment *Deployment           // the deployment that produced this adoption
+	reg        RegisterResourceEvent // the registration intent to convey a URN back to
+	old        *resource.State       // the external state of the resource being adopted
+	new        *resource.State       // the owned state of the resource after adoption
+	duration   time.Duration         // the time spent in the most recent call to Apply.
+	appliedAt  *time.Time            // the time at which the most recent call to Apply started.
+}
+
+var _ TimingStep = (*AdoptStep)(nil)
+var _ TimestampedStep = (*AdoptStep)(nil)
+
+// NewAdoptStep creates a new Adopt step.
+func NewAdoptStep(deployment *Deployment, reg RegisterResourceEvent, old, new *resource.State) Step {
+	contract.Requiref(reg != nil, "reg", "must not be nil")
+
+	contract.Requiref(old != nil, "old", "must not be nil")
+	contract.Requiref(old.URN != "", "old", "must have a URN")
+	contract.Requiref(old.ID != "", "old", "must have an ID")
+	contract.Requiref(old.External, "old", "must be marked as external")
+	contract.Requiref(!old.Delete, "old", "must not be marked for deletion")
+
+	contract.Requiref(new != nil, "new", "must not be nil")
+	contract.Requiref(new.URN == old.URN, "new", "must have the same URN as old")
+	contract.Requiref(new.ID == "" || new.ID == old.ID, "new", "must not have an ID other than old's")
+	contract.Requiref(new.Custom, "new", "must be a custom resource")
+	contract.Requiref(new.Provider != "" || providers.IsProviderType(new.Type),
+		"new", "must have or be a provider")
+	contract.Requiref(!new.Delete, "new", "must not be marked for deletion")
+
+	return &AdoptStep{
+		deployment: deployment,
+		reg:        reg,
+		old:        old,
+		new:        new,
+	}
+}
+
+func (s *AdoptStep) Op() display.StepOp          { return OpAdopt }
+func (s *AdoptStep) Deployment() *Deployment     { return s.deployment }
+func (s *AdoptStep) OperationID() string         { return s.deployment.operationID() }
+func (s *AdoptStep) Type() tokens.Type           { return s.new.Type }
+func (s *AdoptStep) Provider() string            { return s.new.Provider }
+func (s *AdoptStep) URN() resource.URN           { return s.new.URN }
+func (s *AdoptStep) Old() *resource.State        { return s.old }
+func (s *AdoptStep) New() *resource.State        { return s.new }
+func (s *AdoptStep) Res() *resource.State        { return s.new }
+func (s *AdoptStep) Logical() bool               { return true }
+func (s *AdoptStep) LastDuration() time.Duration { return s.duration }
+func (s *AdoptStep) AppliedAt() *time.Time       { return s.appliedAt }
+
+func (s *AdoptStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
+	start := time.Now()
+	defer func() { s.duration = time.Since(start) }()
+	s.appliedAt = appliedAt(start)
+
+	// The adopted resource keeps the ID of the external resource, whatever the provider reports.
+	s.new.ID = s.old.ID
+	s.new.External = false
+	s.deployment.audit(s, s.new, preview)
+
+	prov, err := getProvider(s)
+	if err != nil {
+		return resource.StatusOK, nil, err
+	}
+
+	// Like reads, adoptions only query the provider, so they also run during previews.
+	var resourceError error
+	resourceStatus := resource.StatusOK
+	result, rst, err := prov.Read(s.URN(), s.old.ID, s.new.Inputs, s.old.Outputs)
+	switch ClassifyStepError(rst, err) {
+	case StepErrorNone:
+	case StepErrorPartial:
+		resourceError = err
+		resourceStatus = rst
+
+		if reasons, isInitErr := initErrorReasons(err); isInitErr {
+			s.new.InitErrors = s.deployment.truncateInitErrors(reasons)
+		}
+	default:
+		return rst, nil, err
+	}
+	result = copyReadResult(result)
+	if result.Outputs == nil {
+		return resource.StatusNotFound, nil, &ResourceNotFoundError{URN: s.URN(), ID: s.old.ID}
+	}
+	s.new.Outputs = result.Outputs
+
+	// The resource already exists, so it keeps its creation time. Adopting a resource changes its state whether or not
+	// its outputs changed, so the modification time is always updated.
+	s.new.Created = s.old.Created
+	s.new.Modified = s.old.Modified
+	if !s.deployment.timestampsFrozen() {
+		now := time.Now().UTC()
+		s.new.Modified = &now
+	}
+
+	complete := func() { s.reg.Done(&RegisterResult{State: s.new}) }
+	if resourceError == nil {
+		return resourceStatus, complete, nil
+	}
+	return resourceStatus, complete, resourceError
+}
+
 // RefreshStep is a step used to track the progress of a refresh operation. A refresh operation updates the an existing
 // resource by reading its current state from its provider plugin. These steps are not issued by the step generator;
 // instead, they are issued by the deployment executor as the optional first step in deployment execution.
@@ -775,8 +1348,15 @@ type RefreshStep struct {
 	old        *resource.State // the old resource state, if one exists for this urn
 	new        *resource.State // the new resource state, to be used to query the provider
 	done       chan<- bool     // the channel to use to signal completion, if any
+	duration   time.Duration   // the time spent in the most recent call to Apply.
+	appliedAt  *time.Time      // the time at which the most recent call to Apply started.
+	result     *RefreshResult  // what the most recent call to Apply found, if it read the resource.
 }
 
+var _ TimingStep = (*RefreshStep)(nil)
+var _ TimestampedStep = (*RefreshStep)(nil)
+var _ SkippableStep = (*RefreshStep)(nil)
+
 // NewRefreshStep creates a new Refresh step.
 func NewRefreshStep(deployment *Deployment, old *resource.State, done chan<- bool) Step {
 	contract.Requiref(old != nil, "old", "must not be nil")
@@ -790,15 +1370,18 @@ func NewRefreshStep(deployment *Deployment, old *resource.State, done chan<- boo
 	}
 }
 
-func (s *RefreshStep) Op() display.StepOp      { return OpRefresh }
-func (s *RefreshStep) Deployment() *Deployment { return s.deployment }
-func (s *RefreshStep) Type() tokens.Type       { return s.old.Type }
-func (s *RefreshStep) Provider() string        { return s.old.Provider }
-func (s *RefreshStep) URN() resource.URN       { return s.old.URN }
-func (s *RefreshStep) Old() *resource.State    { return s.old }
-func (s *RefreshStep) New() *resource.State    { return s.new }
-func (s *RefreshStep) Res() *resource.State    { return s.old }
-func (s *RefreshStep) Logical() bool           { return false }
+func (s *RefreshStep) Op() display.StepOp          { return OpRefresh }
+func (s *RefreshStep) Deployment() *Deployment     { return s.deployment }
+func (s *RefreshStep) OperationID() string         { return s.deployment.operationID() }
+func (s *RefreshStep) Type() tokens.Type           { return s.old.Type }
+func (s *RefreshStep) Provider() string            { return s.old.Provider }
+func (s *RefreshStep) URN() resource.URN           { return s.old.URN }
+func (s *RefreshStep) Old() *resource.State        { return s.old }
+func (s *RefreshStep) New() *resource.State        { return s.new }
+func (s *RefreshStep) Res() *resource.State        { return s.old }
+func (s *RefreshStep) Logical() bool               { return false }
+func (s *RefreshStep) LastDuration() time.Duration { return s.duration }
+func (s *RefreshStep) AppliedAt() *time.Time       { return s.appliedAt }
 
 // ResultOp returns the operation that corresponds to the change to this resource after reading its current state, if
 // any.
@@ -806,13 +1389,30 @@ func (s *RefreshStep) ResultOp() display.StepOp {
 	if s.new == nil {
 		return OpDelete
 	}
-	if s.new == s.old || s.old.Outputs.Diff(s.new.Outputs) == nil {
+	if StatesEquivalent(s.old, s.new, EquivOptions{IgnoreIDs: true, IgnoreInputs: true}) {
 		return OpSame
 	}
 	return OpUpdate
 }
 
+func (s *RefreshStep) SkipReason() (bool, string) {
+	switch {
+	case providers.IsProviderType(s.old.Type):
+		return true, "provider resources are not refreshed"
+	case !s.old.Custom:
+		return true, "component resources are not refreshed"
+	case s.old.PendingReplacement:
+		return true, "resources pending replacement are not refreshed"
+	default:
+		return false, ""
+	}
+}
+
 func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
+	start := time.Now()
+	defer func() { s.duration = time.Since(start) }()
+	s.appliedAt = appliedAt(start)
+
 	var complete func()
 	if s.done != nil {
 		complete = func() { close(s.done) }
@@ -822,6 +1422,7 @@ func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, er
 
 	// Component, provider, and pending-replace resources never change with a refresh; just return the current state.
 	if !s.old.Custom || providers.IsProviderType(s.old.Type) || s.old.PendingReplacement {
+		s.recordResult()
 		return resource.StatusOK, complete, nil
 	}
 
@@ -832,13 +1433,15 @@ func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, er
 	}
 
 	var initErrors []string
+	release := s.deployment.acquireRefreshRead(s.old.Provider)
 	refreshed, rst, err := prov.Read(s.old.URN, resourceID, s.old.Inputs, s.old.Outputs)
-	if err != nil {
-		if rst != resource.StatusPartialFailure {
-			return rst, nil, err
-		}
-		if initErr, isInitErr := err.(*plugin.InitError); isInitErr {
-			initErrors = initErr.Reasons
+	release()
+	refreshed = copyReadResult(refreshed)
+	switch ClassifyStepError(rst, err) {
+	case StepErrorNone:
+	case StepErrorPartial:
+		if reasons, isInitErr := initErrorReasons(err); isInitErr {
+			initErrors = reasons
 
 			// Partial failure SHOULD NOT cause refresh to fail. Instead:
 			//
@@ -849,6 +1452,8 @@ func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, er
 			msg := fmt.Sprintf("Refreshed resource is in an unhealthy state:\n* %s", strings.Join(initErrors, "\n* "))
 			s.Deployment().Diag().Warningf(diag.RawMessage(s.URN(), msg))
 		}
+	default:
+		return rst, nil, err
 	}
 	outputs := refreshed.Outputs
 
@@ -856,6 +1461,9 @@ func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, er
 	inputs := s.old.Inputs
 	if refreshed.Inputs != nil {
 		inputs = refreshed.Inputs
+		if s.deployment.NormalizeRefreshInputs {
+			inputs = s.normalizeInputs(prov, inputs)
+		}
 	}
 
 	if outputs != nil {
@@ -867,21 +1475,22 @@ func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, er
 			resourceID = refreshed.ID
 		}
 
+		// The provider may report outputs as secret that were not secret before, so make sure that they remain secret.
+		additionalSecretOutputs := mergeSecretOutputs(s.old.AdditionalSecretOutputs, outputs)
+
 		s.new = resource.NewState(s.old.Type, s.old.URN, s.old.Custom, s.old.Delete, resourceID, inputs, outputs,
 			s.old.Parent, s.old.Protect, s.old.External, s.old.Dependencies, initErrors, s.old.Provider,
-			s.old.PropertyDependencies, s.old.PendingReplacement, s.old.AdditionalSecretOutputs, s.old.Aliases,
+			s.old.PropertyDependencies, s.old.PendingReplacement, additionalSecretOutputs, s.old.Aliases,
 			&s.old.CustomTimeouts, s.old.ImportID, s.old.RetainOnDelete, s.old.DeletedWith, s.old.Created, s.old.Modified,
 			s.old.SourcePosition,
 		)
-		var inputsChange, outputsChange bool
-		if s.old != nil {
-			inputsChange = !refreshed.Inputs.DeepEquals(s.old.Inputs)
-			outputsChange = !refreshed.Outputs.DeepEquals(s.old.Outputs)
-		}
+		s.new.AlwaysReplace = s.old.AlwaysReplace
+		s.new.CreateBeforeUpdate = s.old.CreateBeforeUpdate
+		s.new.ReplacementCause = s.old.ReplacementCause
 
 		// Only update the Modified timestamp if refresh provides new values that differ
 		// from the old state.
-		if inputsChange || outputsChange {
+		if !s.deployment.timestampsFrozen() && !StatesEquivalent(s.old, s.new, EquivOptions{IgnoreIDs: true}) {
 			// The refresh has identified an incongruence between the provider and state
 			// updated the Modified timestamp to track this.
 			now := time.Now().UTC()
@@ -890,10 +1499,59 @@ func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, er
 	} else {
 		s.new = nil
 	}
+	s.recordResult()
 
 	return rst, nil, err
 }
 
+// normalizeInputs runs the given refreshed inputs through the provider's Check method so that they include the
+// defaults and normalization that the provider applies to the inputs of a program. If the inputs cannot be checked,
+// a warning is issued and the inputs are returned as-is.
+func (s *RefreshStep) normalizeInputs(prov plugin.Provider, inputs resource.PropertyMap) resource.PropertyMap {
+	checked, failures, err := prov.Check(s.URN(), s.old.Inputs, inputs, false, DeriveRandomSeed(s.URN()))
+	var reason string
+	switch {
+	case err != nil:
+		reason = err.Error()
+	case len(failures) != 0:
+		reasons := make([]string, len(failures))
+		for i, f := range failures {
+			reasons[i] = f.Reason
+		}
+		reason = strings.Join(reasons, "; ")
+	default:
+		return checked
+	}
+
+	s.Deployment().Diag().Warningf(diag.RawMessage(s.URN(), "could not normalize refreshed inputs: "+reason))
+	return inputs
+}
+
+// mergeSecretOutputs returns the given additional secret outputs extended with the keys of any outputs that are
+// secret but not yet listed. Newly secret keys are appended in sorted order.
+func mergeSecretOutputs(additionalSecretOutputs []resource.PropertyKey,
+	outputs resource.PropertyMap,
+) []resource.PropertyKey {
+	listed := map[resource.PropertyKey]bool{}
+	for _, k := range additionalSecretOutputs {
+		listed[k] = true
+	}
+
+	var added []resource.PropertyKey
+	for _, k := range outputs.StableKeys() {
+		if outputs[k].IsSecret() && !listed[k] {
+			added = append(added, k)
+		}
+	}
+	if len(added) == 0 {
+		return additionalSecretOutputs
+	}
+
+	merged := make([]resource.PropertyKey, 0, len(additionalSecretOutputs)+len(added))
+	merged = append(merged, additionalSecretOutputs...)
+	return append(merged, added...)
+}
+
 type ImportStep struct {
 	deployment    *Deployment                    // the current deployment.
 	reg           RegisterResourceEvent          // the registration intent to convey a URN back to.
@@ -906,6 +1564,38 @@ type ImportStep struct {
 	detailedDiff  map[string]plugin.PropertyDiff // the structured property diff.
 	ignoreChanges []string                       // a list of property paths to ignore when updating.
 	randomSeed    []byte                         // the random seed to use for Check.
+	checkFailures []plugin.CheckFailure          // the check failures that were tolerated by a lenient import.
+	appliedAt     *time.Time                     // the time at which the most recent call to Apply started.
+
+	// CompositeID, if set, holds the parts of a composite ID that identifies the resource to import. The parts are
+	// joined according to the provider's composite ID scheme and take precedence over the resource's ID.
+	CompositeID []string
+}
+
+var _ TimestampedStep = (*ImportStep)(nil)
+
+// joinCompositeID joins the given parts of a composite ID for a resource of the given type using the provider's
+// separator. It returns an error if the provider does not support composite IDs or if any part is not representable.
+func joinCompositeID(prov plugin.Provider, typ tokens.Type, parts []string) (resource.ID, error) {
+	separators, err := compositeIDSeparators(prov)
+	if err != nil {
+		return "", fmt.Errorf("getting the composite ID separators for resource type '%v': %w", typ, err)
+	}
+	sep, ok := separators[typ]
+	if !ok {
+		return "", fmt.Errorf("the provider for resource type '%v' does not support composite IDs", typ)
+	}
+
+	for i, part := range parts {
+		if part == "" {
+			return "", fmt.Errorf("part %d of the composite ID for resource type '%v' is empty", i, typ)
+		}
+		if sep != "" && strings.Contains(part, sep) {
+			return "", fmt.Errorf("part %d of the composite ID for resource type '%v' contains the separator %q",
+				i, typ, sep)
+		}
+	}
+	return resource.ID(strings.Join(parts, sep)), nil
 }
 
 func NewImportStep(deployment *Deployment, reg RegisterResourceEvent, new *resource.State,
@@ -942,6 +1632,12 @@ func NewImportReplacementStep(deployment *Deployment, reg RegisterResourceEvent,
 
 	contract.Requiref(randomSeed != nil, "randomSeed", "must not be nil")
 
+	cause := ReplacementCauseImport
+	if original.External {
+		cause = ReplacementCauseOwnership
+	}
+	deployment.recordReplacement(original, new, cause)
+
 	return &ImportStep{
 		deployment:    deployment,
 		reg:           reg,
@@ -978,7 +1674,9 @@ func (s *ImportStep) Op() display.StepOp {
 }
 
 func (s *ImportStep) Deployment() *Deployment                      { return s.deployment }
+func (s *ImportStep) OperationID() string                          { return s.deployment.operationID() }
 func (s *ImportStep) Type() tokens.Type                            { return s.new.Type }
+func (s *ImportStep) AppliedAt() *time.Time                        { return s.appliedAt }
 func (s *ImportStep) Provider() string                             { return s.new.Provider }
 func (s *ImportStep) URN() resource.URN                            { return s.new.URN }
 func (s *ImportStep) Old() *resource.State                         { return s.old }
@@ -988,7 +1686,44 @@ func (s *ImportStep) Logical() bool                                { return !s.r
 func (s *ImportStep) Diffs() []resource.PropertyKey                { return s.diffs }
 func (s *ImportStep) DetailedDiff() map[string]plugin.PropertyDiff { return s.detailedDiff }
 
+// ReconciliationPatch returns the inputs that would have made the import clean, restricted to the keys that differed
+// between the user's program and the actual state of the resource. Keys that the user set but that are absent from
+// the actual state map to null. The patch is nil until the step has been applied, and empty if nothing differed.
+func (s *ImportStep) ReconciliationPatch() resource.PropertyMap {
+	if s.diffs == nil || s.old == nil {
+		return nil
+	}
+
+	patch := make(resource.PropertyMap, len(s.diffs))
+	for _, k := range s.diffs {
+		if v, ok := s.old.Inputs[k]; ok {
+			patch[k] = v
+		} else {
+			patch[k] = resource.NewNullProperty()
+		}
+	}
+	return patch
+}
+
+// CheckFailures returns the check failures that the import tolerated because the deployment's ImportLenientCheck is
+// set. The result is nil if the inputs passed validation or the import has not been applied.
+func (s *ImportStep) CheckFailures() []plugin.CheckFailure { return s.checkFailures }
+
+// RandomSeed returns the random seed that is passed to the provider's Check method when computing the imported
+// resource's inputs.
+func (s *ImportStep) RandomSeed() []byte { return s.randomSeed }
+
+// DeriveRandomSeed returns a 32-byte random seed that is deterministically derived from the given URN. The seed is the
+// SHA-256 digest of the URN, so re-running an import of the same resource passes the same seed to Check and produces
+// identical generated inputs.
+func DeriveRandomSeed(urn resource.URN) []byte {
+	seed := sha256.Sum256([]byte(urn))
+	return seed[:]
+}
+
 func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
+	s.appliedAt = appliedAt(time.Now())
+
 	complete := func() {
 		s.reg.Done(&RegisterResult{State: s.new})
 	}
@@ -1020,14 +1755,20 @@ func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 		if err != nil {
 			return resource.StatusOK, nil, err
 		}
+		if len(s.CompositeID) > 0 {
+			id, err := joinCompositeID(prov, s.new.Type, s.CompositeID)
+			if err != nil {
+				return resource.StatusOK, nil, err
+			}
+			s.new.ID = id
+		}
 		var read plugin.ReadResult
 		read, rst, err = prov.Read(s.new.URN, s.new.ID, nil, nil)
-		if err != nil {
-			if initErr, isInitErr := err.(*plugin.InitError); isInitErr {
-				s.new.InitErrors = initErr.Reasons
-			} else {
-				return rst, nil, err
-			}
+		read = copyReadResult(read)
+		if reasons, isInitErr := initErrorReasons(err); isInitErr {
+			s.new.InitErrors = s.deployment.truncateInitErrors(reasons)
+		} else if ClassifyStepError(rst, err) != StepErrorNone {
+			return rst, nil, err
 		}
 		if read.Outputs == nil {
 			return rst, nil, fmt.Errorf("resource '%v' does not exist", s.new.ID)
@@ -1054,10 +1795,12 @@ func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 		s.new.DeletedWith, nil, nil, s.new.SourcePosition)
 
 	// Import takes a resource that Pulumi did not create and imports it into pulumi state.
-	now := time.Now().UTC()
-	s.new.Modified = &now
-	// Set Created to now as the resource has been created in the state.
-	s.new.Created = &now
+	if !s.deployment.timestampsFrozen() {
+		now := time.Now().UTC()
+		s.new.Modified = &now
+		// Set Created to now as the resource has been created in the state.
+		s.new.Created = &now
+	}
 
 	// If this is a component we don't need to do the rest of the input validation
 	if !s.new.Custom {
@@ -1138,15 +1881,20 @@ func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 	if err != nil {
 		return rst, nil, err
 	}
-	if issueCheckErrors(s.deployment, s.new, s.new.URN, failures) {
-		return rst, nil, errors.New("one or more inputs failed to validate")
+	if s.deployment.ImportLenientCheck && len(failures) != 0 {
+		// Report the failures as warnings and carry on with the inputs as given, as planned imports do.
+		issueCheckFailures(s.deployment.Diag().Warningf, s.new, s.new.URN, failures)
+		s.checkFailures = failures
+	} else {
+		if issueCheckErrors(s.deployment, s.new, s.new.URN, failures) {
+			return rst, nil, errors.New("one or more inputs failed to validate")
+		}
+		s.new.Inputs = inputs
 	}
-	s.new.Inputs = inputs
 
 	// Diff the user inputs against the provider inputs. If there are any differences, fail the import unless this step
 	// is from an import deployment.
-	diff, err := diffResource(s.new.URN, s.new.ID, s.old.Inputs, s.old.Outputs, s.new.Inputs, prov, preview,
-		s.ignoreChanges)
+	diff, err := s.diff(prov, preview)
 	if err != nil {
 		return rst, nil, err
 	}
@@ -1156,6 +1904,11 @@ func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 	if diff.Changes != plugin.DiffNone {
 		const message = "inputs to import do not match the existing resource"
 
+		if keys := s.ReplacementKeys(); len(keys) > 0 {
+			s.deployment.ctx.Diag.Warningf(diag.StreamMessage(s.new.URN, fmt.Sprintf(
+				"importing with these inputs would force replacement because of changes to %v", keys), 0))
+		}
+
 		if preview {
 			s.deployment.ctx.Diag.Warningf(diag.StreamMessage(s.new.URN,
 				message+"; importing this resource will fail", 0))
@@ -1189,6 +1942,7 @@ const (
 	OpImport               display.StepOp = "import"                 // import an existing resource.
 	OpImportReplacement    display.StepOp = "import-replacement"     // replace an existing resource
 	// with an imported resource.
+	OpAdopt display.StepOp = "adopt" // taking ownership of an external resource.
 )
 
 // StepOps contains the full set of step operation types.
@@ -1208,6 +1962,7 @@ var StepOps = []display.StepOp{
 	OpRemovePendingReplace,
 	OpImport,
 	OpImportReplacement,
+	OpAdopt,
 }
 
 // Color returns a suggested color for lines of this op type.
@@ -1231,7 +1986,7 @@ func Color(op display.StepOp) string {
 		return colors.SpecRead
 	case OpReadReplacement, OpImportReplacement:
 		return colors.SpecReplace
-	case OpRefresh:
+	case OpRefresh, OpAdopt:
 		return colors.SpecUpdate
 	case OpReadDiscard, OpDiscardReplaced:
 		return colors.SpecDelete
@@ -1289,6 +2044,8 @@ func RawPrefix(op display.StepOp) string {
 		return "= "
 	case OpImportReplacement:
 		return "=>"
+	case OpAdopt:
+		return "& "
 	default:
 		contract.Failf("Unrecognized resource step op: %v", op)
 		return ""
@@ -1309,6 +2066,8 @@ func PastTense(op display.StepOp) string {
 		return "deleted"
 	case OpImport, OpImportReplacement:
 		return "imported"
+	case OpAdopt:
+		return "adopted"
 	default:
 		contract.Failf("Unexpected resource step op: %v", op)
 		return ""
@@ -1318,12 +2077,19 @@ func PastTense(op display.StepOp) string {
 // Suffix returns a suggested suffix for lines of this op type.
 func Suffix(op display.StepOp) string {
 	switch op {
-	case OpCreateReplacement, OpUpdate, OpReplace, OpReadReplacement, OpRefresh, OpImportReplacement:
+	case OpCreateReplacement, OpUpdate, OpReplace, OpReadReplacement, OpRefresh, OpImportReplacement,
+		OpAdopt:
 		return colors.Reset // updates and replacements colorize individual lines; get has none
 	}
 	return ""
 }
 
+// appliedAt returns a pointer to the UTC form of the given start time of a call to Apply.
+func appliedAt(start time.Time) *time.Time {
+	t := start.UTC()
+	return &t
+}
+
 // ConstrainedTo returns true if this operation is no more impactful than the constraint.
 func ConstrainedTo(op display.StepOp, constraint display.StepOp) bool {
 	var allowed []display.StepOp
@@ -1335,8 +2101,10 @@ func ConstrainedTo(op display.StepOp, constraint display.StepOp) bool {
 		allowed = []display.StepOp{OpSame, OpCreate}
 	case OpUpdate:
 		allowed = []display.StepOp{OpSame, OpUpdate}
+	case OpAdopt:
+		allowed = []display.StepOp{OpSame, OpAdopt}
 	case OpReplace, OpCreateReplacement, OpDeleteReplaced:
-		allowed = []display.StepOp{OpSame, OpUpdate, constraint}
+		allowed = []display.StepOp{OpSame, OpUpdate, OpAdopt, constraint}
 	}
 	for _, candidate := range allowed {
 		if candidate == op {
@@ -1346,23 +2114,97 @@ func ConstrainedTo(op display.StepOp, constraint display.StepOp) bool {
 	return false
 }
 
+// checkPreviewInputs validates the step's inputs with the provider's Check method and reports any failures as
+// warnings. The inputs returned by Check are discarded, so the random seed is simply derived from the URN. A failure
+// to validate does not abort the preview.
+func (s *CreateStep) checkPreviewInputs(prov plugin.Provider) {
+	var olds resource.PropertyMap
+	if s.old != nil {
+		olds = s.old.Inputs
+	}
+	_, failures, err := prov.Check(s.URN(), olds, s.new.Inputs, true, DeriveRandomSeed(s.URN()))
+	if err != nil {
+		s.deployment.Diag().Warningf(diag.RawMessage(s.URN(),
+			fmt.Sprintf("could not validate inputs during preview: %v", err)))
+		return
+	}
+	issueCheckFailures(s.deployment.Diag().Warningf, s.new, s.URN(), failures)
+}
+
+// markUnknownOutputs returns a copy of the given outputs with any unknown string sentinels replaced by computed values.
+func markUnknownOutputs(outs resource.PropertyMap) resource.PropertyMap {
+	if outs == nil {
+		return nil
+	}
+	marked := make(resource.PropertyMap, len(outs))
+	for k, v := range outs {
+		marked[k] = markUnknownValue(v)
+	}
+	return marked
+}
+
+func markUnknownValue(v resource.PropertyValue) resource.PropertyValue {
+	switch {
+	case v.IsString() && v.StringValue() == plugin.UnknownStringValue:
+		return resource.MakeComputed(resource.NewStringProperty(""))
+	case v.IsArray():
+		elems := make([]resource.PropertyValue, len(v.ArrayValue()))
+		for i, e := range v.ArrayValue() {
+			elems[i] = markUnknownValue(e)
+		}
+		return resource.NewArrayProperty(elems)
+	case v.IsObject():
+		return resource.NewObjectProperty(markUnknownOutputs(v.ObjectValue()))
+	case v.IsSecret():
+		return resource.MakeSecret(markUnknownValue(v.SecretValue().Element))
+	default:
+		return v
+	}
+}
+
 // getProvider fetches the provider for the given step.
 func getProvider(s Step) (plugin.Provider, error) {
 	if providers.IsProviderType(s.Type()) {
 		return s.Deployment().providers, nil
 	}
+	_, provider, err := lookupProvider(s)
+	if err != nil {
+		return nil, err
+	}
+	provider = withOperation(s, provider)
+	if recorder := s.Deployment().ProviderRecorder; recorder != nil {
+		provider = recorder.Wrap(provider)
+	}
+	return provider, nil
+}
+
+// ResolveProvider returns the provider that the given step uses to operate on its resource, resolving the step's
+// provider reference in the same way as the step itself, including the rejection of denied default providers. For
+// provider resources, this returns the deployment's built-in provider registry, which is responsible for
+// configuring them.
+func ResolveProvider(s Step) (plugin.Provider, error) {
+	return getProvider(s)
+}
+
+// lookupProvider resolves the provider reference of the given step, which must not be a provider resource, and
+// returns the resolved reference along with the registered provider it refers to.
+func lookupProvider(s Step) (providers.Reference, plugin.Provider, error) {
 	ref, err := providers.ParseReference(s.Provider())
 	if err != nil {
-		return nil, fmt.Errorf("bad provider reference '%v' for resource %v: %v", s.Provider(), s.URN(), err)
+		return providers.Reference{}, nil,
+			fmt.Errorf("bad provider reference '%v' for resource %v: %v", s.Provider(), s.URN(), err)
+	}
+	if resolve := s.Deployment().ResolveProviderRef; resolve != nil {
+		ref = resolve(ref)
 	}
 	if providers.IsDenyDefaultsProvider(ref) {
 		pkg := providers.GetDeniedDefaultProviderPkg(ref)
 		msg := diag.GetDefaultProviderDenied(s.URN()).Message
-		return nil, fmt.Errorf(msg, pkg, s.URN())
+		return providers.Reference{}, nil, fmt.Errorf(msg, pkg, s.URN())
 	}
 	provider, ok := s.Deployment().GetProvider(ref)
 	if !ok {
-		return nil, fmt.Errorf("unknown provider '%v' for resource %v", s.Provider(), s.URN())
+		return providers.Reference{}, nil, fmt.Errorf("unknown provider '%v' for resource %v", ref, s.URN())
 	}
-	return provider, nil
+	return ref, provider, nil
 }
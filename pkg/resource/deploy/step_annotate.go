@@ -0,0 +1,66 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// NewAnnotateStep produces a SameStep for an unchanged custom resource that additionally asks the resource's provider
+// to attach the given annotations to it. The resource's inputs and outputs are retained as for any other same step.
+// If the provider does not support ProviderCapabilityAnnotate, the annotations are skipped.
+func NewAnnotateStep(deployment *Deployment, reg RegisterResourceEvent, old, new *resource.State,
+	annotations map[string]string,
+) Step {
+	contract.Requiref(old != nil && old.Custom && !providers.IsProviderType(old.Type),
+		"old", "must be a custom resource that is not a provider")
+
+	step := NewSameStep(deployment, reg, old, new).(*SameStep)
+	step.annotations = annotations
+	return step
+}
+
+// Annotations returns the annotations that this step applies to its resource, if it is an annotate step.
+func (s *SameStep) Annotations() map[string]string {
+	return s.annotations
+}
+
+// Annotated returns true if the resource's provider applied this step's annotations.
+func (s *SameStep) Annotated() bool {
+	return s.annotated
+}
+
+// annotate asks the resource's provider to apply the step's annotations, if the provider supports doing so.
+func (s *SameStep) annotate() error {
+	ref, prov, err := lookupProvider(s)
+	if err != nil {
+		return err
+	}
+	annotator, ok := prov.(plugin.AnnotatingProvider)
+	if !ok || !s.deployment.ProviderSupports(ref, ProviderCapabilityAnnotate) {
+		return nil
+	}
+
+	if err := annotator.Annotate(s.URN(), s.old.ID, s.annotations); err != nil {
+		return fmt.Errorf("annotating resource %v: %w", s.URN(), err)
+	}
+	s.annotated = true
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestAnnotateStep(t *testing.T) {
+	t.Parallel()
+
+	annotations := map[string]string{"managed-by": "pulumi"}
+	cases := []struct {
+		name     string
+		caps     []string
+		preview  bool
+		expected bool
+	}{
+		{name: "supported", caps: []string{string(ProviderCapabilityAnnotate)}, expected: true},
+		{name: "unsupported"},
+		{name: "preview", caps: []string{string(ProviderCapabilityAnnotate)}, preview: true},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			annotated := map[resource.ID]map[string]string{}
+			deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+				CapabilitiesF: func() ([]string, error) { return c.caps, nil },
+				AnnotateF: func(urn resource.URN, id resource.ID, annotations map[string]string) error {
+					annotated[id] = annotations
+					return nil
+				},
+			})
+
+			old := newStepTestResource("res", ref)
+			old.ID = "existing-id"
+			old.Outputs = resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+			new := newStepTestResource("res", ref)
+
+			step := NewAnnotateStep(deployment, doneEvent{}, old, new, annotations).(*SameStep)
+			assert.Equal(t, OpSame, step.Op())
+			assert.Equal(t, annotations, step.Annotations())
+
+			_, _, err := step.Apply(c.preview)
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, step.Annotated())
+			assert.Equal(t, resource.ID("existing-id"), new.ID)
+			assert.Equal(t, old.Outputs, new.Outputs)
+			if c.expected {
+				assert.Equal(t, map[resource.ID]map[string]string{"existing-id": annotations}, annotated)
+			} else {
+				assert.Empty(t, annotated)
+			}
+		})
+	}
+}
+
+func TestAnnotateStepError(t *testing.T) {
+	t.Parallel()
+
+	deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+		CapabilitiesF: func() ([]string, error) { return []string{string(ProviderCapabilityAnnotate)}, nil },
+		AnnotateF: func(urn resource.URN, id resource.ID, annotations map[string]string) error {
+			return errors.New("tags are read-only")
+		},
+	})
+
+	old := newStepTestResource("res", ref)
+	old.ID = "existing-id"
+	step := NewAnnotateStep(deployment, doneEvent{}, old, newStepTestResource("res", ref),
+		map[string]string{"managed-by": "pulumi"}).(*SameStep)
+
+	_, _, err := step.Apply(false)
+	assert.ErrorContains(t, err, "tags are read-only")
+	assert.False(t, step.Annotated())
+}
@@ -0,0 +1,59 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+// ApprovalStep is a step that can report whether it should be approved before it is applied, e.g. by prompting the
+// user in an interactive session. Requiring approval does not change how the step is applied by the deployment.
+type ApprovalStep interface {
+	Step
+
+	// RequiresApproval returns true if the step should be approved before it is applied. This is decided by the
+	// deployment's ApprovalPredicate if it has one and by DefaultApprovalPredicate otherwise.
+	RequiresApproval() bool
+}
+
+var (
+	_ ApprovalStep = (*CreateStep)(nil)
+	_ ApprovalStep = (*DeleteStep)(nil)
+	_ ApprovalStep = (*ReplaceStep)(nil)
+)
+
+// DefaultApprovalPredicate returns true for the steps that destroy a resource: deletions that are not part of a
+// replacement, creations of replacements, and replacements themselves. It returns false for all other steps.
+func DefaultApprovalPredicate(step Step) bool {
+	switch step := step.(type) {
+	case *DeleteStep:
+		return !step.replacing
+	case *CreateStep:
+		return step.replacing
+	case *ReplaceStep:
+		return true
+	default:
+		return false
+	}
+}
+
+// requiresApproval applies the deployment's ApprovalPredicate, or DefaultApprovalPredicate if it has none, to the
+// given step.
+func requiresApproval(step Step) bool {
+	if d := step.Deployment(); d != nil && d.ApprovalPredicate != nil {
+		return d.ApprovalPredicate(step)
+	}
+	return DefaultApprovalPredicate(step)
+}
+
+func (s *CreateStep) RequiresApproval() bool  { return requiresApproval(s) }
+func (s *DeleteStep) RequiresApproval() bool  { return requiresApproval(s) }
+func (s *ReplaceStep) RequiresApproval() bool { return requiresApproval(s) }
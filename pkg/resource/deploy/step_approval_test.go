@@ -0,0 +1,102 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestDefaultApprovalPredicate(t *testing.T) {
+	t.Parallel()
+
+	deployment := &Deployment{}
+	ref, err := providers.NewReference("urn:pulumi:test::test::pulumi:providers:pkgA::default", "id")
+	require.NoError(t, err)
+
+	existing := func(name string) *resource.State {
+		res := newStepTestResource(name, ref)
+		res.ID = "existing-id"
+		return res
+	}
+	deletions := map[resource.URN]bool{}
+
+	cases := []struct {
+		name     string
+		step     Step
+		expected bool
+	}{
+		{"create", NewCreateStep(deployment, doneEvent{}, newStepTestResource("res", ref)), false},
+		{
+			"create replacement",
+			NewCreateReplacementStep(deployment, doneEvent{}, existing("res"), newStepTestResource("res", ref),
+				nil, nil, nil, true),
+			true,
+		},
+		{"delete", NewDeleteStep(deployment, deletions, existing("res")), true},
+		{"delete replacement", NewDeleteReplacementStep(deployment, deletions, existing("res"), true), false},
+		{
+			"replace",
+			NewReplaceStep(deployment, existing("res"), newStepTestResource("res", ref), nil, nil, nil, true),
+			true,
+		},
+		{"same", NewSameStep(deployment, doneEvent{}, existing("res"), newStepTestResource("res", ref)), false},
+		{
+			"update",
+			NewUpdateStep(deployment, doneEvent{}, existing("res"), newStepTestResource("res", ref), nil, nil, nil, nil),
+			false,
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, c.expected, DefaultApprovalPredicate(c.step))
+			if step, ok := c.step.(ApprovalStep); ok {
+				assert.Equal(t, c.expected, step.RequiresApproval())
+			}
+		})
+	}
+}
+
+func TestApprovalPredicateOverridesDefault(t *testing.T) {
+	t.Parallel()
+
+	ref, err := providers.NewReference("urn:pulumi:test::test::pulumi:providers:pkgA::default", "id")
+	require.NoError(t, err)
+	res := newStepTestResource("res", ref)
+	res.ID = "existing-id"
+
+	var seen []Step
+	deployment := &Deployment{ApprovalPredicate: func(step Step) bool {
+		seen = append(seen, step)
+		return false
+	}}
+	step := NewDeleteStep(deployment, map[resource.URN]bool{}, res)
+
+	assert.True(t, DefaultApprovalPredicate(step))
+	assert.False(t, step.(ApprovalStep).RequiresApproval())
+	assert.Equal(t, []Step{step}, seen)
+
+	// Steps that are not approval steps are unaffected by the predicate.
+	_, ok := NewSameStep(deployment, doneEvent{}, res, newStepTestResource("res", ref)).(ApprovalStep)
+	assert.False(t, ok)
+}
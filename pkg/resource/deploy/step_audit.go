@@ -0,0 +1,90 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"github.com/pulumi/pulumi/pkg/v3/display"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// AuditRedactedValue replaces the values of secret inputs in audit records.
+const AuditRedactedValue = "[secret]"
+
+// AuditRecord describes a mutating step that is about to be applied.
+type AuditRecord struct {
+	// URN is the URN of the resource the step operates upon.
+	URN resource.URN
+	// Op is the operation the step performs.
+	Op display.StepOp
+	// Provider is the reference of the provider that performs the operation, if any.
+	Provider string
+	// Inputs are the resource's inputs. Secret values, and the values of any inputs named by the resource's
+	// AdditionalSecretOutputs, are replaced with AuditRedactedValue at any depth.
+	Inputs resource.PropertyMap
+}
+
+// audit reports the given step to the deployment's AuditSink, if any. res is the state whose inputs the step applies.
+// Steps are not audited during previews.
+func (d *Deployment) audit(step Step, res *resource.State, preview bool) {
+	if d == nil || d.AuditSink == nil || preview {
+		return
+	}
+
+	inputs := make(resource.PropertyMap, len(res.Inputs))
+	for k, v := range res.Inputs {
+		inputs[k] = redactSecrets(v)
+	}
+	for _, k := range res.AdditionalSecretOutputs {
+		if _, has := inputs[k]; has {
+			inputs[k] = resource.NewStringProperty(AuditRedactedValue)
+		}
+	}
+
+	d.AuditSink(AuditRecord{
+		URN:      step.URN(),
+		Op:       step.Op(),
+		Provider: step.Provider(),
+		Inputs:   inputs,
+	})
+}
+
+// redactSecrets returns a copy of the given value in which every secret value is replaced with AuditRedactedValue.
+func redactSecrets(v resource.PropertyValue) resource.PropertyValue {
+	switch {
+	case v.IsSecret():
+		return resource.NewStringProperty(AuditRedactedValue)
+	case v.IsOutput():
+		o := v.OutputValue()
+		if o.Secret {
+			return resource.NewStringProperty(AuditRedactedValue)
+		}
+		o.Element = redactSecrets(o.Element)
+		return resource.NewOutputProperty(o)
+	case v.IsArray():
+		arr := make([]resource.PropertyValue, len(v.ArrayValue()))
+		for i, e := range v.ArrayValue() {
+			arr[i] = redactSecrets(e)
+		}
+		return resource.NewArrayProperty(arr)
+	case v.IsObject():
+		obj := make(resource.PropertyMap, len(v.ObjectValue()))
+		for k, e := range v.ObjectValue() {
+			obj[k] = redactSecrets(e)
+		}
+		return resource.NewObjectProperty(obj)
+	default:
+		return v
+	}
+}
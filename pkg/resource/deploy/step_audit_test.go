@@ -0,0 +1,123 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/display"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+func TestAuditSink(t *testing.T) {
+	t.Parallel()
+
+	secret := func(v resource.PropertyValue) resource.PropertyValue { return resource.MakeSecret(v) }
+	redacted := resource.NewStringProperty(AuditRedactedValue)
+
+	newResource := func(id resource.ID) *resource.State {
+		res := newTestDeploymentResource("res", TestProviderRef("pkgA"))
+		res.ID = id
+		res.Inputs = resource.PropertyMap{
+			"plain":    resource.NewStringProperty("visible"),
+			"password": secret(resource.NewStringProperty("hunter2")),
+			"nested": resource.NewObjectProperty(resource.PropertyMap{
+				"token": secret(resource.NewStringProperty("abc")),
+				"list": resource.NewArrayProperty([]resource.PropertyValue{
+					resource.NewNumberProperty(1),
+					secret(resource.NewNumberProperty(2)),
+				}),
+			}),
+			"output": resource.NewOutputProperty(resource.Output{
+				Element: resource.NewStringProperty("from-output"),
+				Known:   true,
+				Secret:  true,
+			}),
+			"additional": resource.NewStringProperty("marked-secret"),
+		}
+		res.AdditionalSecretOutputs = []resource.PropertyKey{"additional", "missing"}
+		return res
+	}
+	expected := resource.PropertyMap{
+		"plain":    resource.NewStringProperty("visible"),
+		"password": redacted,
+		"nested": resource.NewObjectProperty(resource.PropertyMap{
+			"token": redacted,
+			"list": resource.NewArrayProperty([]resource.PropertyValue{
+				resource.NewNumberProperty(1),
+				redacted,
+			}),
+		}),
+		"output":     redacted,
+		"additional": redacted,
+	}
+
+	var records []AuditRecord
+	deployment := NewTestDeployment(map[tokens.Package]plugin.Provider{"pkgA": &deploytest.Provider{
+		CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+			preview bool,
+		) (resource.ID, resource.PropertyMap, resource.Status, error) {
+			return "id", news, resource.StatusOK, nil
+		},
+		ReadF: func(urn resource.URN, id resource.ID,
+			inputs, state resource.PropertyMap,
+		) (plugin.ReadResult, resource.Status, error) {
+			return plugin.ReadResult{ID: id, Inputs: inputs, Outputs: state}, resource.StatusOK, nil
+		},
+	}}, nil)
+	deployment.AuditSink = func(record AuditRecord) { records = append(records, record) }
+
+	created := newResource("")
+	_, _, err := NewCreateStep(deployment, doneEvent{}, created).Apply(false)
+	require.NoError(t, err)
+	_, _, err = NewUpdateStep(deployment, doneEvent{}, newResource("id"), newResource(""), nil, nil, nil, nil).Apply(false)
+	require.NoError(t, err)
+	external := newResource("id")
+	external.External = true
+	_, _, err = NewAdoptStep(deployment, doneEvent{}, external, newResource("")).Apply(false)
+	require.NoError(t, err)
+	_, _, err = NewDeleteStep(deployment, map[resource.URN]bool{}, newResource("id")).Apply(false)
+	require.NoError(t, err)
+
+	require.Len(t, records, 4)
+	for i, op := range []display.StepOp{OpCreate, OpUpdate, OpAdopt, OpDelete} {
+		assert.Equal(t, op, records[i].Op)
+		assert.Equal(t, created.URN, records[i].URN)
+		assert.Equal(t, TestProviderRef("pkgA"), records[i].Provider)
+		assert.Equal(t, expected, records[i].Inputs)
+	}
+
+	// The resource's own inputs are not redacted.
+	assert.True(t, created.Inputs["password"].IsSecret())
+	assert.Equal(t, resource.NewStringProperty("marked-secret"), created.Inputs["additional"])
+
+	t.Run("preview", func(t *testing.T) {
+		t.Parallel()
+
+		var records []AuditRecord
+		deployment := NewTestDeployment(map[tokens.Package]plugin.Provider{"pkgA": &deploytest.Provider{}}, nil)
+		deployment.AuditSink = func(record AuditRecord) { records = append(records, record) }
+
+		_, _, err := NewCreateStep(deployment, doneEvent{}, newResource("")).Apply(true)
+		require.NoError(t, err)
+		assert.Empty(t, records)
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/deepcopy"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+// autoTagInputs returns the given goal inputs with the deployment's AutoTags merged into the tag property that prov
+// declares for resources of the given type, so that the provider's Check and Diff see the same inputs that are
+// saved to the resource's state. Tags that the inputs already set take precedence. The inputs are returned as they
+// are if the property holds something other than a map, e.g. an unknown value during a preview, or if the provider
+// declares no tag property for the type. Providers whose tag property paths cannot be fetched, e.g. older plugins that
+// do not report them, are treated as declaring none.
+func (d *Deployment) autoTagInputs(
+	typ tokens.Type, prov plugin.Provider, inputs resource.PropertyMap,
+) resource.PropertyMap {
+	if d == nil || len(d.AutoTags) == 0 || prov == nil || providers.IsProviderType(typ) {
+		return inputs
+	}
+	paths, err := tagPropertyPaths(prov)
+	if err != nil {
+		logging.V(7).Infof("failed to get the tag property paths for resource type '%v': %v", typ, err)
+		return inputs
+	}
+	path := paths[typ]
+	if len(path) == 0 {
+		return inputs
+	}
+
+	// Work on a copy of the inputs, as they belong to the program's registration.
+	tagged := resource.NewObjectProperty(deepcopy.Copy(inputs).(resource.PropertyMap))
+	tags := resource.PropertyMap{}
+	if existing, has := path.Get(tagged); has && !existing.IsNull() {
+		if !existing.IsObject() {
+			return inputs
+		}
+		tags = existing.ObjectValue()
+	}
+	for k, v := range d.AutoTags {
+		if _, has := tags[resource.PropertyKey(k)]; !has {
+			tags[resource.PropertyKey(k)] = resource.NewStringProperty(v)
+		}
+	}
+	tagged, ok := path.Add(tagged, resource.NewObjectProperty(tags))
+	if !ok {
+		return inputs
+	}
+	return tagged.ObjectValue()
+}
@@ -0,0 +1,211 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// taggingProvider is a test provider that declares a fixed tag property path for the test resource type.
+type taggingProvider struct {
+	*deploytest.Provider
+
+	path resource.PropertyPath
+}
+
+func (p *taggingProvider) TagPropertyPaths() (map[tokens.Type]resource.PropertyPath, error) {
+	if p.path == nil {
+		return nil, nil
+	}
+	return map[tokens.Type]resource.PropertyPath{"pkgA:m:typA": p.path}, nil
+}
+
+// generateAutoTagSteps runs the step generator over the registration of a resource named "res" with the given inputs,
+// using prov as the resource's provider and old, if non-nil, as its prior state. It returns the generated steps along
+// with the inputs that the provider's Check and Diff were given.
+func generateAutoTagSteps(
+	t *testing.T, prov *taggingProvider, old *resource.State, inputs resource.PropertyMap,
+) ([]Step, resource.PropertyMap, resource.PropertyMap) {
+	var checked, diffed resource.PropertyMap
+	prov.CheckF = func(urn resource.URN, olds, news resource.PropertyMap,
+		randomSeed []byte,
+	) (resource.PropertyMap, []plugin.CheckFailure, error) {
+		checked = news
+		return news, nil, nil
+	}
+	prov.DiffF = func(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap,
+		ignoreChanges []string,
+	) (plugin.DiffResult, error) {
+		diffed = newInputs
+		if oldInputs.DeepEquals(newInputs) {
+			return plugin.DiffResult{Changes: plugin.DiffNone}, nil
+		}
+		return plugin.DiffResult{Changes: plugin.DiffSome}, nil
+	}
+
+	deployment, ref := newStepTestDeployment(t, prov)
+	deployment.AutoTags = map[string]string{"stack": "dev", "project": "proj"}
+	deployment.target = &Target{Name: tokens.MustParseStackName("teststack")}
+	deployment.source = NewNullSource("pkg")
+	deployment.goals = &goalMap{}
+	if old != nil {
+		old.Provider = ref.String()
+		deployment.olds[old.URN] = old
+	}
+
+	goal := &resource.Goal{
+		Type:       "pkgA:m:typA",
+		Name:       "res",
+		Custom:     true,
+		Properties: inputs,
+		Provider:   ref.String(),
+	}
+	sg := newStepGenerator(deployment, Options{}, NewUrnTargets(nil), NewUrnTargets(nil))
+	steps, err := sg.generateSteps(&testRegEvent{goal: goal})
+	require.NoError(t, err)
+	require.Len(t, steps, 1)
+	return steps, checked, diffed
+}
+
+func TestAutoTags(t *testing.T) {
+	t.Parallel()
+
+	t.Run("merge over existing tags", func(t *testing.T) {
+		t.Parallel()
+
+		existingTags := resource.PropertyMap{
+			"owner": resource.NewStringProperty("me"),
+			"stack": resource.NewStringProperty("explicit"),
+		}
+		prov := &taggingProvider{Provider: &deploytest.Provider{}, path: resource.PropertyPath{"tags"}}
+		steps, checked, _ := generateAutoTagSteps(t, prov, nil,
+			resource.PropertyMap{"tags": resource.NewObjectProperty(existingTags)})
+
+		// The program's tags take precedence over the automatic ones, and Check sees the merged tags.
+		expected := resource.PropertyMap{"tags": resource.NewObjectProperty(resource.PropertyMap{
+			"owner":   resource.NewStringProperty("me"),
+			"stack":   resource.NewStringProperty("explicit"),
+			"project": resource.NewStringProperty("proj"),
+		})}
+		assert.Equal(t, OpCreate, steps[0].Op())
+		assert.Equal(t, expected, checked)
+		assert.Equal(t, expected, steps[0].New().Inputs)
+		// The program's own tags are not modified.
+		assert.Len(t, existingTags, 2)
+	})
+
+	t.Run("no diff once tagged", func(t *testing.T) {
+		t.Parallel()
+
+		// A resource whose saved inputs already carry the automatic tags has no diff when the program is unchanged.
+		tagged := resource.PropertyMap{
+			"size": resource.NewNumberProperty(2),
+			"tags": resource.NewObjectProperty(resource.PropertyMap{
+				"stack":   resource.NewStringProperty("dev"),
+				"project": resource.NewStringProperty("proj"),
+			}),
+		}
+		old := newStepTestResource("res", providers.Reference{})
+		old.ID = "existing-id"
+		old.Inputs, old.Outputs = tagged.Copy(), tagged.Copy()
+
+		prov := &taggingProvider{Provider: &deploytest.Provider{}, path: resource.PropertyPath{"tags"}}
+		steps, _, diffed := generateAutoTagSteps(t, prov, old,
+			resource.PropertyMap{"size": resource.NewNumberProperty(2)})
+		assert.Equal(t, tagged, diffed)
+		assert.Equal(t, OpSame, steps[0].Op())
+	})
+
+	t.Run("nested path", func(t *testing.T) {
+		t.Parallel()
+
+		prov := &taggingProvider{Provider: &deploytest.Provider{}, path: resource.PropertyPath{"metadata", "labels"}}
+		steps, checked, _ := generateAutoTagSteps(t, prov, nil,
+			resource.PropertyMap{"size": resource.NewNumberProperty(2)})
+
+		expected := resource.PropertyMap{
+			"size": resource.NewNumberProperty(2),
+			"metadata": resource.NewObjectProperty(resource.PropertyMap{
+				"labels": resource.NewObjectProperty(resource.PropertyMap{
+					"stack":   resource.NewStringProperty("dev"),
+					"project": resource.NewStringProperty("proj"),
+				}),
+			}),
+		}
+		assert.Equal(t, expected, checked)
+		assert.Equal(t, expected, steps[0].New().Inputs)
+	})
+
+	t.Run("unknown tags", func(t *testing.T) {
+		t.Parallel()
+
+		prov := &taggingProvider{Provider: &deploytest.Provider{}, path: resource.PropertyPath{"tags"}}
+		_, checked, _ := generateAutoTagSteps(t, prov, nil,
+			resource.PropertyMap{"tags": resource.MakeComputed(resource.NewStringProperty(""))})
+		assert.True(t, checked["tags"].IsComputed())
+	})
+
+	t.Run("no path", func(t *testing.T) {
+		t.Parallel()
+
+		// A provider that declares no path for the type does not have its resources tagged.
+		prov := &taggingProvider{Provider: &deploytest.Provider{}}
+		steps, checked, _ := generateAutoTagSteps(t, prov, nil,
+			resource.PropertyMap{"name": resource.NewStringProperty("res")})
+		assert.Equal(t, resource.PropertyMap{"name": resource.NewStringProperty("res")}, checked)
+		assert.Equal(t, resource.PropertyMap{"name": resource.NewStringProperty("res")}, steps[0].New().Inputs)
+	})
+
+	t.Run("not a tagging provider", func(t *testing.T) {
+		t.Parallel()
+
+		deployment := &Deployment{AutoTags: map[string]string{"stack": "dev"}}
+		inputs := resource.PropertyMap{"name": resource.NewStringProperty("res")}
+		prov := struct{ plugin.Provider }{&deploytest.Provider{}}
+		assert.Equal(t, inputs, deployment.autoTagInputs("pkgA:m:typA", prov, inputs))
+	})
+
+	t.Run("tag paths unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		// Providers that fail to report their tag paths, e.g. older plugins, are treated as declaring none.
+		deployment := &Deployment{AutoTags: map[string]string{"stack": "dev"}}
+		inputs := resource.PropertyMap{"name": resource.NewStringProperty("res")}
+		for _, err := range []error{
+			status.Error(codes.Unimplemented, "TagPropertyPaths is not yet implemented"),
+			errors.New("tag paths unavailable"),
+		} {
+			err := err
+			prov := &deploytest.Provider{
+				TagPropertyPathsF: func() (map[tokens.Type]resource.PropertyPath, error) {
+					return nil, err
+				},
+			}
+			assert.Equal(t, inputs, deployment.autoTagInputs("pkgA:m:typA", prov, inputs))
+		}
+	})
+}
@@ -0,0 +1,61 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/deepcopy"
+)
+
+// CloneableStep is a step that can be copied, e.g. to speculatively apply it with different inputs.
+type CloneableStep interface {
+	Step
+
+	// Clone returns a deep copy of the step. The clone shares the step's deployment and registration event, but none
+	// of its mutable state: changes to the clone's states, including those made by applying the clone, do not affect
+	// the original step.
+	Clone() Step
+}
+
+var (
+	_ CloneableStep = (*CreateStep)(nil)
+	_ CloneableStep = (*UpdateStep)(nil)
+)
+
+// Clone returns a deep copy of the step and its states.
+func (s *CreateStep) Clone() Step {
+	c := *s
+	c.old = deepcopy.Copy(s.old).(*resource.State)
+	c.new = deepcopy.Copy(s.new).(*resource.State)
+	c.keys = deepcopy.Copy(s.keys).([]resource.PropertyKey)
+	c.diffs = deepcopy.Copy(s.diffs).([]resource.PropertyKey)
+	c.detailedDiff = deepcopy.Copy(s.detailedDiff).(map[string]plugin.PropertyDiff)
+	c.previewOuts = deepcopy.Copy(s.previewOuts).(resource.PropertyMap)
+	c.replacementMessages = deepcopy.Copy(s.replacementMessages).(map[resource.PropertyKey]string)
+	return &c
+}
+
+// Clone returns a deep copy of the step and its states.
+func (s *UpdateStep) Clone() Step {
+	c := *s
+	c.old = deepcopy.Copy(s.old).(*resource.State)
+	c.new = deepcopy.Copy(s.new).(*resource.State)
+	c.stables = deepcopy.Copy(s.stables).([]resource.PropertyKey)
+	c.diffs = deepcopy.Copy(s.diffs).([]resource.PropertyKey)
+	c.detailedDiff = deepcopy.Copy(s.detailedDiff).(map[string]plugin.PropertyDiff)
+	c.ignoreChanges = deepcopy.Copy(s.ignoreChanges).([]string)
+	return &c
+}
@@ -0,0 +1,96 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+func TestStepClone(t *testing.T) {
+	t.Parallel()
+
+	deployment := NewTestDeployment(map[tokens.Package]plugin.Provider{
+		"pkgA": &deploytest.Provider{
+			CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+				preview bool,
+			) (resource.ID, resource.PropertyMap, resource.Status, error) {
+				return "created-id", news, resource.StatusOK, nil
+			},
+			UpdateF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap,
+				timeout float64, ignoreChanges []string, preview bool,
+			) (resource.PropertyMap, resource.Status, error) {
+				return newInputs, resource.StatusOK, nil
+			},
+		},
+	}, nil)
+
+	newResource := func(id resource.ID) *resource.State {
+		res := newTestDeploymentResource("res", TestProviderRef("pkgA"))
+		res.ID = id
+		res.Inputs = resource.PropertyMap{
+			"foo": resource.NewStringProperty("bar"),
+			"nested": resource.NewObjectProperty(resource.PropertyMap{
+				"list": resource.NewArrayProperty([]resource.PropertyValue{resource.NewStringProperty("a")}),
+			}),
+		}
+		return res
+	}
+
+	steps := map[string]func() Step{
+		"create": func() Step {
+			return NewCreateStep(deployment, doneEvent{}, newResource(""))
+		},
+		"replace": func() Step {
+			return NewCreateReplacementStep(deployment, doneEvent{}, newResource("old-id"), newResource(""),
+				[]resource.PropertyKey{"foo"}, []resource.PropertyKey{"foo"}, nil, true)
+		},
+		"update": func() Step {
+			return NewUpdateStep(deployment, doneEvent{}, newResource("old-id"), newResource(""),
+				nil, []resource.PropertyKey{"foo"}, nil, []string{"nested"})
+		},
+	}
+	for name, newStep := range steps {
+		newStep := newStep
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			original := newStep()
+			expected := newStep()
+			clone := original.(CloneableStep).Clone()
+			assert.Same(t, deployment, clone.Deployment())
+			assert.Equal(t, original, clone)
+
+			// Mutating the clone's inputs, at any depth, must not change the original.
+			clone.New().Inputs["foo"] = resource.NewStringProperty("baz")
+			nested := clone.New().Inputs["nested"].ObjectValue()
+			nested["list"].ArrayValue()[0] = resource.NewStringProperty("b")
+			assert.Equal(t, expected, original)
+
+			// Neither must applying the clone.
+			_, _, err := clone.Apply(false)
+			require.NoError(t, err)
+			assert.NotEqual(t, expected.New().ID, clone.New().ID)
+			assert.Equal(t, expected, original)
+		})
+	}
+}
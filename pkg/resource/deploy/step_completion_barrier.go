@@ -0,0 +1,166 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// DefaultCompletionBarrierTimeout is the default for how long a step waits for its dependents to be registered
+// before it completes anyway.
+const DefaultCompletionBarrierTimeout = 5 * time.Minute
+
+// CompletionBarrier defers the completion of create and update steps until other resources have been registered.
+// Completing a step delivers its resource's registration result back to the program, so a deferred completion holds
+// the resource back from the program until the resources that it waits for have been registered, too.
+//
+// A step whose dependents are never registered, for example because they wait for the outputs of the resource, does
+// not complete until the barrier is released or its wait times out. The deployment releases the barrier once the
+// program finishes registering resources or the deployment is canceled. It is safe for concurrent use.
+type CompletionBarrier struct {
+	m        sync.Mutex
+	cond     *sync.Cond
+	timeout  time.Duration                   // how long each completion waits for its dependents.
+	waits    map[resource.URN][]resource.URN // the dependents that each resource's completion waits for.
+	arrived  map[resource.URN]bool           // the resources that have been registered.
+	released bool                            // true once all waits have been released.
+	done     chan struct{}                   // closed once all waits have been released.
+}
+
+// NewCompletionBarrier creates a new completion barrier with no waits. Each completion waits at most timeout for its
+// dependents to be registered; a timeout of zero uses DefaultCompletionBarrierTimeout.
+func NewCompletionBarrier(timeout time.Duration) *CompletionBarrier {
+	if timeout == 0 {
+		timeout = DefaultCompletionBarrierTimeout
+	}
+	b := &CompletionBarrier{
+		timeout: timeout,
+		waits:   make(map[resource.URN][]resource.URN),
+		arrived: make(map[resource.URN]bool),
+		done:    make(chan struct{}),
+	}
+	b.cond = sync.NewCond(&b.m)
+	return b
+}
+
+// WaitFor makes the completion of the create or update step for urn wait until each of the given dependents has been
+// registered. Calling WaitFor more than once for the same URN adds to its dependents.
+func (b *CompletionBarrier) WaitFor(urn resource.URN, dependents ...resource.URN) {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	b.waits[urn] = append(b.waits[urn], dependents...)
+}
+
+// Arrive records that the resource with the given URN has been registered.
+func (b *CompletionBarrier) Arrive(urn resource.URN) {
+	if b == nil {
+		return
+	}
+
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	b.arrived[urn] = true
+	b.cond.Broadcast()
+}
+
+// Release unblocks all current and future waits, regardless of whether their dependents have been registered.
+func (b *CompletionBarrier) Release() {
+	if b == nil {
+		return
+	}
+
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	if !b.released {
+		b.released = true
+		close(b.done)
+	}
+	b.cond.Broadcast()
+}
+
+// ReleaseOnDone releases the barrier once the given context is done. This unblocks the waits of a deployment that is
+// canceled before it finishes processing registrations.
+func (b *CompletionBarrier) ReleaseOnDone(ctx context.Context) {
+	if b == nil {
+		return
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			b.Release()
+		case <-b.done:
+		}
+	}()
+}
+
+// wait blocks until each of the dependents of urn has been registered, the barrier has been released, or the wait
+// has timed out. It returns false if the wait timed out.
+func (b *CompletionBarrier) wait(urn resource.URN) bool {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	timedOut := false
+	timer := time.AfterFunc(b.timeout, func() {
+		b.m.Lock()
+		defer b.m.Unlock()
+
+		timedOut = true
+		b.cond.Broadcast()
+	})
+	defer timer.Stop()
+
+	for !b.released && !b.allArrived(b.waits[urn]) {
+		if timedOut {
+			return false
+		}
+		b.cond.Wait()
+	}
+	return true
+}
+
+// allArrived reports whether each of the given resources has been registered. The caller must hold the lock.
+func (b *CompletionBarrier) allArrived(urns []resource.URN) bool {
+	for _, urn := range urns {
+		if !b.arrived[urn] {
+			return false
+		}
+	}
+	return true
+}
+
+// deferCompletion returns a completion function that waits on the deployment's completion barrier, if any, for the
+// dependents of urn before calling complete. If the wait times out, a warning is issued and the step completes anyway.
+func (d *Deployment) deferCompletion(urn resource.URN, complete StepCompleteFunc) StepCompleteFunc {
+	if d == nil || d.CompletionBarrier == nil {
+		return complete
+	}
+	return func() {
+		if !d.CompletionBarrier.wait(urn) {
+			d.Diag().Warningf(diag.RawMessage(urn, fmt.Sprintf("completing this resource after waiting %v for the "+
+				"resources that it waits for to be registered", d.CompletionBarrier.timeout)))
+		}
+		complete()
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// signalEvent is a RegisterResourceEvent that signals a channel when it is completed.
+type signalEvent chan *RegisterResult
+
+var _ RegisterResourceEvent = signalEvent(nil)
+
+func (signalEvent) event()                        {}
+func (signalEvent) Goal() *resource.Goal          { return nil }
+func (e signalEvent) Done(result *RegisterResult) { e <- result }
+
+func TestCompletionBarrier(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		timeout time.Duration
+		release func(b *CompletionBarrier, dependent resource.URN)
+	}{
+		{
+			name:    "dependent registered",
+			release: func(b *CompletionBarrier, dependent resource.URN) { b.Arrive(dependent) },
+		},
+		{
+			name:    "barrier released",
+			release: func(b *CompletionBarrier, dependent resource.URN) { b.Release() },
+		},
+		{
+			name: "deployment canceled",
+			release: func(b *CompletionBarrier, dependent resource.URN) {
+				ctx, cancel := context.WithCancel(context.Background())
+				b.ReleaseOnDone(ctx)
+				cancel()
+			},
+		},
+		{
+			name:    "wait timed out",
+			timeout: 500 * time.Millisecond,
+			release: func(b *CompletionBarrier, dependent resource.URN) {},
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			deployment, ref := newStepTestDeployment(t, &deploytest.Provider{})
+			deployment.CompletionBarrier = NewCompletionBarrier(c.timeout)
+
+			a, b := newStepTestResource("a", ref), newStepTestResource("b", ref)
+			deployment.CompletionBarrier.WaitFor(a.URN, b.URN)
+
+			aDone, bDone := make(signalEvent, 1), make(signalEvent, 1)
+			_, completeA, err := NewCreateStep(deployment, aDone, a).Apply(false)
+			require.NoError(t, err)
+			go completeA()
+
+			// b does not wait for anything, so it completes as soon as its step does.
+			_, completeB, err := NewCreateStep(deployment, bDone, b).Apply(false)
+			require.NoError(t, err)
+			completeB()
+			assert.Equal(t, b, (<-bDone).State)
+
+			select {
+			case <-aDone:
+				t.Fatal("a completed before b was registered")
+			case <-time.After(50 * time.Millisecond):
+			}
+
+			c.release(deployment.CompletionBarrier, b.URN)
+			select {
+			case result := <-aDone:
+				assert.Equal(t, a, result.State)
+			case <-time.After(10 * time.Second):
+				t.Fatal("a did not complete")
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// retainComponentOutputs records that the given same step retained the outputs of a component resource from its old
+// state. Components that have no outputs, e.g. because they never register any, have nothing to refresh and are not
+// tracked.
+func (d *Deployment) retainComponentOutputs(s *SameStep) {
+	if d == nil || s.new.Custom || s.skippedCreate || len(s.old.Outputs) == 0 {
+		return
+	}
+
+	d.retainedComponentsLock.Lock()
+	defer d.retainedComponentsLock.Unlock()
+	if d.retainedComponents == nil {
+		d.retainedComponents = map[resource.URN]*resource.State{}
+	}
+	d.retainedComponents[s.URN()] = s.new
+}
+
+// forgetComponentOutputs records that the component resource with the given URN has registered its own outputs, which
+// replace any outputs that were retained from its old state.
+func (d *Deployment) forgetComponentOutputs(urn resource.URN) {
+	if d == nil {
+		return
+	}
+
+	d.retainedComponentsLock.Lock()
+	defer d.retainedComponentsLock.Unlock()
+	delete(d.retainedComponents, urn)
+}
+
+// childOutputsChanged records that the given resource produced new outputs. If its parent is a component whose
+// outputs were retained from its old state, each retained output that carried the child's old value for the same
+// property is refreshed with the child's new value. If that changes the parent's outputs, the refresh is propagated to
+// the parent's own parent. A component that registers its outputs later in the deployment replaces the refreshed
+// outputs with its own.
+func (d *Deployment) childOutputsChanged(old, new *resource.State) {
+	if d == nil {
+		return
+	}
+
+	d.retainedComponentsLock.Lock()
+	defer d.retainedComponentsLock.Unlock()
+	for new != nil && new.Parent != "" {
+		parent, has := d.retainedComponents[new.Parent]
+		if !has {
+			return
+		}
+
+		var olds resource.PropertyMap
+		if old != nil {
+			olds = old.Outputs
+		}
+		outs, changed := refreshRetainedOutputs(parent.Outputs, olds, new.Outputs)
+		if !changed {
+			return
+		}
+
+		parentOld := &resource.State{Outputs: parent.Outputs}
+		parent.Outputs = outs
+		old, new = parentOld, parent
+	}
+}
+
+// refreshRetainedOutputs returns a copy of the retained outputs in which each property whose value equals the child's
+// old output of the same name is replaced with the child's new output of that name, and whether any property changed.
+func refreshRetainedOutputs(retained, childOlds, childNews resource.PropertyMap) (resource.PropertyMap, bool) {
+	var outs resource.PropertyMap
+	for k, v := range retained {
+		oldValue, hadOld := childOlds[k]
+		newValue, hasNew := childNews[k]
+		if !hadOld || !hasNew || !v.DeepEquals(oldValue) || v.DeepEquals(newValue) {
+			continue
+		}
+		if outs == nil {
+			outs = retained.Copy()
+		}
+		outs[k] = newValue
+	}
+	return outs, outs != nil
+}
+
+// outputsChanged returns true if the given step produced outputs that differ from those of its old state.
+func outputsChanged(step Step) bool {
+	if step.Op() == OpSame || step.New() == nil {
+		return false
+	}
+	if update, isUpdate := step.(*UpdateStep); isUpdate && update.EffectiveOp() == OpSame {
+		return false
+	}
+	old := step.Old()
+	return old == nil || !step.New().Outputs.DeepEquals(old.Outputs)
+}
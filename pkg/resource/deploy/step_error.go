@@ -0,0 +1,102 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/rpcutil/rpcerror"
+)
+
+// StepErrorClass classifies the outcome of a provider call made while applying a step.
+type StepErrorClass int
+
+const (
+	// StepErrorNone indicates that the call succeeded.
+	StepErrorNone StepErrorClass = iota
+	// StepErrorFatal indicates that the call failed and may have left the resource in an unknown state.
+	StepErrorFatal
+	// StepErrorPartial indicates that the call changed the resource but did not fully succeed, e.g. because the
+	// resource failed to initialize. The resource's new state should still be recorded.
+	StepErrorPartial
+	// StepErrorRetryable indicates that the call failed without changing the resource, so it is safe to retry.
+	StepErrorRetryable
+	// StepErrorNotFound indicates that the resource the call operated on does not exist.
+	StepErrorNotFound
+)
+
+func (c StepErrorClass) String() string {
+	switch c {
+	case StepErrorNone:
+		return "none"
+	case StepErrorFatal:
+		return "fatal"
+	case StepErrorPartial:
+		return "partial"
+	case StepErrorRetryable:
+		return "retryable"
+	case StepErrorNotFound:
+		return "not found"
+	default:
+		return "unknown"
+	}
+}
+
+// ClassifyStepError classifies the status and error returned by a provider call. A nil error is never a failure. Only
+// calls that report resource.StatusPartialFailure are partial failures, as the resource exists but is unhealthy. A
+// failure is only retryable if it left the resource unchanged, as indicated by resource.StatusOK, and the provider
+// marked it as transient; see IsTransientError. All other failures are fatal.
+func ClassifyStepError(status resource.Status, err error) StepErrorClass {
+	switch {
+	case err == nil:
+		return StepErrorNone
+	case status == resource.StatusNotFound || plugin.IsNotFoundError(err):
+		return StepErrorNotFound
+	case status == resource.StatusPartialFailure:
+		return StepErrorPartial
+	case status == resource.StatusOK && IsTransientError(err):
+		return StepErrorRetryable
+	default:
+		return StepErrorFatal
+	}
+}
+
+// IsTransientError returns true if the provider marked the given error as transient by failing with one of the gRPC
+// codes that indicate that the same request may succeed later: Unavailable, ResourceExhausted, or Aborted.
+func IsTransientError(err error) bool {
+	rpcError, ok := rpcerror.FromError(err)
+	if !ok || rpcError == nil {
+		return false
+	}
+	switch rpcError.Code() {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// initErrorReasons returns the reasons of the given error if it is an initialization error.
+func initErrorReasons(err error) ([]string, bool) {
+	var initErr *plugin.InitError
+	if errors.As(err, &initErr) {
+		return initErr.Reasons, true
+	}
+	return nil, false
+}
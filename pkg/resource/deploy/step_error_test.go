@@ -0,0 +1,76 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+)
+
+func TestClassifyStepError(t *testing.T) {
+	t.Parallel()
+
+	initErr := &plugin.InitError{Reasons: []string{"not healthy"}}
+	plainErr := errors.New("boom")
+	notFoundErr := status.Error(codes.NotFound, "gone")
+	transientErr := status.Error(codes.Unavailable, "throttled")
+
+	cases := []struct {
+		name     string
+		status   resource.Status
+		err      error
+		expected StepErrorClass
+	}{
+		{"success", resource.StatusOK, nil, StepErrorNone},
+		{"init error", resource.StatusPartialFailure, initErr, StepErrorPartial},
+		{"init error without partial status", resource.StatusOK, fmt.Errorf("creating: %w", initErr), StepErrorFatal},
+		{"partial failure", resource.StatusPartialFailure, plainErr, StepErrorPartial},
+		{"plain error", resource.StatusOK, plainErr, StepErrorFatal},
+		{"transient error", resource.StatusOK, transientErr, StepErrorRetryable},
+		{"transient error with unknown status", resource.StatusUnknown, transientErr, StepErrorFatal},
+		{"unknown status", resource.StatusUnknown, plainErr, StepErrorFatal},
+		{"not found status", resource.StatusNotFound, plainErr, StepErrorNotFound},
+		{"not found error", resource.StatusUnknown, notFoundErr, StepErrorNotFound},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, c.expected, ClassifyStepError(c.status, c.err))
+		})
+	}
+}
+
+func TestInitErrorReasons(t *testing.T) {
+	t.Parallel()
+
+	reasons, ok := initErrorReasons(fmt.Errorf("wrapped: %w", &plugin.InitError{Reasons: []string{"a", "b"}}))
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a", "b"}, reasons)
+
+	_, ok = initErrorReasons(errors.New("boom"))
+	assert.False(t, ok)
+
+	_, ok = initErrorReasons(nil)
+	assert.False(t, ok)
+}
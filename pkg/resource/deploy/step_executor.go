@@ -187,12 +187,16 @@ func (se *stepExecutor) ExecuteRegisterResourceOutputs(e RegisterResourceOutputs
 	se.log(synchronousWorkerID,
 		"registered resource outputs %s: old=#%d, new=#%d", urn, len(reg.New().Outputs), len(outs))
 	reg.New().Outputs = outs
+	se.deployment.forgetComponentOutputs(urn)
 
 	old := se.deployment.Olds()[urn]
 	var oldOuts resource.PropertyMap
 	if old != nil {
 		oldOuts = old.Outputs
 	}
+	if old == nil || !outs.DeepEquals(oldOuts) {
+		se.deployment.childOutputsChanged(old, reg.New())
+	}
 
 	// If a plan is present check that these outputs match what we recorded before
 	if se.deployment.plan != nil {
@@ -340,7 +344,12 @@ func (se *stepExecutor) executeStep(workerID int, step Step) error {
 	}
 
 	se.log(workerID, "applying step %v on %v (preview %v)", step.Op(), step.URN(), se.preview)
-	status, stepComplete, err := step.Apply(se.preview)
+	status, stepComplete, err := se.deployment.applyStep(step, se.preview)
+
+	// Record the outcome of each deletion so that resources deleted with this one can tell whether it succeeded.
+	if _, isDelete := step.(*DeleteStep); isDelete && !se.preview {
+		se.deployment.recordDeletion(step.URN(), err == nil)
+	}
 
 	if err == nil {
 		// If we have a state object, and this is a create or update, remember it, as we may need to update it later.
@@ -351,6 +360,11 @@ func (se *stepExecutor) executeStep(workerID int, step Step) error {
 
 			se.pendingNews.Store(step.URN(), step)
 		}
+
+		// If this step produced new outputs, the outputs that its parent component retained may be stale.
+		if outputsChanged(step) {
+			se.deployment.childOutputsChanged(step.Old(), step.New())
+		}
 	}
 
 	// Ensure that any secrets properties in the output are marked as such and that the resource is tracked in the set
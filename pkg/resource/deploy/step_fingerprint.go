@@ -0,0 +1,117 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// FingerprintStep is a step that can compute a fingerprint of the state that fed it.
+type FingerprintStep interface {
+	Step
+
+	// Fingerprint returns a stable hash over the step's operation, resource, and provider, and the inputs and outputs
+	// that fed the step. Steps with equal fingerprints were computed from the same state, so the fingerprint can be used
+	// to cache diff results across runs. Timestamps are not included in the fingerprint.
+	Fingerprint() string
+}
+
+var (
+	_ FingerprintStep = (*SameStep)(nil)
+	_ FingerprintStep = (*CreateStep)(nil)
+	_ FingerprintStep = (*UpdateStep)(nil)
+)
+
+// Fingerprint returns a hash over the old resource's inputs and outputs and the new resource's inputs.
+func (s *SameStep) Fingerprint() string {
+	f := newStepFingerprint(s)
+	f.properties("oldInputs", s.old.Inputs)
+	f.properties("oldOutputs", s.old.Outputs)
+	f.properties("newInputs", s.new.Inputs)
+	return f.sum()
+}
+
+// Fingerprint returns a hash over the new resource's inputs and, for replacements, the old resource's inputs and
+// outputs.
+func (s *CreateStep) Fingerprint() string {
+	f := newStepFingerprint(s)
+	if s.old != nil {
+		f.properties("oldInputs", s.old.Inputs)
+		f.properties("oldOutputs", s.old.Outputs)
+	}
+	f.properties("newInputs", s.new.Inputs)
+	return f.sum()
+}
+
+// Fingerprint returns a hash over the old resource's inputs and outputs and the new resource's inputs.
+func (s *UpdateStep) Fingerprint() string {
+	f := newStepFingerprint(s)
+	f.properties("oldInputs", s.old.Inputs)
+	f.properties("oldOutputs", s.old.Outputs)
+	f.properties("newInputs", s.new.Inputs)
+	return f.sum()
+}
+
+// stepFingerprint accumulates a canonical serialization of the state that fed a step into a hash.
+type stepFingerprint struct {
+	h hash.Hash
+}
+
+// fingerprintOptions are the options used to serialize properties into a fingerprint. All special values are kept so
+// that, e.g., a secret and a plain value do not share a fingerprint.
+var fingerprintOptions = plugin.MarshalOptions{
+	Label:            "fingerprint",
+	KeepUnknowns:     true,
+	KeepSecrets:      true,
+	KeepResources:    true,
+	KeepOutputValues: true,
+}
+
+func newStepFingerprint(step Step) *stepFingerprint {
+	f := &stepFingerprint{h: sha256.New()}
+	f.field("op", string(step.Op()))
+	f.field("urn", string(step.URN()))
+	f.field("type", string(step.Type()))
+	f.field("provider", step.Provider())
+	return f
+}
+
+// field writes a length-prefixed name and value so that adjacent fields cannot be confused with one another.
+func (f *stepFingerprint) field(name string, value string) {
+	fmt.Fprintf(f.h, "%d:%s%d:%s", len(name), name, len(value), value)
+}
+
+// properties writes a deterministic serialization of the given properties. Object keys are always serialized in the
+// same order, regardless of the order in which they were inserted.
+func (f *stepFingerprint) properties(name string, props resource.PropertyMap) {
+	s, err := plugin.MarshalProperties(props, fingerprintOptions)
+	contract.AssertNoErrorf(err, "marshaling properties for fingerprint")
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(s)
+	contract.AssertNoErrorf(err, "serializing properties for fingerprint")
+	f.field(name, string(b))
+}
+
+func (f *stepFingerprint) sum() string {
+	return hex.EncodeToString(f.h.Sum(nil))
+}
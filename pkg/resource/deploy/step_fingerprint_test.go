@@ -0,0 +1,112 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestStepFingerprint(t *testing.T) {
+	t.Parallel()
+
+	ref, err := providers.NewReference("urn:pulumi:teststack::pkg::pulumi:providers:pkgA::default", "provider-id")
+	assert.NoError(t, err)
+	other, err := providers.NewReference("urn:pulumi:teststack::pkg::pulumi:providers:pkgA::other", "provider-id")
+	assert.NoError(t, err)
+
+	// Build the same property maps with their keys inserted in different orders.
+	forward := func() resource.PropertyMap {
+		props := resource.PropertyMap{}
+		props["a"] = resource.NewStringProperty("1")
+		props["b"] = resource.NewNumberProperty(2)
+		props["nested"] = resource.NewObjectProperty(resource.PropertyMap{
+			"x": resource.NewBoolProperty(true),
+			"y": resource.NewStringProperty("why"),
+		})
+		return props
+	}
+	backward := func() resource.PropertyMap {
+		nested := resource.PropertyMap{}
+		nested["y"] = resource.NewStringProperty("why")
+		nested["x"] = resource.NewBoolProperty(true)
+		props := resource.PropertyMap{}
+		props["nested"] = resource.NewObjectProperty(nested)
+		props["b"] = resource.NewNumberProperty(2)
+		props["a"] = resource.NewStringProperty("1")
+		return props
+	}
+
+	newState := func(ref providers.Reference, props resource.PropertyMap) *resource.State {
+		res := newStepTestResource("res", ref)
+		res.Inputs = props
+		res.Outputs = props.Copy()
+		return res
+	}
+	withID := func(res *resource.State) *resource.State {
+		res.ID = "res-id"
+		return res
+	}
+
+	steps := map[string]func(ref providers.Reference, props resource.PropertyMap) FingerprintStep{
+		"same": func(ref providers.Reference, props resource.PropertyMap) FingerprintStep {
+			return NewSameStep(nil, doneEvent{}, withID(newState(ref, props)), newState(ref, props)).(FingerprintStep)
+		},
+		"create": func(ref providers.Reference, props resource.PropertyMap) FingerprintStep {
+			return NewCreateStep(nil, doneEvent{}, newState(ref, props)).(FingerprintStep)
+		},
+		"update": func(ref providers.Reference, props resource.PropertyMap) FingerprintStep {
+			return NewUpdateStep(nil, doneEvent{}, withID(newState(ref, props)), newState(ref, props),
+				nil, nil, nil, nil).(FingerprintStep)
+		},
+	}
+	for name, newStep := range steps {
+		newStep := newStep
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expected := newStep(ref, forward()).Fingerprint()
+			assert.Equal(t, expected, newStep(ref, backward()).Fingerprint())
+
+			changed := forward()
+			changed["b"] = resource.NewNumberProperty(3)
+			assert.NotEqual(t, expected, newStep(ref, changed).Fingerprint())
+
+			secret := forward()
+			secret["a"] = resource.MakeSecret(secret["a"])
+			assert.NotEqual(t, expected, newStep(ref, secret).Fingerprint())
+
+			assert.NotEqual(t, expected, newStep(other, forward()).Fingerprint())
+
+			// Timestamps do not contribute to the fingerprint.
+			stamped := newStep(ref, forward())
+			now := time.Now()
+			stamped.Res().Created = &now
+			stamped.Res().Modified = &now
+			assert.Equal(t, expected, stamped.Fingerprint())
+		})
+	}
+
+	t.Run("operation", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NotEqual(t, steps["same"](ref, forward()).Fingerprint(), steps["update"](ref, forward()).Fingerprint())
+	})
+}
@@ -0,0 +1,48 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi/pkg/v3/display"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// StepVetoedError is returned for a step that was not applied because the deployment's StepGate rejected it.
+type StepVetoedError struct {
+	URN resource.URN   // the URN of the vetoed step
+	Op  display.StepOp // the operation of the vetoed step
+	Err error          // the error returned by the gate
+}
+
+func (e *StepVetoedError) Error() string {
+	return fmt.Sprintf("%s of '%s' was vetoed: %v", e.Op, e.URN, e.Err)
+}
+
+func (e *StepVetoedError) Unwrap() error {
+	return e.Err
+}
+
+// applyStep applies the given step if the deployment's StepGate allows it. A vetoed step has not touched its
+// resource, so it reports resource.StatusOK along with its error.
+func (d *Deployment) applyStep(step Step, preview bool) (resource.Status, StepCompleteFunc, error) {
+	if d.StepGate != nil {
+		if err := d.StepGate(step); err != nil {
+			return resource.StatusOK, nil, &StepVetoedError{URN: step.URN(), Op: step.Op(), Err: err}
+		}
+	}
+	return step.Apply(preview)
+}
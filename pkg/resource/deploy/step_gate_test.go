@@ -0,0 +1,101 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestStepGate(t *testing.T) {
+	t.Parallel()
+
+	errNoDeletes := errors.New("no deletes allowed")
+
+	var created, deleted int
+	deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+		CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+			preview bool,
+		) (resource.ID, resource.PropertyMap, resource.Status, error) {
+			created++
+			return "created-id", resource.PropertyMap{}, resource.StatusOK, nil
+		},
+		DeleteF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs resource.PropertyMap,
+			timeout float64,
+		) (resource.Status, error) {
+			deleted++
+			return resource.StatusOK, nil
+		},
+	})
+
+	var gated []Step
+	deployment.StepGate = func(step Step) error {
+		gated = append(gated, step)
+		if step.Op() == OpDelete {
+			return errNoDeletes
+		}
+		return nil
+	}
+
+	t.Run("veto delete", func(t *testing.T) {
+		old := newStepTestResource("old", ref)
+		old.ID = "old-id"
+		step := NewDeleteStep(deployment, map[resource.URN]bool{}, old)
+
+		rst, complete, err := deployment.applyStep(step, false)
+		assert.Equal(t, resource.StatusOK, rst)
+		assert.Nil(t, complete)
+		assert.EqualError(t, err, "delete of '"+string(old.URN)+"' was vetoed: no deletes allowed")
+		assert.ErrorIs(t, err, errNoDeletes)
+
+		var vetoed *StepVetoedError
+		require.True(t, errors.As(err, &vetoed))
+		assert.Equal(t, old.URN, vetoed.URN)
+		assert.Equal(t, OpDelete, vetoed.Op)
+
+		assert.Equal(t, 0, deleted)
+		assert.Equal(t, []Step{step}, gated)
+	})
+
+	t.Run("allow create", func(t *testing.T) {
+		gated = nil
+		step := NewCreateStep(deployment, doneEvent{}, newStepTestResource("new", ref))
+
+		rst, complete, err := deployment.applyStep(step, false)
+		require.NoError(t, err)
+		assert.Equal(t, resource.StatusOK, rst)
+		assert.NotNil(t, complete)
+
+		assert.Equal(t, 1, created)
+		assert.Equal(t, resource.ID("created-id"), step.New().ID)
+		assert.Equal(t, []Step{step}, gated)
+	})
+
+	t.Run("no gate", func(t *testing.T) {
+		deployment.StepGate = nil
+		old := newStepTestResource("old", ref)
+		old.ID = "old-id"
+
+		_, _, err := deployment.applyStep(NewDeleteStep(deployment, map[resource.URN]bool{}, old), false)
+		require.NoError(t, err)
+		assert.Equal(t, 1, deleted)
+	})
+}
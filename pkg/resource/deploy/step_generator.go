@@ -105,6 +105,29 @@ func (sg *stepGenerator) isTargetedForUpdate(res *resource.State) bool {
 	return false
 }
 
+// skippedCreateReason returns the reason a create is skipped because the resource was not targeted for update.
+func (sg *stepGenerator) skippedCreateReason() string {
+	if sg.opts.TargetDependents {
+		return "not in --target set and does not depend on a targeted resource"
+	}
+	return "not in --target set"
+}
+
+// markTargeted records on the given step whether its resource was explicitly named by the given targets.
+func markTargeted(step Step, targets UrnTargets) {
+	targeted := targets.IsConstrained() && targets.Contains(step.URN())
+	switch step := step.(type) {
+	case *SameStep:
+		step.targeted = targeted
+	case *CreateStep:
+		step.targeted = targeted
+	case *UpdateStep:
+		step.targeted = targeted
+	case *DeleteStep:
+		step.targeted = targeted
+	}
+}
+
 func (sg *stepGenerator) isTargetedReplace(urn resource.URN) bool {
 	return sg.opts.ReplaceTargets.IsConstrained() && sg.opts.ReplaceTargets.Contains(urn)
 }
@@ -276,6 +299,12 @@ func (sg *stepGenerator) GenerateSteps(event RegisterResourceEvent) ([]Step, err
 	for _, s := range steps {
 		logging.V(5).Infof("Checking step %s for %s", s.Op(), s.URN())
 
+		// The step generator has already validated the inputs of any resource it creates.
+		if create, ok := s.(*CreateStep); ok {
+			create.inputsChecked = true
+		}
+		markTargeted(s, sg.opts.Targets)
+
 		if sg.deployment.plan != nil {
 			if resourcePlan, ok := sg.deployment.plan.ResourcePlans[s.URN()]; ok {
 				if len(resourcePlan.Ops) == 0 {
@@ -480,6 +509,9 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, err
 		return nil, err
 	}
 
+	// Let any steps whose completion is waiting for this resource to be registered know that it has been.
+	sg.deployment.CompletionBarrier.Arrive(urn)
+
 	// Generate the aliases for this resource.
 	aliases := sg.generateAliases(goal)
 
@@ -556,6 +588,11 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, err
 		goal.Dependencies, goal.InitErrors, goal.Provider, goal.PropertyDependencies, false,
 		goal.AdditionalSecretOutputs, aliasUrns, &goal.CustomTimeouts, "", goal.RetainOnDelete, goal.DeletedWith,
 		createdAt, modifiedAt, goal.SourcePosition)
+	if hasOld {
+		// Resources that must always be replaced or created before they are updated remain so across deployments.
+		new.AlwaysReplace = old.AlwaysReplace
+		new.CreateBeforeUpdate = old.CreateBeforeUpdate
+	}
 
 	// Mark the URN/resource as having been seen. So we can run analyzers on all resources seen, as well as
 	// lookup providers for calculating replacement of resources that use the provider.
@@ -570,6 +607,11 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, err
 		return nil, err
 	}
 
+	// Merge any automatic tags into the goal state and the new inputs before they are checked and diffed.
+	goal.Properties = sg.deployment.autoTagInputs(goal.Type, prov, goal.Properties)
+	inputs = sg.deployment.autoTagInputs(goal.Type, prov, inputs)
+	new.Inputs = inputs
+
 	// We only allow unknown property values to be exposed to the provider if we are performing an update preview.
 	allowUnknowns := sg.deployment.preview
 
@@ -935,7 +977,7 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, err
 	if !isTargeted {
 		sg.sames[urn] = true
 		sg.skippedCreates[urn] = true
-		return []Step{NewSkippedCreateStep(sg.deployment, event, new)}, nil
+		return []Step{NewSkippedCreateStep(sg.deployment, event, new, sg.skippedCreateReason())}, nil
 	}
 
 	sg.creates[urn] = true
@@ -967,6 +1009,22 @@ func (sg *stepGenerator) generateStepsFromDiff(
 			"unrecognized diff state for %s: %d", urn, diff.Changes)
 	}
 
+	// A provider that reports no changes although its configuration, e.g. its credentials or region, changed must not be
+	// kept the same, as the resources that it manages would remain under a provider that is configured for the old
+	// settings. Such a provider is replaced instead.
+	if diff.Changes == plugin.DiffNone && providers.IsProviderType(new.Type) {
+		same := *new
+		same.ID = old.ID
+		changed, err := sg.deployment.SameProviderWithDiff(old, &same)
+		if err != nil {
+			return nil, fmt.Errorf("bad provider state for resource %v: %w", urn, err)
+		}
+		if len(changed) > 0 {
+			logging.V(7).Infof("Planner decided to replace provider '%v' whose configuration changed: %v", urn, changed)
+			diff = plugin.DiffResult{Changes: plugin.DiffSome, ReplaceKeys: changed, ChangedKeys: changed}
+		}
+	}
+
 	hasInitErrors := len(old.InitErrors) > 0
 
 	// Update the diff to apply any replaceOnChanges annotations and to include initErrors in the diff.
@@ -975,6 +1033,18 @@ func (sg *stepGenerator) generateStepsFromDiff(
 		return nil, err
 	}
 
+	// Resources that are marked to always be replaced are never updated in place.
+	if new.AlwaysReplace {
+		diff = applyAlwaysReplace(diff, hasInitErrors)
+	}
+
+	// Resources that must be created before they are updated are never updated in place either. Instead, the new
+	// resource is created first and the old one is deleted once the new one exists.
+	if new.CreateBeforeUpdate {
+		diff = applyAlwaysReplace(diff, hasInitErrors)
+		diff.DeleteBeforeReplace = false
+	}
+
 	// If there were changes check for a replacement vs. an in-place update.
 	if diff.Changes == plugin.DiffSome {
 		if diff.Replace() {
@@ -1041,7 +1111,7 @@ func (sg *stepGenerator) generateStepsFromDiff(
 			// the provider's decision by setting the `deleteBeforeReplace` field of `ResourceOptions` to either
 			// `true` or `false`.
 			deleteBeforeReplace := diff.DeleteBeforeReplace
-			if goal.DeleteBeforeReplace != nil {
+			if goal.DeleteBeforeReplace != nil && !new.CreateBeforeUpdate {
 				deleteBeforeReplace = *goal.DeleteBeforeReplace
 			}
 			if deleteBeforeReplace {
@@ -1107,17 +1177,21 @@ func (sg *stepGenerator) generateStepsFromDiff(
 					return nil, fmt.Errorf("could not load provider for resource %v: %w", old.URN, err)
 				}
 
+				create := NewCreateReplacementStep(
+					sg.deployment, event, old, new, diff.ReplaceKeys, diff.ChangedKeys, diff.DetailedDiff, false)
+				create.(*CreateStep).setReplacementMessages(diff.ReplaceReasons)
 				return append(steps,
 					NewDeleteReplacementStep(sg.deployment, sg.deletes, old, true),
 					NewReplaceStep(sg.deployment, old, new, diff.ReplaceKeys, diff.ChangedKeys, diff.DetailedDiff, false),
-					NewCreateReplacementStep(
-						sg.deployment, event, old, new, diff.ReplaceKeys, diff.ChangedKeys, diff.DetailedDiff, false),
+					create,
 				), nil
 			}
 
+			create := NewCreateReplacementStep(
+				sg.deployment, event, old, new, diff.ReplaceKeys, diff.ChangedKeys, diff.DetailedDiff, true)
+			create.(*CreateStep).setReplacementMessages(diff.ReplaceReasons)
 			return []Step{
-				NewCreateReplacementStep(
-					sg.deployment, event, old, new, diff.ReplaceKeys, diff.ChangedKeys, diff.DetailedDiff, true),
+				create,
 				NewReplaceStep(sg.deployment, old, new, diff.ReplaceKeys, diff.ChangedKeys, diff.DetailedDiff, true),
 				// note that the delete step is generated "later" on, after all creates/updates finish.
 			}, nil
@@ -1266,6 +1340,7 @@ func (sg *stepGenerator) GenerateDeletes(targetsOpt UrnTargets) ([]Step, error)
 	deletingUnspecifiedTarget := false
 	for _, step := range dels {
 		urn := step.URN()
+		markTargeted(step, targetsOpt)
 		if !targetsOpt.Contains(urn) && !sg.opts.TargetDependents {
 			d := diag.GetResourceWillBeDestroyedButWasNotSpecifiedInTargetList(urn)
 
@@ -1409,6 +1484,10 @@ func (sg *stepGenerator) determineAllowedResourcesToDeleteFromTargets(
 // The resulting list of antichains is a list of list of steps that can be safely executed in parallel. Since we must
 // process deletes in reverse (so we don't delete resources upon which other resources depend), we reverse the list and
 // hand it back to the deployment executor for safe execution.
+//
+// Resources that are deleted with another condemned resource are the exception: they are scheduled after the resource
+// they are deleted with, so that they can tell whether that deletion succeeded and fall back to deleting themselves
+// if it did not.
 func (sg *stepGenerator) ScheduleDeletes(deleteSteps []Step) []antichain {
 	var antichains []antichain                // the list of parallelizable steps we intend to return.
 	dg := sg.deployment.depGraph              // the current deployment's dependency graph.
@@ -1418,7 +1497,7 @@ func (sg *stepGenerator) ScheduleDeletes(deleteSteps []Step) []antichain {
 	// If we don't trust the dependency graph we've been given, we must be conservative and delete everything serially.
 	if !sg.opts.TrustDependencies {
 		logging.V(7).Infof("Planner does not trust dependency graph, scheduling deletions serially")
-		for _, step := range deleteSteps {
+		for _, step := range sg.orderDeletedWith(deleteSteps) {
 			antichains = append(antichains, antichain{step})
 		}
 
@@ -1434,13 +1513,26 @@ func (sg *stepGenerator) ScheduleDeletes(deleteSteps []Step) []antichain {
 		stepMap[step.Res()] = step
 	}
 
+	// Resources that are deleted with a condemned resource must be deleted after it. Since the antichains are built
+	// backwards, each owner gains an outgoing edge to the resources deleted with it, and those resources lose their
+	// outgoing edges to their owners.
+	owners := sg.deletedWithOwners(deleteSteps)
+	dependents := make(map[*resource.State]graph.ResourceSet)
+	for res, resOwners := range owners {
+		for owner := range resOwners {
+			if dependents[owner] == nil {
+				dependents[owner] = make(graph.ResourceSet)
+			}
+			dependents[owner][res] = true
+		}
+	}
+
 	for len(condemned) > 0 {
 		var steps antichain
 		logging.V(7).Infof("Planner beginning schedule of new deletion antichain")
 		for res := range condemned {
 			// Does res have any outgoing edges to resources that haven't already been removed from the graph?
-			condemnedDependencies := dg.DependenciesOf(res).Intersect(condemned)
-			if len(condemnedDependencies) == 0 {
+			if len(sg.condemnedEdges(res, condemned, owners, dependents)) == 0 {
 				// If not, it's safe to delete res at this stage.
 				logging.V(7).Infof("Planner scheduling deletion of '%v'", res.URN)
 				steps = append(steps, stepMap[res])
@@ -1450,6 +1542,17 @@ func (sg *stepGenerator) ScheduleDeletes(deleteSteps []Step) []antichain {
 			// it can't be deleted this round.
 		}
 
+		// If the DeletedWith edges introduced a cycle, fall back to the plain dependency graph for this round, which
+		// is always acyclic.
+		if len(steps) == 0 {
+			for res := range condemned {
+				if len(dg.DependenciesOf(res).Intersect(condemned)) == 0 {
+					logging.V(7).Infof("Planner scheduling deletion of '%v' ignoring DeletedWith", res.URN)
+					steps = append(steps, stepMap[res])
+				}
+			}
+		}
+
 		// For all reosurces that are to be deleted in this round, remove them from the graph.
 		for _, step := range steps {
 			delete(condemned, step.Res())
@@ -1472,6 +1575,101 @@ func (sg *stepGenerator) ScheduleDeletes(deleteSteps []Step) []antichain {
 	return antichains
 }
 
+// condemnedEdges returns the set of condemned resources that must be removed from the graph before res when
+// scheduling deletes: the condemned dependencies of res other than the resources it is deleted with, plus the
+// condemned resources that are deleted with res.
+func (sg *stepGenerator) condemnedEdges(res *resource.State, condemned graph.ResourceSet,
+	owners, dependents map[*resource.State]graph.ResourceSet,
+) graph.ResourceSet {
+	edges := make(graph.ResourceSet)
+	for dep := range sg.deployment.depGraph.DependenciesOf(res).Intersect(condemned) {
+		if !owners[res][dep] {
+			edges[dep] = true
+		}
+	}
+	for dependent := range dependents[res] {
+		if condemned[dependent] {
+			edges[dependent] = true
+		}
+	}
+	return edges
+}
+
+// deletedWithOwners returns, for each resource deleted by deleteSteps, the other resources deleted by deleteSteps
+// that it is deleted with, following DeletedWith relationships transitively through the deployment's old resources.
+func (sg *stepGenerator) deletedWithOwners(deleteSteps []Step) map[*resource.State]graph.ResourceSet {
+	condemned := make(map[resource.URN][]*resource.State)
+	for _, step := range deleteSteps {
+		condemned[step.URN()] = append(condemned[step.URN()], step.Res())
+	}
+
+	owners := make(map[*resource.State]graph.ResourceSet)
+	for _, step := range deleteSteps {
+		res := step.Res()
+		visited := map[resource.URN]bool{res.URN: true}
+		for with := res.DeletedWith; with != "" && !visited[with]; {
+			visited[with] = true
+			for _, owner := range condemned[with] {
+				if owners[res] == nil {
+					owners[res] = make(graph.ResourceSet)
+				}
+				owners[res][owner] = true
+			}
+
+			old, ok := sg.deployment.oldResource(with)
+			if !ok {
+				break
+			}
+			with = old.DeletedWith
+		}
+	}
+	return owners
+}
+
+// orderDeletedWith returns deleteSteps reordered so that each step runs after the steps that delete the resources it
+// is deleted with. The order of the steps is otherwise preserved. Steps whose owners cannot all be scheduled first,
+// because their DeletedWith relationships form a cycle, are left at the end in their original order.
+func (sg *stepGenerator) orderDeletedWith(deleteSteps []Step) []Step {
+	owners := sg.deletedWithOwners(deleteSteps)
+	scheduled := make(graph.ResourceSet)
+	ready := func(step Step) bool {
+		for owner := range owners[step.Res()] {
+			if !scheduled[owner] {
+				return false
+			}
+		}
+		return true
+	}
+
+	ordered := make([]Step, 0, len(deleteSteps))
+	var deferred []Step
+	for _, step := range deleteSteps {
+		if !ready(step) {
+			deferred = append(deferred, step)
+			continue
+		}
+		ordered = append(ordered, step)
+		scheduled[step.Res()] = true
+
+		// Scheduling this step may have unblocked steps that were waiting on it.
+		for progress := true; progress; {
+			progress = false
+			remaining := deferred[:0]
+			for _, waiting := range deferred {
+				if ready(waiting) {
+					ordered = append(ordered, waiting)
+					scheduled[waiting.Res()] = true
+					progress = true
+				} else {
+					remaining = append(remaining, waiting)
+				}
+			}
+			deferred = remaining
+		}
+	}
+	return append(ordered, deferred...)
+}
+
 // providerChanged diffs the Provider field of old and new resources, returning true if the rest of the step generator
 // should consider there to be a diff between these two resources.
 func (sg *stepGenerator) providerChanged(urn resource.URN, old, new *resource.State) (bool, error) {
@@ -1822,9 +2020,38 @@ func applyReplaceOnChanges(diff plugin.DiffResult,
 		Changes:             modifiedChanges,
 		DeleteBeforeReplace: diff.DeleteBeforeReplace,
 		StableKeys:          diff.StableKeys,
+		ReplaceReasons:      diff.ReplaceReasons,
 	}, nil
 }
 
+// applyAlwaysReplace turns any changes in the given diff into replacements. Initialization errors, which would
+// otherwise be retried with an update, also trigger a replacement.
+func applyAlwaysReplace(diff plugin.DiffResult, hasInitErrors bool) plugin.DiffResult {
+	if diff.Changes == plugin.DiffNone && hasInitErrors {
+		diff.Changes = plugin.DiffSome
+		diff.ReplaceKeys = []resource.PropertyKey{initErrorSpecialKey}
+		return diff
+	}
+	if diff.Changes != plugin.DiffSome || diff.Replace() {
+		return diff
+	}
+
+	if diff.DetailedDiff != nil {
+		detailedDiff := make(map[string]plugin.PropertyDiff, len(diff.DetailedDiff))
+		for p, v := range diff.DetailedDiff {
+			detailedDiff[p] = v.ToReplace()
+		}
+		diff.DetailedDiff = detailedDiff
+	}
+
+	// If the provider did not report which keys changed, blame the ID for the replacement.
+	diff.ReplaceKeys = diff.ChangedKeys
+	if len(diff.ReplaceKeys) == 0 {
+		diff.ReplaceKeys = []resource.PropertyKey{"id"}
+	}
+	return diff
+}
+
 type dependentReplace struct {
 	res  *resource.State
 	keys []resource.PropertyKey
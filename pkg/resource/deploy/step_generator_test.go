@@ -239,6 +239,78 @@ func TestApplyReplaceOnChangesEmptyDetailedDiff(t *testing.T) {
 	}
 }
 
+func TestApplyAlwaysReplace(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		diff          plugin.DiffResult
+		hasInitErrors bool
+		expected      plugin.DiffResult
+	}{
+		{
+			name:     "Empty diff",
+			diff:     plugin.DiffResult{Changes: plugin.DiffNone},
+			expected: plugin.DiffResult{Changes: plugin.DiffNone},
+		},
+		{
+			name: "DiffSome",
+			diff: plugin.DiffResult{
+				Changes:      plugin.DiffSome,
+				ChangedKeys:  []resource.PropertyKey{"a"},
+				DetailedDiff: map[string]plugin.PropertyDiff{"a": {Kind: plugin.DiffUpdate, InputDiff: true}},
+			},
+			expected: plugin.DiffResult{
+				Changes:      plugin.DiffSome,
+				ChangedKeys:  []resource.PropertyKey{"a"},
+				ReplaceKeys:  []resource.PropertyKey{"a"},
+				DetailedDiff: map[string]plugin.PropertyDiff{"a": {Kind: plugin.DiffUpdateReplace, InputDiff: true}},
+			},
+		},
+		{
+			name: "DiffSome without changed keys",
+			diff: plugin.DiffResult{Changes: plugin.DiffSome},
+			expected: plugin.DiffResult{
+				Changes:     plugin.DiffSome,
+				ReplaceKeys: []resource.PropertyKey{"id"},
+			},
+		},
+		{
+			name: "Already a replacement",
+			diff: plugin.DiffResult{
+				Changes:     plugin.DiffSome,
+				ChangedKeys: []resource.PropertyKey{"a", "b"},
+				ReplaceKeys: []resource.PropertyKey{"b"},
+			},
+			expected: plugin.DiffResult{
+				Changes:     plugin.DiffSome,
+				ChangedKeys: []resource.PropertyKey{"a", "b"},
+				ReplaceKeys: []resource.PropertyKey{"b"},
+			},
+		},
+		{
+			name:          "Empty diff w/ init errors",
+			diff:          plugin.DiffResult{Changes: plugin.DiffNone},
+			hasInitErrors: true,
+			expected: plugin.DiffResult{
+				Changes:     plugin.DiffSome,
+				ReplaceKeys: []resource.PropertyKey{"#initerror"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			newdiff := applyAlwaysReplace(c.diff, c.hasInitErrors)
+			assert.Equal(t, c.expected, newdiff)
+			assert.Equal(t, c.expected.Changes == plugin.DiffSome, newdiff.Replace())
+		})
+	}
+}
+
 func TestEngineDiff(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,123 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"crypto/sha256"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+)
+
+// ImportDiffCache memoizes the diffs computed by import steps, so that an import that is previewed and then applied
+// with the same inputs is only diffed by its provider once. Diffs are keyed by provider reference, URN, ID, the
+// resource's actual inputs and outputs as read from the provider, the inputs to import with, and the properties whose
+// changes are ignored. If the state read from the provider changes, so does the key, so a stale diff is never reused.
+// To share diffs between a preview and the update that follows it, give both deployments the same cache. It is safe
+// for concurrent use.
+type ImportDiffCache struct {
+	m       sync.Mutex
+	results map[string]plugin.DiffResult
+}
+
+// NewImportDiffCache creates a new, empty import diff cache.
+func NewImportDiffCache() *ImportDiffCache {
+	return &ImportDiffCache{results: make(map[string]plugin.DiffResult)}
+}
+
+// importDiffCacheKey returns the key of a diff of the given import.
+func importDiffCacheKey(s *ImportStep) string {
+	ignoreChanges := append([]string(nil), s.ignoreChanges...)
+	sort.Strings(ignoreChanges)
+
+	f := &stepFingerprint{h: sha256.New()}
+	f.field("provider", s.Provider())
+	f.field("urn", string(s.URN()))
+	f.field("id", string(s.new.ID))
+	f.properties("oldInputs", s.old.Inputs)
+	f.properties("oldOutputs", s.old.Outputs)
+	f.properties("newInputs", s.new.Inputs)
+	f.field("ignoreChanges", strings.Join(ignoreChanges, "\n"))
+	return f.sum()
+}
+
+// get returns the cached result of the given diff, if any.
+func (c *ImportDiffCache) get(key string) (plugin.DiffResult, bool) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	result, ok := c.results[key]
+	if !ok {
+		return plugin.DiffResult{}, false
+	}
+	return copyDiffResult(result), true
+}
+
+// put records the result of the given diff.
+func (c *ImportDiffCache) put(key string, result plugin.DiffResult) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.results[key] = copyDiffResult(result)
+}
+
+// copyDiffResult copies the keys and detailed diff of the given result so that a cached result is not affected by
+// changes that the steps that share it make to them.
+func copyDiffResult(result plugin.DiffResult) plugin.DiffResult {
+	copyKeys := func(keys []resource.PropertyKey) []resource.PropertyKey {
+		if keys == nil {
+			return nil
+		}
+		return append([]resource.PropertyKey{}, keys...)
+	}
+	result.ReplaceKeys = copyKeys(result.ReplaceKeys)
+	result.StableKeys = copyKeys(result.StableKeys)
+	result.ChangedKeys = copyKeys(result.ChangedKeys)
+	if result.DetailedDiff != nil {
+		detailedDiff := make(map[string]plugin.PropertyDiff, len(result.DetailedDiff))
+		for k, v := range result.DetailedDiff {
+			detailedDiff[k] = v
+		}
+		result.DetailedDiff = detailedDiff
+	}
+	return result
+}
+
+// diff diffs the inputs to import against the resource's actual state, consulting the deployment's import diff cache,
+// if any. Only diffs that succeed are cached. Diffs of inputs that contain unknowns are never cached.
+func (s *ImportStep) diff(prov plugin.Provider, preview bool) (plugin.DiffResult, error) {
+	var cache *ImportDiffCache
+	if s.deployment != nil && !s.new.Inputs.ContainsUnknowns() {
+		cache = s.deployment.ImportDiffCache
+	}
+	if cache == nil {
+		return diffResource(s.new.URN, s.new.ID, s.old.Inputs, s.old.Outputs, s.new.Inputs, prov, preview,
+			s.ignoreChanges)
+	}
+
+	key := importDiffCacheKey(s)
+	if result, ok := cache.get(key); ok {
+		return result, nil
+	}
+	result, err := diffResource(s.new.URN, s.new.ID, s.old.Inputs, s.old.Outputs, s.new.Inputs, prov, preview,
+		s.ignoreChanges)
+	if err == nil {
+		cache.put(key, result)
+	}
+	return result, err
+}
@@ -0,0 +1,113 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+func TestImportDiffCache(t *testing.T) {
+	t.Parallel()
+
+	// newDeployment returns a deployment that uses the given cache and whose provider counts its diffs. The provider
+	// reads the resource's actual state from the given map.
+	newDeployment := func(cache *ImportDiffCache, actual *resource.PropertyMap, diffs *int32) *Deployment {
+		deployment := NewTestDeployment(map[tokens.Package]plugin.Provider{
+			"pkgA": &deploytest.Provider{
+				ReadF: func(urn resource.URN, id resource.ID,
+					inputs, state resource.PropertyMap,
+				) (plugin.ReadResult, resource.Status, error) {
+					return plugin.ReadResult{Inputs: actual.Copy(), Outputs: actual.Copy()}, resource.StatusOK, nil
+				},
+				DiffF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap,
+					ignoreChanges []string,
+				) (plugin.DiffResult, error) {
+					atomic.AddInt32(diffs, 1)
+					if !oldInputs.DeepEquals(newInputs) {
+						return plugin.DiffResult{Changes: plugin.DiffSome, ChangedKeys: []resource.PropertyKey{"foo"}}, nil
+					}
+					return plugin.DiffResult{Changes: plugin.DiffNone}, nil
+				},
+			},
+		}, nil)
+		deployment.ImportDiffCache = cache
+		return deployment
+	}
+
+	importResource := func(deployment *Deployment, preview bool) (*ImportStep, error) {
+		res := newTestDeploymentResource("res", TestProviderRef("pkgA"))
+		res.ID = "import-id"
+		step := NewImportStep(deployment, doneEvent{}, res, nil, []byte{}).(*ImportStep)
+		_, _, err := step.Apply(preview)
+		return step, err
+	}
+
+	t.Run("preview then update", func(t *testing.T) {
+		t.Parallel()
+
+		var diffs int32
+		actual := resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+		cache := NewImportDiffCache()
+
+		_, err := importResource(newDeployment(cache, &actual, &diffs), true)
+		require.NoError(t, err)
+		_, err = importResource(newDeployment(cache, &actual, &diffs), false)
+		require.NoError(t, err)
+
+		// The update's diff is served from the cache.
+		assert.Equal(t, int32(1), atomic.LoadInt32(&diffs))
+	})
+
+	t.Run("state changed", func(t *testing.T) {
+		t.Parallel()
+
+		var diffs int32
+		actual := resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+		cache := NewImportDiffCache()
+
+		_, err := importResource(newDeployment(cache, &actual, &diffs), true)
+		require.NoError(t, err)
+
+		// The resource changed behind our back, so the cached diff must not be reused.
+		actual = resource.PropertyMap{"foo": resource.NewStringProperty("baz")}
+		step, err := importResource(newDeployment(cache, &actual, &diffs), false)
+		assert.ErrorContains(t, err, "inputs to import do not match the existing resource")
+		assert.Equal(t, []resource.PropertyKey{"foo"}, step.Diffs())
+		assert.Equal(t, int32(2), atomic.LoadInt32(&diffs))
+	})
+
+	t.Run("no cache", func(t *testing.T) {
+		t.Parallel()
+
+		var diffs int32
+		actual := resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+
+		_, err := importResource(newDeployment(nil, &actual, &diffs), true)
+		require.NoError(t, err)
+		_, err = importResource(newDeployment(nil, &actual, &diffs), false)
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(2), atomic.LoadInt32(&diffs))
+	})
+}
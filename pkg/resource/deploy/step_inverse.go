@@ -0,0 +1,129 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi/pkg/v3/display"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// InvertibleStep is a step that can compute the step that would undo it. Inverse steps can be used to build rollback
+// plans for steps that have been applied.
+type InvertibleStep interface {
+	Step
+
+	// Inverse returns a step that undoes this step once it has been applied. Inverse steps are not registered with
+	// the deployment's resource monitor; completing them has no effect on the program. If the step cannot be undone,
+	// Inverse returns a NotInvertibleError.
+	Inverse() (Step, error)
+}
+
+var (
+	_ InvertibleStep = (*CreateStep)(nil)
+	_ InvertibleStep = (*DeleteStep)(nil)
+	_ InvertibleStep = (*UpdateStep)(nil)
+	_ InvertibleStep = (*ReplaceStep)(nil)
+	_ InvertibleStep = (*ReadStep)(nil)
+)
+
+// NotInvertibleError is returned by InvertibleStep.Inverse when a step cannot be undone.
+type NotInvertibleError struct {
+	URN    resource.URN   // the URN of the step's resource
+	Op     display.StepOp // the operation of the step
+	Reason string         // why the step cannot be undone
+}
+
+func (e *NotInvertibleError) Error() string {
+	return fmt.Sprintf("%s of '%s' cannot be inverted: %s", e.Op, e.URN, e.Reason)
+}
+
+// Inverse returns the step that undoes the given step, which must have been applied. Steps that do not implement
+// InvertibleStep cannot be undone.
+func Inverse(step Step) (Step, error) {
+	invertible, ok := step.(InvertibleStep)
+	if !ok {
+		return nil, notInvertible(step, "the step does not support inversion")
+	}
+	return invertible.Inverse()
+}
+
+// inverseEvent is the registration event of an inverse step. There is no program waiting on the registration of an
+// inverse step, so its completion is ignored.
+type inverseEvent struct{}
+
+var _ RegisterResourceEvent = inverseEvent{}
+
+func (inverseEvent) event()                      {}
+func (inverseEvent) Goal() *resource.Goal        { return nil }
+func (inverseEvent) Done(result *RegisterResult) {}
+
+func notInvertible(step Step, reason string) error {
+	return &NotInvertibleError{URN: step.URN(), Op: step.Op(), Reason: reason}
+}
+
+// Inverse returns a step that deletes the created resource. Replacements cannot be inverted.
+func (s *CreateStep) Inverse() (Step, error) {
+	if s.replacing {
+		return nil, notInvertible(s, "replacements cannot be undone")
+	}
+	if s.new.Custom && s.new.ID == "" {
+		return nil, notInvertible(s, "the resource has not been created")
+	}
+
+	created := *s.new
+	return NewDeleteStep(s.deployment, map[resource.URN]bool{}, &created), nil
+}
+
+// Inverse returns a step that creates the deleted resource from its old inputs. The recreated resource receives a new
+// ID from its provider. Deletions that are part of a replacement, and deletions of resources that Pulumi does not own,
+// cannot be inverted.
+func (s *DeleteStep) Inverse() (Step, error) {
+	switch {
+	case s.replacing:
+		return nil, notInvertible(s, "replacements cannot be undone")
+	case s.old.External:
+		return nil, notInvertible(s, "the resource is external")
+	}
+
+	deleted := *s.old
+	deleted.ID = ""
+	deleted.Outputs = nil
+	deleted.Delete = false
+	deleted.PendingReplacement = false
+	return NewCreateStep(s.deployment, inverseEvent{}, &deleted), nil
+}
+
+// Inverse returns a step that updates the resource from its new state back to its old inputs.
+func (s *UpdateStep) Inverse() (Step, error) {
+	if s.new.Custom && s.new.ID == "" {
+		return nil, notInvertible(s, "the resource has not been updated")
+	}
+
+	updated, reverted := *s.new, *s.old
+	reverted.ID = ""
+	return NewUpdateStep(s.deployment, inverseEvent{}, &updated, &reverted, nil, s.diffs, nil, nil), nil
+}
+
+// Inverse always fails, as replacements cannot be undone.
+func (s *ReplaceStep) Inverse() (Step, error) {
+	return nil, notInvertible(s, "replacements cannot be undone")
+}
+
+// Inverse always fails, as reads do not change the resource.
+func (s *ReadStep) Inverse() (Step, error) {
+	return nil, notInvertible(s, "reads do not change the resource")
+}
@@ -0,0 +1,118 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/display"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestStepInverse(t *testing.T) {
+	t.Parallel()
+
+	ref, err := providers.NewReference("urn:pulumi:teststack::pkg::pulumi:providers:pkgA::default", "provider-id")
+	require.NoError(t, err)
+
+	newResource := func(id resource.ID) *resource.State {
+		res := newStepTestResource("res", ref)
+		res.ID = id
+		res.Inputs = resource.PropertyMap{"foo": resource.NewStringProperty(string(id))}
+		return res
+	}
+
+	t.Run("create", func(t *testing.T) {
+		t.Parallel()
+
+		step := NewCreateStep(nil, doneEvent{}, newResource("")).(InvertibleStep)
+		step.New().ID = "created-id"
+
+		inverse, err := Inverse(step)
+		require.NoError(t, err)
+		assert.Equal(t, OpDelete, inverse.Op())
+		assert.Equal(t, newResource("").URN, inverse.URN())
+		assert.Equal(t, resource.ID("created-id"), inverse.Old().ID)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		t.Parallel()
+
+		old := newResource("old-id")
+		inverse, err := NewDeleteStep(nil, map[resource.URN]bool{}, old).(InvertibleStep).Inverse()
+		require.NoError(t, err)
+		assert.Equal(t, OpCreate, inverse.Op())
+		assert.Equal(t, old.URN, inverse.URN())
+		assert.Equal(t, resource.ID(""), inverse.New().ID)
+		assert.Equal(t, old.Inputs, inverse.New().Inputs)
+		assert.Equal(t, resource.ID("old-id"), old.ID)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		t.Parallel()
+
+		old, new := newResource("id"), newResource("")
+		new.Inputs = resource.PropertyMap{"foo": resource.NewStringProperty("updated")}
+		step := NewUpdateStep(nil, doneEvent{}, old, new, nil, []resource.PropertyKey{"foo"}, nil, nil).(*UpdateStep)
+		new.ID = old.ID
+
+		inverse, err := step.Inverse()
+		require.NoError(t, err)
+		assert.Equal(t, OpUpdate, inverse.Op())
+		assert.Equal(t, old.URN, inverse.URN())
+		assert.Equal(t, new.Inputs, inverse.Old().Inputs)
+		assert.Equal(t, old.Inputs, inverse.New().Inputs)
+		assert.Equal(t, []resource.PropertyKey{"foo"}, inverse.(*UpdateStep).Diffs())
+	})
+
+	notInvertible := func(t *testing.T, step InvertibleStep, op display.StepOp) {
+		inverse, err := step.Inverse()
+		assert.Nil(t, inverse)
+		var notInvertible *NotInvertibleError
+		require.True(t, errors.As(err, &notInvertible))
+		assert.Equal(t, step.URN(), notInvertible.URN)
+		assert.Equal(t, op, notInvertible.Op)
+	}
+
+	t.Run("not invertible", func(t *testing.T) {
+		t.Parallel()
+
+		old, new := newResource("id"), newResource("")
+		notInvertible(t, NewReplaceStep(nil, old, new, nil, nil, nil, true).(InvertibleStep), OpReplace)
+		notInvertible(t,
+			NewCreateReplacementStep(nil, doneEvent{}, old, newResource(""), nil, nil, nil, true).(InvertibleStep),
+			OpCreateReplacement)
+		notInvertible(t,
+			NewDeleteReplacementStep(nil, map[resource.URN]bool{}, newResource("id"), true).(InvertibleStep),
+			OpDeleteReplaced)
+
+		read := newResource("read-id")
+		read.External = true
+		notInvertible(t, NewReadStep(nil, nil, nil, read).(InvertibleStep), OpRead)
+
+		_, err := Inverse(NewSameStep(nil, doneEvent{}, newResource("id"), newResource("")))
+		var sameErr *NotInvertibleError
+		require.True(t, errors.As(err, &sameErr))
+		assert.Equal(t, OpSame, sameErr.Op)
+
+		// A create that has not been applied has no resource to delete.
+		notInvertible(t, NewCreateStep(nil, doneEvent{}, newResource("")).(InvertibleStep), OpCreate)
+	})
+}
@@ -0,0 +1,110 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+)
+
+// ProgressFunc receives intermediate progress reports from a long-running provider operation. The fraction is the
+// portion of the operation that has completed, between 0 and 1.
+type ProgressFunc func(message string, fraction float64)
+
+// ProgressProvider is implemented by providers that can report intermediate progress while they create, update, or
+// delete a resource. Each method behaves like its plugin.Provider counterpart, additionally calling progress as the
+// operation advances.
+//
+// Progress is not part of the provider protocol, so only providers that run in the engine's process, such as the
+// test providers in deploytest, can implement this interface. Provider plugins never report progress.
+type ProgressProvider interface {
+	CreateWithProgress(urn resource.URN, news resource.PropertyMap, timeout float64, preview bool,
+		progress ProgressFunc) (resource.ID, resource.PropertyMap, resource.Status, error)
+	UpdateWithProgress(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap,
+		timeout float64, ignoreChanges []string, preview bool,
+		progress ProgressFunc) (plugin.UpdateResult, resource.Status, error)
+	DeleteWithProgress(urn resource.URN, id resource.ID, oldInputs, oldOutputs resource.PropertyMap, timeout float64,
+		progress ProgressFunc) (resource.Status, error)
+}
+
+// progressFunc returns a function that forwards progress for the given resource to the deployment's StepProgress
+// callback, or nil if the deployment has no callback.
+func (d *Deployment) progressFunc(urn resource.URN) ProgressFunc {
+	if d.StepProgress == nil {
+		return nil
+	}
+	return func(message string, fraction float64) { d.StepProgress(urn, message, fraction) }
+}
+
+// progressProvider returns the given provider as a ProgressProvider along with a function that forwards its progress
+// for the given resource to the deployment's StepProgress callback. It returns false if the deployment has no
+// callback or the provider does not report progress.
+func (d *Deployment) progressProvider(prov plugin.Provider, urn resource.URN) (ProgressProvider, ProgressFunc, bool) {
+	progress := d.progressFunc(urn)
+	if progress == nil {
+		return nil, nil, false
+	}
+	pp, ok := prov.(ProgressProvider)
+	if !ok {
+		return nil, nil, false
+	}
+	return pp, progress, true
+}
+
+// createResource invokes the provider's Create method, streaming progress if possible and retrying transient
+// failures according to the deployment's retry policy.
+func (d *Deployment) createResource(prov plugin.Provider, urn resource.URN, news resource.PropertyMap,
+	timeout float64, preview bool,
+) (id resource.ID, outs resource.PropertyMap, rst resource.Status, err error) {
+	rst, err = d.retry(urn, timeout, func(timeout float64) (resource.Status, error) {
+		if pp, progress, ok := d.progressProvider(prov, urn); ok {
+			id, outs, rst, err = pp.CreateWithProgress(urn, news, timeout, preview, progress)
+		} else {
+			id, outs, rst, err = prov.Create(urn, news, timeout, preview)
+		}
+		return rst, err
+	})
+	return id, outs, rst, err
+}
+
+// updateResource invokes the provider's Update method, streaming progress if possible and retrying transient
+// failures according to the deployment's retry policy.
+func (d *Deployment) updateResource(prov plugin.Provider, urn resource.URN, id resource.ID,
+	oldInputs, oldOutputs, newInputs resource.PropertyMap, timeout float64, ignoreChanges []string, preview bool,
+) (result plugin.UpdateResult, rst resource.Status, err error) {
+	rst, err = d.retry(urn, timeout, func(timeout float64) (resource.Status, error) {
+		if pp, progress, ok := d.progressProvider(prov, urn); ok {
+			result, rst, err = pp.UpdateWithProgress(urn, id, oldInputs, oldOutputs, newInputs, timeout, ignoreChanges,
+				preview, progress)
+		} else {
+			result, rst, err = prov.Update(urn, id, oldInputs, oldOutputs, newInputs, timeout, ignoreChanges, preview)
+		}
+		return rst, err
+	})
+	return result, rst, err
+}
+
+// deleteResource invokes the provider's Delete method, streaming progress if possible and retrying transient
+// failures according to the deployment's retry policy.
+func (d *Deployment) deleteResource(prov plugin.Provider, urn resource.URN, id resource.ID,
+	oldInputs, oldOutputs resource.PropertyMap, timeout float64,
+) (resource.Status, error) {
+	return d.retry(urn, timeout, func(timeout float64) (resource.Status, error) {
+		if pp, progress, ok := d.progressProvider(prov, urn); ok {
+			return pp.DeleteWithProgress(urn, id, oldInputs, oldOutputs, timeout, progress)
+		}
+		return prov.Delete(urn, id, oldInputs, oldOutputs, timeout)
+	})
+}
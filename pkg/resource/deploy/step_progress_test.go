@@ -0,0 +1,210 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+)
+
+// progressProvider is a test provider that reports two progress events before completing each operation.
+type progressProvider struct {
+	*deploytest.Provider
+}
+
+var _ ProgressProvider = (*progressProvider)(nil)
+
+func (p *progressProvider) CreateWithProgress(urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool, progress ProgressFunc,
+) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	progress("creating", 0.5)
+	progress("created", 1)
+	return "created-id", news, resource.StatusOK, nil
+}
+
+func (p *progressProvider) UpdateWithProgress(urn resource.URN, id resource.ID,
+	oldInputs, oldOutputs, newInputs resource.PropertyMap, timeout float64, ignoreChanges []string, preview bool,
+	progress ProgressFunc,
+) (plugin.UpdateResult, resource.Status, error) {
+	progress("updating", 0.5)
+	progress("updated", 1)
+	return plugin.UpdateResult{Outputs: newInputs}, resource.StatusOK, nil
+}
+
+func (p *progressProvider) DeleteWithProgress(urn resource.URN, id resource.ID,
+	oldInputs, oldOutputs resource.PropertyMap, timeout float64, progress ProgressFunc,
+) (resource.Status, error) {
+	progress("deleting", 0.5)
+	progress("deleted", 1)
+	return resource.StatusOK, nil
+}
+
+type progressEvent struct {
+	urn      resource.URN
+	message  string
+	fraction float64
+}
+
+func TestStepProgress(t *testing.T) {
+	t.Parallel()
+
+	deployment, ref := newStepTestDeployment(t, &progressProvider{Provider: &deploytest.Provider{}})
+
+	var lock sync.Mutex
+	var events []progressEvent
+	deployment.StepProgress = func(urn resource.URN, message string, fraction float64) {
+		lock.Lock()
+		defer lock.Unlock()
+		events = append(events, progressEvent{urn, message, fraction})
+	}
+
+	res := newStepTestResource("res", ref)
+	_, _, err := NewCreateStep(deployment, doneEvent{}, res).Apply(false)
+	require.NoError(t, err)
+
+	updated := newStepTestResource("res", ref)
+	_, _, err = NewUpdateStep(deployment, doneEvent{}, res, updated, nil, nil, nil, nil).Apply(false)
+	require.NoError(t, err)
+
+	_, _, err = NewDeleteStep(deployment, map[resource.URN]bool{}, updated).Apply(false)
+	require.NoError(t, err)
+
+	assert.Equal(t, []progressEvent{
+		{res.URN, "creating", 0.5},
+		{res.URN, "created", 1},
+		{res.URN, "updating", 0.5},
+		{res.URN, "updated", 1},
+		{res.URN, "deleting", 0.5},
+		{res.URN, "deleted", 1},
+	}, events)
+
+	t.Run("no callback", func(t *testing.T) {
+		t.Parallel()
+
+		// Without a callback, the provider's plain methods are used.
+		created := false
+		deployment, ref := newStepTestDeployment(t, &progressProvider{Provider: &deploytest.Provider{
+			CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+				preview bool,
+			) (resource.ID, resource.PropertyMap, resource.Status, error) {
+				created = true
+				return "plain-id", news, resource.StatusOK, nil
+			},
+		}})
+
+		res := newStepTestResource("res", ref)
+		_, _, err := NewCreateStep(deployment, doneEvent{}, res).Apply(false)
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, resource.ID("plain-id"), res.ID)
+	})
+}
+
+// reissuingProvider is a test provider whose updates return the given ID. It also reports progress for all of its
+// operations.
+type reissuingProvider struct {
+	*progressProvider
+
+	id resource.ID
+}
+
+func (p *reissuingProvider) Update(urn resource.URN, id resource.ID,
+	oldInputs, oldOutputs, newInputs resource.PropertyMap, timeout float64, ignoreChanges []string, preview bool,
+) (plugin.UpdateResult, resource.Status, error) {
+	return plugin.UpdateResult{ID: p.id, Outputs: newInputs}, resource.StatusOK, nil
+}
+
+func (p *reissuingProvider) UpdateWithProgress(urn resource.URN, id resource.ID,
+	oldInputs, oldOutputs, newInputs resource.PropertyMap, timeout float64, ignoreChanges []string, preview bool,
+	progress ProgressFunc,
+) (plugin.UpdateResult, resource.Status, error) {
+	progress("reissuing", 1)
+	return p.Update(urn, id, oldInputs, oldOutputs, newInputs, timeout, ignoreChanges, preview)
+}
+
+func TestUpdateStepReissuedID(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		id       resource.ID
+		preview  bool
+		expected resource.ID
+		warned   bool
+	}{
+		{name: "changed", id: "new-id", expected: "new-id", warned: true},
+		{name: "unchanged", id: "old-id", expected: "old-id"},
+		{name: "unreported", id: "", expected: "old-id"},
+		{name: "preview", id: "new-id", preview: true, expected: "old-id"},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			deployment, ref := newStepTestDeployment(t, &reissuingProvider{
+				progressProvider: &progressProvider{Provider: &deploytest.Provider{}},
+				id:               c.id,
+			})
+			var output bytes.Buffer
+			deployment.ctx.Diag = diag.DefaultSink(&output, &output, diag.FormatOptions{Color: colors.Never})
+
+			old := newStepTestResource("res", ref)
+			old.ID = "old-id"
+			new := newStepTestResource("res", ref)
+			_, _, err := NewUpdateStep(deployment, doneEvent{}, old, new, nil, nil, nil, nil).Apply(c.preview)
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, new.ID)
+			if c.warned {
+				assert.Contains(t, output.String(),
+					"provider recreated the resource during update; its ID changed from 'old-id' to 'new-id'")
+			} else {
+				assert.Empty(t, output.String())
+			}
+		})
+	}
+}
+
+func TestUpdateStepReissuedIDProgress(t *testing.T) {
+	t.Parallel()
+
+	// A provider that both reports progress and reissues IDs does both during the same update.
+	deployment, ref := newStepTestDeployment(t, &reissuingProvider{
+		progressProvider: &progressProvider{Provider: &deploytest.Provider{}},
+		id:               "new-id",
+	})
+	var events []progressEvent
+	deployment.StepProgress = func(urn resource.URN, message string, fraction float64) {
+		events = append(events, progressEvent{urn, message, fraction})
+	}
+
+	old := newStepTestResource("res", ref)
+	old.ID = "old-id"
+	new := newStepTestResource("res", ref)
+	_, _, err := NewUpdateStep(deployment, doneEvent{}, old, new, nil, nil, nil, nil).Apply(false)
+	require.NoError(t, err)
+	assert.Equal(t, resource.ID("new-id"), new.ID)
+	assert.Equal(t, []progressEvent{{old.URN, "reissuing", 1}}, events)
+}
@@ -0,0 +1,102 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"crypto/sha256"
+	"sync"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/deepcopy"
+)
+
+// ReadCache memoizes the results of provider reads for the duration of a deployment, so that an external resource
+// that is read by several URNs is only read from its provider once. Reads are keyed by provider reference, ID, and
+// inputs. It is safe for concurrent use.
+type ReadCache struct {
+	m       sync.Mutex
+	results map[string]plugin.ReadResult
+}
+
+// NewReadCache creates a new, empty read cache.
+func NewReadCache() *ReadCache {
+	return &ReadCache{results: make(map[string]plugin.ReadResult)}
+}
+
+// readCacheKey returns the key of a read of the given ID by the given provider with the given inputs.
+func readCacheKey(provider string, id resource.ID, inputs resource.PropertyMap) string {
+	f := &stepFingerprint{h: sha256.New()}
+	f.field("provider", provider)
+	f.field("id", string(id))
+	f.properties("inputs", inputs)
+	return f.sum()
+}
+
+// get returns the cached result of the given read, if any.
+func (c *ReadCache) get(key string) (plugin.ReadResult, bool) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	result, ok := c.results[key]
+	if !ok {
+		return plugin.ReadResult{}, false
+	}
+	return copyReadResult(result), true
+}
+
+// put records the result of the given read.
+func (c *ReadCache) put(key string, result plugin.ReadResult) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.results[key] = copyReadResult(result)
+}
+
+// copyReadResult deeply copies the property maps of the given result. Steps copy the results of their providers'
+// reads before storing them in their states, so that a provider that retains and later mutates the values it returned
+// cannot alter the state of the deployment. The cache likewise copies results so that a cached result is not affected
+// by changes that the steps that share it make to their states.
+func copyReadResult(result plugin.ReadResult) plugin.ReadResult {
+	result.Inputs = deepcopy.Copy(result.Inputs).(resource.PropertyMap)
+	result.Outputs = deepcopy.Copy(result.Outputs).(resource.PropertyMap)
+	return result
+}
+
+// read reads the given resource from the step's provider, consulting the deployment's read cache, if any. Only reads
+// that succeed and find the resource are cached. Reads of unknown IDs are never cached.
+func (s *ReadStep) read(
+	prov plugin.Provider, urn resource.URN, id resource.ID,
+) (plugin.ReadResult, resource.Status, error) {
+	var cache *ReadCache
+	if s.deployment != nil && id != plugin.UnknownStringValue {
+		cache = s.deployment.ReadCache
+	}
+	if cache == nil {
+		result, rst, err := prov.Read(urn, id, nil, s.new.Inputs)
+		return copyReadResult(result), rst, err
+	}
+
+	key := readCacheKey(s.Provider(), id, s.new.Inputs)
+	if result, ok := cache.get(key); ok {
+		return result, resource.StatusOK, nil
+	}
+	result, rst, err := prov.Read(urn, id, nil, s.new.Inputs)
+	result = copyReadResult(result)
+	if err == nil && result.Outputs != nil {
+		cache.put(key, result)
+	}
+	return result, rst, err
+}
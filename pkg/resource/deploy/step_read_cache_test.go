@@ -0,0 +1,157 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+func TestReadCache(t *testing.T) {
+	t.Parallel()
+
+	// newDeployment returns a deployment with a read cache whose provider counts its reads. Reads of "gone-id" do not
+	// find the resource.
+	newDeployment := func() (*Deployment, *int32) {
+		var reads int32
+		deployment := NewTestDeployment(map[tokens.Package]plugin.Provider{
+			"pkgA": &deploytest.Provider{
+				ReadF: func(urn resource.URN, id resource.ID,
+					inputs, state resource.PropertyMap,
+				) (plugin.ReadResult, resource.Status, error) {
+					atomic.AddInt32(&reads, 1)
+					if id == "gone-id" {
+						return plugin.ReadResult{}, resource.StatusOK, nil
+					}
+					return plugin.ReadResult{
+						ID:      id,
+						Outputs: resource.PropertyMap{"read": resource.NewStringProperty(string(id))},
+					}, resource.StatusOK, nil
+				},
+			},
+		}, nil)
+		deployment.ReadCache = NewReadCache()
+		return deployment, &reads
+	}
+
+	newReadState := func(name string, id resource.ID) *resource.State {
+		res := newTestDeploymentResource(name, TestProviderRef("pkgA"))
+		res.ID = id
+		res.External = true
+		return res
+	}
+
+	t.Run("hit", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, reads := newDeployment()
+
+		first := newReadState("first", "shared-id")
+		_, _, err := NewReadStep(deployment, nil, nil, first).Apply(false)
+		require.NoError(t, err)
+
+		second := newReadState("second", "shared-id")
+		_, _, err = NewReadStep(deployment, nil, nil, second).Apply(false)
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(reads))
+		assert.Equal(t, first.Outputs, second.Outputs)
+
+		// Changes to one state's outputs must not leak into the cache.
+		first.Outputs["read"] = resource.NewStringProperty("changed")
+		third := newReadState("third", "shared-id")
+		_, _, err = NewReadStep(deployment, nil, nil, third).Apply(false)
+		require.NoError(t, err)
+		assert.Equal(t, resource.NewStringProperty("shared-id"), third.Outputs["read"])
+	})
+
+	t.Run("miss", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, reads := newDeployment()
+
+		_, _, err := NewReadStep(deployment, nil, nil, newReadState("first", "id-1")).Apply(false)
+		require.NoError(t, err)
+		_, _, err = NewReadStep(deployment, nil, nil, newReadState("second", "id-2")).Apply(false)
+		require.NoError(t, err)
+
+		changed := newReadState("third", "id-1")
+		changed.Inputs = resource.PropertyMap{"foo": resource.NewStringProperty("baz")}
+		_, _, err = NewReadStep(deployment, nil, nil, changed).Apply(false)
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(3), atomic.LoadInt32(reads))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, reads := newDeployment()
+
+		for _, name := range []string{"first", "second"} {
+			_, _, err := NewReadStep(deployment, nil, nil, newReadState(name, "gone-id")).Apply(false)
+			var notFound *ResourceNotFoundError
+			assert.ErrorAs(t, err, &notFound)
+		}
+		assert.Equal(t, int32(2), atomic.LoadInt32(reads))
+	})
+
+	t.Run("unknown ID", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, reads := newDeployment()
+
+		res := newReadState("res", plugin.UnknownStringValue)
+		_, _, err := NewReadStep(deployment, nil, nil, res).Apply(true)
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), atomic.LoadInt32(reads))
+		assert.Empty(t, deployment.ReadCache.results)
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, reads := newDeployment()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				res := newReadState("res", "shared-id")
+				_, _, err := NewReadStep(deployment, nil, nil, res).Apply(false)
+				assert.NoError(t, err)
+				assert.Equal(t, resource.NewStringProperty("shared-id"), res.Outputs["read"])
+			}()
+		}
+		wg.Wait()
+
+		// Concurrent reads may race to populate the cache, but later reads must hit it.
+		before := atomic.LoadInt32(reads)
+		assert.LessOrEqual(t, before, int32(8))
+		_, _, err := NewReadStep(deployment, nil, nil, newReadState("res", "shared-id")).Apply(false)
+		require.NoError(t, err)
+		assert.Equal(t, before, atomic.LoadInt32(reads))
+	})
+}
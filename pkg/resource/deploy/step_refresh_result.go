@@ -0,0 +1,64 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"github.com/pulumi/pulumi/pkg/v3/display"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// RefreshResult describes what a refresh step found when it read its resource's current state.
+type RefreshResult struct {
+	// Op is the operation that corresponds to the change to the resource, as reported by RefreshStep.ResultOp.
+	Op display.StepOp
+	// OutputDiff is the difference between the resource's old and refreshed outputs. It is nil if the outputs are the
+	// same or the resource no longer exists.
+	OutputDiff *resource.ObjectDiff
+	// OldID is the resource's ID before the refresh, and NewID its ID after the refresh. NewID is empty if the resource
+	// no longer exists.
+	OldID, NewID resource.ID
+	// IDChanged is true if the provider reported a new ID for a resource that still exists.
+	IDChanged bool
+	// NewInitErrors lists the initialization errors that the provider reported for the resource and that it did not
+	// have before the refresh.
+	NewInitErrors []string
+}
+
+// RefreshResult returns what the step found when it was applied, or nil if it has not been applied or failed to read
+// the resource. Resources that are not refreshed, such as components, are reported as unchanged.
+func (s *RefreshStep) RefreshResult() *RefreshResult {
+	return s.result
+}
+
+// recordResult records the result of the step from its old and new states.
+func (s *RefreshStep) recordResult() {
+	result := &RefreshResult{Op: s.ResultOp(), OldID: s.old.ID}
+	if s.new != nil {
+		result.NewID = s.new.ID
+		result.IDChanged = s.new.ID != s.old.ID
+		result.OutputDiff = s.old.Outputs.Diff(s.new.Outputs)
+
+		existing := make(map[string]bool, len(s.old.InitErrors))
+		for _, reason := range s.old.InitErrors {
+			existing[reason] = true
+		}
+		for _, reason := range s.new.InitErrors {
+			if !existing[reason] {
+				result.NewInitErrors = append(result.NewInitErrors, reason)
+			}
+		}
+	}
+	s.result = result
+}
@@ -0,0 +1,104 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+)
+
+func TestRefreshResult(t *testing.T) {
+	t.Parallel()
+
+	oldOutputs := resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+	newOutputs := resource.PropertyMap{"foo": resource.NewStringProperty("baz")}
+
+	// Each resource is read back according to its name.
+	prov := &deploytest.Provider{
+		ReadF: func(urn resource.URN, id resource.ID,
+			inputs, state resource.PropertyMap,
+		) (plugin.ReadResult, resource.Status, error) {
+			switch urn.Name() {
+			case "id-change":
+				return plugin.ReadResult{ID: "new-id", Inputs: inputs, Outputs: oldOutputs}, resource.StatusOK, nil
+			case "output-change":
+				return plugin.ReadResult{ID: id, Inputs: inputs, Outputs: newOutputs}, resource.StatusOK, nil
+			case "unhealthy":
+				return plugin.ReadResult{ID: id, Inputs: inputs, Outputs: oldOutputs},
+					resource.StatusPartialFailure, &plugin.InitError{Reasons: []string{"still unhealthy", "now unhealthy"}}
+			default:
+				return plugin.ReadResult{}, resource.StatusOK, nil
+			}
+		},
+	}
+	deployment, ref := newStepTestDeployment(t, prov)
+
+	refresh := func(t *testing.T, name string, initErrors ...string) *RefreshResult {
+		res := newStepTestResource(name, ref)
+		res.ID = "old-id"
+		res.Outputs = oldOutputs
+		res.InitErrors = initErrors
+		step := NewRefreshStep(deployment, res, nil).(*RefreshStep)
+		assert.Nil(t, step.RefreshResult())
+
+		_, _, err := step.Apply(false)
+		require.NoError(t, err)
+		result := step.RefreshResult()
+		require.NotNil(t, result)
+		assert.Equal(t, step.ResultOp(), result.Op)
+		return result
+	}
+
+	t.Run("id change", func(t *testing.T) {
+		t.Parallel()
+
+		// The outputs are unchanged, so the refresh is a same despite the new ID.
+		assert.Equal(t, &RefreshResult{Op: OpSame, OldID: "old-id", NewID: "new-id", IDChanged: true},
+			refresh(t, "id-change"))
+	})
+
+	t.Run("output change", func(t *testing.T) {
+		t.Parallel()
+
+		result := refresh(t, "output-change")
+		assert.Equal(t, OpUpdate, result.Op)
+		assert.False(t, result.IDChanged)
+		assert.Equal(t, resource.ID("old-id"), result.NewID)
+		require.NotNil(t, result.OutputDiff)
+		assert.Equal(t, []resource.PropertyKey{"foo"}, result.OutputDiff.ChangedKeys())
+		assert.Equal(t, resource.NewStringProperty("baz"), result.OutputDiff.Updates["foo"].New)
+		assert.Empty(t, result.NewInitErrors)
+	})
+
+	t.Run("init errors", func(t *testing.T) {
+		t.Parallel()
+
+		result := refresh(t, "unhealthy", "still unhealthy")
+		assert.Equal(t, []string{"now unhealthy"}, result.NewInitErrors)
+		assert.Nil(t, result.OutputDiff)
+	})
+
+	t.Run("deleted", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, &RefreshResult{Op: OpDelete, OldID: "old-id"}, refresh(t, "deleted"))
+	})
+}
@@ -0,0 +1,166 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+// RetryConfig is a deployment-wide policy for retrying the provider calls made by create, update, and delete steps
+// when they fail transiently. Each retry waits twice as long as the one before it.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of times a provider call is attempted, including the first attempt. Values
+	// less than two disable retries.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry.
+	BaseDelay time.Duration
+	// Jitter, if true, shortens each delay to a random duration between zero and its full length so that steps that
+	// fail together do not all retry at the same time.
+	Jitter bool
+	// Retryable reports whether a failed call with the given status and error should be retried. Defaults to
+	// DefaultRetryable.
+	Retryable func(resource.Status, error) bool
+
+	// After returns a channel that will send the time after the duration elapses. Defaults to time.After.
+	After func(time.Duration) <-chan time.Time
+	// Rand returns a random number in [0, 1) that is used to jitter delays. Defaults to rand.Float64.
+	Rand func() float64
+	// Now returns the current time, which is used to track the time remaining in a call's timeout. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// RetryTimeoutError is returned when a provider call and its retries run out of the time allowed by the call's
+// timeout.
+type RetryTimeoutError struct {
+	URN     resource.URN  // the URN of the resource the call operated on
+	Timeout time.Duration // the timeout shared by all attempts
+	Err     error         // the error of the last attempt
+}
+
+func (e *RetryTimeoutError) Error() string {
+	return fmt.Sprintf("operation on '%s' did not succeed within its timeout of %v: %v", e.URN, e.Timeout, e.Err)
+}
+
+func (e *RetryTimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// DefaultRetryable reports whether a failed provider call is safe to retry. Only failures that the provider marked as
+// transient and that left the resource unchanged, as indicated by resource.StatusOK, are retried. Partial failures and
+// failures with an unknown outcome may have changed the resource, and other errors will not succeed on a later attempt.
+func DefaultRetryable(status resource.Status, err error) bool {
+	return ClassifyStepError(status, err) == StepErrorRetryable
+}
+
+// delay returns the delay before the given retry, where the first retry is 1.
+func (c *RetryConfig) delay(retry int) time.Duration {
+	delay := c.BaseDelay
+	for i := 1; i < retry && delay < time.Hour; i++ {
+		delay *= 2
+	}
+	if c.Jitter {
+		random := rand.Float64 //nolint:gosec
+		if c.Rand != nil {
+			random = c.Rand
+		}
+		delay = time.Duration(random() * float64(delay))
+	}
+	return delay
+}
+
+// do calls op, retrying it according to the policy until it succeeds, fails with an error that is not retryable, or
+// runs out of attempts. The status and error of the last attempt are returned.
+func (c *RetryConfig) do(urn resource.URN, op func() (resource.Status, error)) (resource.Status, error) {
+	return c.doWithTimeout(urn, 0, func(float64) (resource.Status, error) { return op() })
+}
+
+// doWithTimeout is like do, but treats the given timeout, in seconds, as a budget shared by all attempts rather than
+// a limit on each one. Each attempt is passed an even share of the time that remains, so the final attempt receives
+// all of it. The budget only applies when another attempt would be made: the results of the final attempt and of
+// attempts that are not retryable are returned as they are. Otherwise, if the budget runs out, no further attempts
+// are made and a RetryTimeoutError is returned; if it ran out during an attempt, the status is
+// resource.StatusUnknown as the attempt may not have finished, unless the attempt reported a partial failure. A
+// timeout of zero disables the budget, and each attempt is passed a timeout of zero.
+func (c *RetryConfig) doWithTimeout(urn resource.URN, timeout float64,
+	op func(timeout float64) (resource.Status, error),
+) (resource.Status, error) {
+	retryable := DefaultRetryable
+	if c.Retryable != nil {
+		retryable = c.Retryable
+	}
+	after := time.After
+	if c.After != nil {
+		after = c.After
+	}
+	now := time.Now
+	if c.Now != nil {
+		now = c.Now
+	}
+
+	budget := time.Duration(timeout * float64(time.Second))
+	var deadline time.Time
+	if budget > 0 {
+		deadline = now().Add(budget)
+	}
+
+	for attempt := 1; ; attempt++ {
+		attemptTimeout := timeout
+		if budget > 0 {
+			remaining := c.MaxAttempts - attempt + 1
+			if remaining < 1 {
+				remaining = 1
+			}
+			attemptTimeout = deadline.Sub(now()).Seconds() / float64(remaining)
+		}
+
+		rst, err := op(attemptTimeout)
+		if err == nil {
+			return rst, nil
+		}
+		if attempt >= c.MaxAttempts || !retryable(rst, err) {
+			return rst, err
+		}
+		if budget > 0 && !now().Before(deadline) {
+			if rst != resource.StatusPartialFailure {
+				rst = resource.StatusUnknown
+			}
+			return rst, &RetryTimeoutError{URN: urn, Timeout: budget, Err: err}
+		}
+
+		delay := c.delay(attempt)
+		if budget > 0 && !now().Add(delay).Before(deadline) {
+			return rst, &RetryTimeoutError{URN: urn, Timeout: budget, Err: err}
+		}
+		logging.V(7).Infof("Retrying provider call for %v after %v (attempt %d of %d): %v",
+			urn, delay, attempt+1, c.MaxAttempts, err)
+		<-after(delay)
+	}
+}
+
+// retry calls op according to the deployment's retry policy, or exactly once if the deployment has none. The timeout,
+// in seconds, is shared by all attempts; see RetryConfig.doWithTimeout.
+func (d *Deployment) retry(urn resource.URN, timeout float64,
+	op func(timeout float64) (resource.Status, error),
+) (resource.Status, error) {
+	if d == nil || d.RetryConfig == nil {
+		return op(timeout)
+	}
+	return d.RetryConfig.doWithTimeout(urn, timeout, op)
+}
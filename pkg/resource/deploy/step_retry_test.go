@@ -0,0 +1,397 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// fakeClock records the delays that a retry policy waits for without actually waiting. Waiting advances the clock's
+// current time by the delay.
+type fakeClock struct {
+	delays []time.Duration
+	now    time.Time
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.delays = append(c.delays, d)
+	c.now = c.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+// errTransient is an error that a provider marked as transient.
+var errTransient = status.Error(codes.Unavailable, "transient failure")
+
+// failingOp returns an op that fails with the given status the given number of times before succeeding.
+func failingOp(failures int, rst resource.Status) (func() (resource.Status, error), *int) {
+	attempts := 0
+	return func() (resource.Status, error) {
+		attempts++
+		if attempts <= failures {
+			return rst, errTransient
+		}
+		return resource.StatusOK, nil
+	}, &attempts
+}
+
+func TestRetryConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("backoff", func(t *testing.T) {
+		t.Parallel()
+
+		clock := &fakeClock{}
+		config := &RetryConfig{MaxAttempts: 5, BaseDelay: 10 * time.Millisecond, After: clock.After}
+		op, attempts := failingOp(3, resource.StatusOK)
+		rst, err := config.do("urn", op)
+		require.NoError(t, err)
+		assert.Equal(t, resource.StatusOK, rst)
+		assert.Equal(t, 4, *attempts)
+		assert.Equal(t, []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 40 * time.Millisecond},
+			clock.delays)
+	})
+
+	t.Run("attempts exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		clock := &fakeClock{}
+		config := &RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond, After: clock.After}
+		op, attempts := failingOp(10, resource.StatusOK)
+		_, err := config.do("urn", op)
+		assert.Equal(t, errTransient, err)
+		assert.Equal(t, 3, *attempts)
+		assert.Len(t, clock.delays, 2)
+	})
+
+	t.Run("jitter", func(t *testing.T) {
+		t.Parallel()
+
+		run := func() []time.Duration {
+			clock := &fakeClock{}
+			randoms := []float64{0.5, 0.25, 0.75}
+			config := &RetryConfig{
+				MaxAttempts: 4,
+				BaseDelay:   100 * time.Millisecond,
+				Jitter:      true,
+				After:       clock.After,
+				Rand: func() float64 {
+					r := randoms[0]
+					randoms = randoms[1:]
+					return r
+				},
+			}
+			op, _ := failingOp(3, resource.StatusOK)
+			_, err := config.do("urn", op)
+			require.NoError(t, err)
+			return clock.delays
+		}
+
+		expected := []time.Duration{50 * time.Millisecond, 50 * time.Millisecond, 300 * time.Millisecond}
+		assert.Equal(t, expected, run())
+		// The same random sequence always produces the same delays.
+		assert.Equal(t, expected, run())
+	})
+
+	t.Run("not retryable by default", func(t *testing.T) {
+		t.Parallel()
+
+		for _, rst := range []resource.Status{resource.StatusPartialFailure, resource.StatusUnknown} {
+			clock := &fakeClock{}
+			config := &RetryConfig{MaxAttempts: 3, After: clock.After}
+			op, attempts := failingOp(1, rst)
+			actual, err := config.do("urn", op)
+			assert.Error(t, err)
+			assert.Equal(t, rst, actual)
+			assert.Equal(t, 1, *attempts)
+			assert.Empty(t, clock.delays)
+		}
+
+		assert.False(t, DefaultRetryable(resource.StatusOK, status.Error(codes.NotFound, "gone")))
+		assert.False(t, DefaultRetryable(resource.StatusOK, nil))
+		assert.False(t, DefaultRetryable(resource.StatusOK, errors.New("boom")))
+		assert.True(t, DefaultRetryable(resource.StatusOK, status.Error(codes.Unavailable, "throttled")))
+	})
+
+	t.Run("custom retryable", func(t *testing.T) {
+		t.Parallel()
+
+		clock := &fakeClock{}
+		config := &RetryConfig{
+			MaxAttempts: 3,
+			After:       clock.After,
+			Retryable:   func(rst resource.Status, err error) bool { return rst == resource.StatusPartialFailure },
+		}
+		op, attempts := failingOp(1, resource.StatusPartialFailure)
+		_, err := config.do("urn", op)
+		require.NoError(t, err)
+		assert.Equal(t, 2, *attempts)
+	})
+}
+
+func TestRetryTimeoutBudget(t *testing.T) {
+	t.Parallel()
+
+	// slowOp returns an op that takes the given durations on the clock, failing transiently on every attempt but the
+	// last. The timeout passed to each attempt is recorded.
+	slowOp := func(clock *fakeClock, durations ...time.Duration) (func(float64) (resource.Status, error), *[]float64) {
+		var timeouts []float64
+		return func(timeout float64) (resource.Status, error) {
+			timeouts = append(timeouts, timeout)
+			clock.now = clock.now.Add(durations[len(timeouts)-1])
+			if len(timeouts) < len(durations) {
+				return resource.StatusOK, errTransient
+			}
+			return resource.StatusOK, nil
+		}, &timeouts
+	}
+
+	t.Run("split across attempts", func(t *testing.T) {
+		t.Parallel()
+
+		clock := &fakeClock{}
+		config := &RetryConfig{MaxAttempts: 3, BaseDelay: 10 * time.Second, After: clock.After, Now: clock.Now}
+		op, timeouts := slowOp(clock, 20*time.Second, 20*time.Second, 20*time.Second)
+		start := clock.Now()
+
+		rst, err := config.doWithTimeout("urn", 120, op)
+		require.NoError(t, err)
+		assert.Equal(t, resource.StatusOK, rst)
+
+		// The first attempt gets a third of the budget. After it and a 10s delay, 90s remain, half of which go to the
+		// second attempt. After it and a 20s delay, the final attempt gets the remaining 50s.
+		assert.Equal(t, []float64{40, 45, 50}, *timeouts)
+		assert.LessOrEqual(t, clock.Now().Sub(start), 120*time.Second)
+	})
+
+	t.Run("exhausted during attempt", func(t *testing.T) {
+		t.Parallel()
+
+		clock := &fakeClock{}
+		config := &RetryConfig{MaxAttempts: 5, BaseDelay: time.Second, After: clock.After, Now: clock.Now}
+		op, timeouts := slowOp(clock, 30*time.Second, 40*time.Second, time.Second)
+
+		rst, err := config.doWithTimeout("urn", 60, op)
+		assert.Equal(t, resource.StatusUnknown, rst)
+		assert.EqualError(t, err, "operation on 'urn' did not succeed within its timeout of 1m0s: "+errTransient.Error())
+
+		var timeoutErr *RetryTimeoutError
+		require.True(t, errors.As(err, &timeoutErr))
+		assert.Equal(t, time.Minute, timeoutErr.Timeout)
+		assert.Len(t, *timeouts, 2)
+	})
+
+	t.Run("exhausted by delay", func(t *testing.T) {
+		t.Parallel()
+
+		clock := &fakeClock{}
+		config := &RetryConfig{MaxAttempts: 3, BaseDelay: time.Minute, After: clock.After, Now: clock.Now}
+		op, timeouts := slowOp(clock, time.Second, time.Second)
+
+		rst, err := config.doWithTimeout("urn", 30, op)
+		assert.Equal(t, resource.StatusOK, rst)
+		var timeoutErr *RetryTimeoutError
+		assert.True(t, errors.As(err, &timeoutErr))
+		assert.Len(t, *timeouts, 1)
+		assert.Empty(t, clock.delays)
+	})
+
+	t.Run("exhausted without another attempt", func(t *testing.T) {
+		t.Parallel()
+
+		// Running out of time only matters if another attempt would be made, so a partial failure, a failure that is
+		// not retryable, and the failure of the final attempt are all returned as they are.
+		cases := map[string]struct {
+			config RetryConfig
+			rst    resource.Status
+		}{
+			"partial failure": {config: RetryConfig{MaxAttempts: 3}, rst: resource.StatusPartialFailure},
+			"not retryable": {config: RetryConfig{
+				MaxAttempts: 3,
+				Retryable:   func(resource.Status, error) bool { return false },
+			}, rst: resource.StatusOK},
+			"single attempt": {config: RetryConfig{MaxAttempts: 1}, rst: resource.StatusOK},
+		}
+		for name, c := range cases {
+			clock := &fakeClock{}
+			config := c.config
+			config.After, config.Now = clock.After, clock.Now
+			attempts := 0
+			rst, err := config.doWithTimeout("urn", 10, func(float64) (resource.Status, error) {
+				attempts++
+				clock.now = clock.now.Add(time.Minute)
+				return c.rst, errors.New("failure")
+			})
+			assert.Equal(t, c.rst, rst, name)
+			assert.EqualError(t, err, "failure", name)
+			assert.Equal(t, 1, attempts, name)
+		}
+	})
+
+	t.Run("partial failure exhausted during attempt", func(t *testing.T) {
+		t.Parallel()
+
+		// A partial failure that a custom policy retries keeps its status when the budget runs out.
+		clock := &fakeClock{}
+		config := &RetryConfig{
+			MaxAttempts: 3,
+			After:       clock.After,
+			Now:         clock.Now,
+			Retryable:   func(rst resource.Status, err error) bool { return rst == resource.StatusPartialFailure },
+		}
+		rst, err := config.doWithTimeout("urn", 10, func(float64) (resource.Status, error) {
+			clock.now = clock.now.Add(time.Minute)
+			return resource.StatusPartialFailure, errors.New("failure")
+		})
+		assert.Equal(t, resource.StatusPartialFailure, rst)
+		var timeoutErr *RetryTimeoutError
+		assert.True(t, errors.As(err, &timeoutErr))
+	})
+
+	t.Run("no timeout", func(t *testing.T) {
+		t.Parallel()
+
+		clock := &fakeClock{}
+		config := &RetryConfig{MaxAttempts: 3, BaseDelay: time.Hour, After: clock.After, Now: clock.Now}
+		op, timeouts := slowOp(clock, time.Hour, time.Hour, time.Hour)
+
+		_, err := config.doWithTimeout("urn", 0, op)
+		require.NoError(t, err)
+		assert.Equal(t, []float64{0, 0, 0}, *timeouts)
+	})
+
+	t.Run("create step", func(t *testing.T) {
+		t.Parallel()
+
+		clock := &fakeClock{}
+		var timeouts []float64
+		deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+			CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+				preview bool,
+			) (resource.ID, resource.PropertyMap, resource.Status, error) {
+				timeouts = append(timeouts, timeout)
+				clock.now = clock.now.Add(time.Duration(timeout * float64(time.Second)))
+				return "", nil, resource.StatusOK, errTransient
+			},
+		})
+		deployment.RetryConfig = &RetryConfig{MaxAttempts: 10, BaseDelay: time.Second, After: clock.After, Now: clock.Now}
+
+		new := newStepTestResource("res", ref)
+		new.CustomTimeouts.Create = 100
+		start := clock.Now()
+		rst, _, err := NewCreateStep(deployment, doneEvent{}, new).Apply(false)
+		assert.Equal(t, resource.StatusOK, rst)
+		var timeoutErr *RetryTimeoutError
+		assert.True(t, errors.As(err, &timeoutErr))
+
+		// Every attempt used all of the time it was given, yet the attempts together stayed within the budget.
+		assert.Equal(t, float64(10), timeouts[0])
+		assert.Less(t, len(timeouts), 10)
+		assert.LessOrEqual(t, clock.Now().Sub(start), 100*time.Second)
+	})
+}
+
+func TestStepRetries(t *testing.T) {
+	t.Parallel()
+
+	// newFlakyProvider returns a provider whose create, update, and delete each fail once before succeeding.
+	newFlakyProvider := func(calls map[string]int) *deploytest.Provider {
+		fail := func(method string) error {
+			calls[method]++
+			if calls[method] == 1 {
+				return errTransient
+			}
+			return nil
+		}
+		return &deploytest.Provider{
+			CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+				preview bool,
+			) (resource.ID, resource.PropertyMap, resource.Status, error) {
+				if err := fail("create"); err != nil {
+					return "", nil, resource.StatusOK, err
+				}
+				return "created-id", news, resource.StatusOK, nil
+			},
+			UpdateF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap,
+				timeout float64, ignoreChanges []string, preview bool,
+			) (resource.PropertyMap, resource.Status, error) {
+				if err := fail("update"); err != nil {
+					return nil, resource.StatusOK, err
+				}
+				return newInputs, resource.StatusOK, nil
+			},
+			DeleteF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs resource.PropertyMap,
+				timeout float64,
+			) (resource.Status, error) {
+				return resource.StatusOK, fail("delete")
+			},
+		}
+	}
+
+	applySteps := func(t *testing.T, config *RetryConfig) (map[string]int, []error) {
+		calls := map[string]int{}
+		deployment, ref := newStepTestDeployment(t, newFlakyProvider(calls))
+		deployment.RetryConfig = config
+
+		old := newStepTestResource("res", ref)
+		old.ID = "id"
+		steps := []Step{
+			NewCreateStep(deployment, doneEvent{}, newStepTestResource("res", ref)),
+			NewUpdateStep(deployment, doneEvent{}, old, newStepTestResource("res", ref), nil, nil, nil, nil),
+			NewDeleteStep(deployment, map[resource.URN]bool{}, old),
+		}
+		var errs []error
+		for _, step := range steps {
+			_, _, err := step.Apply(false)
+			errs = append(errs, err)
+		}
+		return calls, errs
+	}
+
+	t.Run("with retries", func(t *testing.T) {
+		t.Parallel()
+
+		clock := &fakeClock{}
+		calls, errs := applySteps(t, &RetryConfig{MaxAttempts: 2, BaseDelay: time.Second, After: clock.After})
+		assert.Equal(t, []error{nil, nil, nil}, errs)
+		assert.Equal(t, map[string]int{"create": 2, "update": 2, "delete": 2}, calls)
+		assert.Equal(t, []time.Duration{time.Second, time.Second, time.Second}, clock.delays)
+	})
+
+	t.Run("without retries", func(t *testing.T) {
+		t.Parallel()
+
+		calls, errs := applySteps(t, nil)
+		for _, err := range errs {
+			assert.Equal(t, errTransient, err)
+		}
+		assert.Equal(t, map[string]int{"create": 1, "update": 1, "delete": 1}, calls)
+	})
+}
@@ -0,0 +1,165 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"strconv"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+)
+
+// SizedStep is a step that can estimate the size of the resource states that it carries.
+type SizedStep interface {
+	Step
+
+	// ApproxStateBytes returns an estimate of the serialized size, in bytes, of the step's old and new states. The
+	// estimate is cheap to compute and is intended to find unusually large resources; it is not exact.
+	ApproxStateBytes() int
+}
+
+var (
+	_ SizedStep = (*SameStep)(nil)
+	_ SizedStep = (*CreateStep)(nil)
+	_ SizedStep = (*DeleteStep)(nil)
+	_ SizedStep = (*RemovePendingReplaceStep)(nil)
+	_ SizedStep = (*UpdateStep)(nil)
+	_ SizedStep = (*ReplaceStep)(nil)
+	_ SizedStep = (*ReadStep)(nil)
+	_ SizedStep = (*AdoptStep)(nil)
+	_ SizedStep = (*RefreshStep)(nil)
+	_ SizedStep = (*ImportStep)(nil)
+)
+
+func (s *SameStep) ApproxStateBytes() int                 { return approxStepStateBytes(s) }
+func (s *CreateStep) ApproxStateBytes() int               { return approxStepStateBytes(s) }
+func (s *DeleteStep) ApproxStateBytes() int               { return approxStepStateBytes(s) }
+func (s *RemovePendingReplaceStep) ApproxStateBytes() int { return approxStepStateBytes(s) }
+func (s *UpdateStep) ApproxStateBytes() int               { return approxStepStateBytes(s) }
+func (s *ReplaceStep) ApproxStateBytes() int              { return approxStepStateBytes(s) }
+func (s *ReadStep) ApproxStateBytes() int                 { return approxStepStateBytes(s) }
+func (s *AdoptStep) ApproxStateBytes() int                { return approxStepStateBytes(s) }
+func (s *RefreshStep) ApproxStateBytes() int              { return approxStepStateBytes(s) }
+func (s *ImportStep) ApproxStateBytes() int               { return approxStepStateBytes(s) }
+
+// approxStepStateBytes estimates the serialized size of the given step's old and new states. A state that is both
+// the old and the new state of the step is only counted once.
+func approxStepStateBytes(s Step) int {
+	old, new := s.Old(), s.New()
+	size := approxStateBytes(old)
+	if new != old {
+		size += approxStateBytes(new)
+	}
+	return size
+}
+
+// stateOverheadBytes approximates the size of the field names and punctuation of a serialized resource state.
+const stateOverheadBytes = 128
+
+// approxStateBytes estimates the serialized size of the given state. It accounts for the state's properties and its
+// variable-length fields; fixed-size fields are covered by stateOverheadBytes.
+func approxStateBytes(state *resource.State) int {
+	if state == nil {
+		return 0
+	}
+
+	size := stateOverheadBytes
+	size += len(state.URN) + len(state.Type) + len(state.ID) + len(state.Provider) + len(state.Parent)
+	size += len(state.ImportID) + len(state.DeletedWith) + len(state.SourcePosition)
+	size += approxPropertyMapBytes(state.Inputs) + approxPropertyMapBytes(state.Outputs)
+	for _, urn := range state.Dependencies {
+		size += len(urn) + 3
+	}
+	for k, urns := range state.PropertyDependencies {
+		size += len(k) + 5
+		for _, urn := range urns {
+			size += len(urn) + 3
+		}
+	}
+	for _, k := range state.AdditionalSecretOutputs {
+		size += len(k) + 3
+	}
+	for _, urn := range state.Aliases {
+		size += len(urn) + 3
+	}
+	for _, err := range state.InitErrors {
+		size += len(err) + 3
+	}
+	return size
+}
+
+// approxPropertyMapBytes estimates the size of the given properties when they are serialized as a JSON object.
+func approxPropertyMapBytes(props resource.PropertyMap) int {
+	size := 2
+	for k, v := range props {
+		// The key, its quotes, a colon, and a separating comma.
+		size += len(k) + 4 + approxPropertyValueBytes(v)
+	}
+	return size
+}
+
+// sigOverheadBytes approximates the size of the signature and field names of a serialized special value, e.g. a
+// secret, an asset, or a resource reference.
+const sigOverheadBytes = 80
+
+// approxPropertyValueBytes estimates the size of the given value when it is serialized as JSON.
+func approxPropertyValueBytes(v resource.PropertyValue) int {
+	switch {
+	case v.IsNull():
+		return 4
+	case v.IsBool():
+		return 5
+	case v.IsNumber():
+		return len(strconv.FormatFloat(v.NumberValue(), 'g', -1, 64))
+	case v.IsString():
+		return len(v.StringValue()) + 2
+	case v.IsArray():
+		size := 2
+		for _, e := range v.ArrayValue() {
+			size += approxPropertyValueBytes(e) + 1
+		}
+		return size
+	case v.IsObject():
+		return approxPropertyMapBytes(v.ObjectValue())
+	case v.IsComputed():
+		return len(plugin.UnknownStringValue) + 2
+	case v.IsOutput():
+		o := v.OutputValue()
+		if !o.Known {
+			return len(plugin.UnknownStringValue) + 2
+		}
+		return approxPropertyValueBytes(o.Element)
+	case v.IsSecret():
+		return sigOverheadBytes + approxPropertyValueBytes(v.SecretValue().Element)
+	case v.IsAsset():
+		a := v.AssetValue()
+		return sigOverheadBytes + len(a.Hash) + len(a.Text) + len(a.Path) + len(a.URI)
+	case v.IsArchive():
+		a := v.ArchiveValue()
+		size := sigOverheadBytes + len(a.Hash) + len(a.Path) + len(a.URI)
+		for name, asset := range a.Assets {
+			size += len(name) + 4
+			if asset, ok := asset.(*resource.Asset); ok {
+				size += sigOverheadBytes + len(asset.Hash) + len(asset.Text) + len(asset.Path) + len(asset.URI)
+			}
+		}
+		return size
+	case v.IsResourceReference():
+		ref := v.ResourceReferenceValue()
+		return sigOverheadBytes + len(ref.URN) + len(ref.PackageVersion) + approxPropertyValueBytes(ref.ID)
+	default:
+		return 0
+	}
+}
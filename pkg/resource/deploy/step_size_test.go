@@ -0,0 +1,93 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestApproxStateBytes(t *testing.T) {
+	t.Parallel()
+
+	// Build a resource whose inputs are a large nested map.
+	nested := resource.PropertyMap{}
+	for i := 0; i < 100; i++ {
+		obj := resource.PropertyMap{}
+		for j := 0; j < 50; j++ {
+			obj[resource.PropertyKey(fmt.Sprintf("key-%d", j))] = resource.NewStringProperty(strings.Repeat("x", 20))
+		}
+		obj["list"] = resource.NewArrayProperty([]resource.PropertyValue{
+			resource.NewNumberProperty(float64(i)), resource.NewBoolProperty(true), resource.NewNullProperty(),
+		})
+		nested[resource.PropertyKey(fmt.Sprintf("object-%d", i))] = resource.NewObjectProperty(obj)
+	}
+	large := newTestDeploymentResource("large", TestProviderRef("pkgA"))
+	large.Inputs = nested
+	large.Outputs = nested
+
+	b, err := json.Marshal(map[string]interface{}{
+		"urn":      large.URN,
+		"type":     large.Type,
+		"provider": large.Provider,
+		"inputs":   large.Inputs.Mappable(),
+		"outputs":  large.Outputs.Mappable(),
+	})
+	require.NoError(t, err)
+	serialized := len(b)
+
+	create := NewCreateStep(nil, doneEvent{}, large).(SizedStep)
+	estimate := create.ApproxStateBytes()
+	assert.GreaterOrEqual(t, estimate, serialized*9/10)
+	assert.LessOrEqual(t, estimate, serialized*11/10+stateOverheadBytes)
+
+	// An update carries both an old and a new state.
+	old := newTestDeploymentResource("large", TestProviderRef("pkgA"))
+	old.ID = "id"
+	old.Inputs, old.Outputs = nested, nested
+	update := NewUpdateStep(nil, doneEvent{}, old, large, nil, nil, nil, nil).(SizedStep)
+	assert.InDelta(t, 2*estimate, update.ApproxStateBytes(), float64(estimate)/10)
+
+	// A small resource is estimated to be much smaller than a large one.
+	small := NewCreateStep(nil, doneEvent{}, newTestDeploymentResource("small", TestProviderRef("pkgA"))).(SizedStep)
+	assert.Less(t, small.ApproxStateBytes(), estimate/100)
+
+	t.Run("special values", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := resource.NewTextAsset(strings.Repeat("y", 1000))
+		require.NoError(t, err)
+		res := newTestDeploymentResource("special", TestProviderRef("pkgA"))
+		res.Inputs = resource.PropertyMap{
+			"secret":   resource.MakeSecret(resource.NewStringProperty(strings.Repeat("s", 1000))),
+			"computed": resource.MakeComputed(resource.NewStringProperty("")),
+			"asset":    resource.NewAssetProperty(text),
+			"output": resource.NewOutputProperty(resource.Output{
+				Element: resource.NewStringProperty(strings.Repeat("o", 1000)),
+				Known:   true,
+			}),
+		}
+		size := NewCreateStep(nil, doneEvent{}, res).(SizedStep).ApproxStateBytes()
+		assert.Greater(t, size, 3000)
+		assert.Less(t, size, 4000)
+	})
+}
@@ -0,0 +1,41 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"github.com/pulumi/pulumi/pkg/v3/display"
+)
+
+// SummarizeSteps counts the operations that the given steps will perform under the given constraint, e.g. the
+// operation implied by --target or --replace. Updates that the provider reported had no effect are counted as sames.
+// An update that the constraint does not allow is counted as a same if the constraint allows sames, as the resource
+// is then left as it is; any other step that the constraint does not allow will not run and is not counted.
+func SummarizeSteps(steps []Step, constraint display.StepOp) map[display.StepOp]int {
+	counts := map[display.StepOp]int{}
+	for _, step := range steps {
+		op := step.Op()
+		if update, ok := step.(*UpdateStep); ok {
+			op = update.EffectiveOp()
+		}
+		if !ConstrainedTo(op, constraint) {
+			if op != OpUpdate || !ConstrainedTo(OpSame, constraint) {
+				continue
+			}
+			op = OpSame
+		}
+		counts[op]++
+	}
+	return counts
+}
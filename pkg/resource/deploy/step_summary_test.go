@@ -0,0 +1,63 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v3/display"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestSummarizeSteps(t *testing.T) {
+	t.Parallel()
+
+	ref := TestProviderRef("pkgA")
+	old := func(name string) *resource.State {
+		r := newTestDeploymentResource(name, ref)
+		r.ID = "id"
+		return r
+	}
+	new := func(name string) *resource.State {
+		return newTestDeploymentResource(name, ref)
+	}
+
+	unchanged := NewUpdateStep(nil, doneEvent{}, old("unchanged"), new("unchanged"), nil, nil, nil, nil)
+	unchanged.(*UpdateStep).unchanged = true
+	steps := []Step{
+		NewSameStep(nil, doneEvent{}, old("same"), new("same")),
+		NewCreateStep(nil, doneEvent{}, new("create")),
+		NewUpdateStep(nil, doneEvent{}, old("update"), new("update"), nil, nil, nil, nil),
+		unchanged,
+		NewDeleteStep(nil, map[resource.URN]bool{}, old("delete")),
+		NewReplaceStep(nil, old("replace"), new("replace"), nil, nil, nil, true),
+	}
+
+	// A replace constraint allows sames, updates, and replacements, but not creates or deletes.
+	assert.Equal(t, map[display.StepOp]int{OpSame: 2, OpUpdate: 1, OpReplace: 1}, SummarizeSteps(steps, OpReplace))
+
+	// Under a same constraint, updates are downgraded to sames and everything else is dropped.
+	assert.Equal(t, map[display.StepOp]int{OpSame: 3}, SummarizeSteps(steps, OpSame))
+
+	// Under an update constraint, only the update that has an effect is counted as one.
+	assert.Equal(t, map[display.StepOp]int{OpSame: 2, OpUpdate: 1}, SummarizeSteps(steps, OpUpdate))
+
+	// Deletes do not allow sames, so updates are not downgraded.
+	assert.Equal(t, map[display.StepOp]int{OpDelete: 1}, SummarizeSteps(steps, OpDelete))
+
+	assert.Empty(t, SummarizeSteps(nil, OpSame))
+}
@@ -0,0 +1,2281 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pulumi/pulumi/pkg/v3/display"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/pkg/v3/resource/graph"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// newStepTestDeployment returns a deployment whose provider registry has the given provider registered for package
+// "pkgA", along with the reference under which the provider is registered.
+func newStepTestDeployment(t *testing.T, prov plugin.Provider) (*Deployment, providers.Reference) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return prov, nil
+		}, deploytest.WithoutGrpc),
+	}
+	host := deploytest.NewPluginHost(nil, nil, nil, loaders...)
+	t.Cleanup(func() { contract.IgnoreClose(host) })
+
+	registry := providers.NewRegistry(host, false, nil)
+	provState := newProviderResource("pkgA", "default", "provider-id", resource.PropertyMap{})
+	require.NoError(t, registry.Same(provState))
+
+	ref, err := providers.NewReference(provState.URN, provState.ID)
+	require.NoError(t, err)
+
+	sink := diag.DefaultSink(io.Discard, io.Discard, diag.FormatOptions{Color: colors.Never})
+	return &Deployment{
+		ctx:       &plugin.Context{Diag: sink, Host: host},
+		providers: registry,
+		olds:      map[resource.URN]*resource.State{},
+		news:      &resourceMap{},
+	}, ref
+}
+
+// newStepTestResource returns the state of a custom resource named name that uses the given provider.
+func newStepTestResource(name string, ref providers.Reference) *resource.State {
+	ty := tokens.Type("pkgA:m:typA")
+	return &resource.State{
+		Type:     ty,
+		URN:      resource.NewURN(tokens.QName("teststack"), tokens.PackageName("pkg"), "", ty, name),
+		Custom:   true,
+		Provider: ref.String(),
+		Inputs:   resource.PropertyMap{},
+		Outputs:  resource.PropertyMap{},
+	}
+}
+
+// doneEvent is a RegisterResourceEvent that ignores its completion.
+type doneEvent struct{}
+
+var _ RegisterResourceEvent = doneEvent{}
+
+func (doneEvent) event()                      {}
+func (doneEvent) Goal() *resource.Goal        { return nil }
+func (doneEvent) Done(result *RegisterResult) {}
+
+func TestStepLastDuration(t *testing.T) {
+	t.Parallel()
+
+	const delay = 10 * time.Millisecond
+
+	prov := &deploytest.Provider{
+		CreateF: func(urn resource.URN, inputs resource.PropertyMap, timeout float64,
+			preview bool,
+		) (resource.ID, resource.PropertyMap, resource.Status, error) {
+			time.Sleep(delay)
+			return "created-id", resource.PropertyMap{}, resource.StatusOK, nil
+		},
+		UpdateF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap,
+			timeout float64, ignoreChanges []string, preview bool,
+		) (resource.PropertyMap, resource.Status, error) {
+			time.Sleep(delay)
+			return newInputs, resource.StatusOK, nil
+		},
+		DeleteF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs resource.PropertyMap,
+			timeout float64,
+		) (resource.Status, error) {
+			time.Sleep(delay)
+			return resource.StatusOK, nil
+		},
+		ReadF: func(urn resource.URN, id resource.ID,
+			inputs, state resource.PropertyMap,
+		) (plugin.ReadResult, resource.Status, error) {
+			time.Sleep(delay)
+			return plugin.ReadResult{Inputs: inputs, Outputs: resource.PropertyMap{}}, resource.StatusOK, nil
+		},
+	}
+	deployment, ref := newStepTestDeployment(t, prov)
+
+	existing := func(name string) *resource.State {
+		res := newStepTestResource(name, ref)
+		res.ID = "existing-id"
+		return res
+	}
+	external := func(name string) *resource.State {
+		res := existing(name)
+		res.External = true
+		return res
+	}
+
+	steps := map[string]Step{
+		"create": NewCreateStep(deployment, doneEvent{}, newStepTestResource("create", ref)),
+		"update": NewUpdateStep(deployment, doneEvent{}, existing("update"), newStepTestResource("update", ref),
+			nil, nil, nil, nil),
+		"delete":  NewDeleteStep(deployment, map[resource.URN]bool{}, existing("delete")),
+		"read":    NewReadStep(deployment, nil, nil, external("read")),
+		"refresh": NewRefreshStep(deployment, existing("refresh"), nil),
+	}
+	for name, step := range steps {
+		name, step := name, step
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			timed, ok := step.(TimingStep)
+			require.True(t, ok)
+			assert.Zero(t, timed.LastDuration())
+
+			_, _, err := step.Apply(false)
+			require.NoError(t, err)
+			assert.GreaterOrEqual(t, timed.LastDuration(), delay)
+		})
+	}
+}
+
+func TestStepAppliedAt(t *testing.T) {
+	t.Parallel()
+
+	prov := &deploytest.Provider{
+		ReadF: func(urn resource.URN, id resource.ID,
+			inputs, state resource.PropertyMap,
+		) (plugin.ReadResult, resource.Status, error) {
+			// Imports need the provider to return the resource's inputs.
+			inputs = resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+			return plugin.ReadResult{Inputs: inputs, Outputs: resource.PropertyMap{}}, resource.StatusOK, nil
+		},
+		DiffF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap,
+			ignoreChanges []string,
+		) (plugin.DiffResult, error) {
+			return plugin.DiffResult{Changes: plugin.DiffNone}, nil
+		},
+	}
+	deployment, ref := newStepTestDeployment(t, prov)
+
+	existing := func(name string) *resource.State {
+		res := newStepTestResource(name, ref)
+		res.ID = "existing-id"
+		return res
+	}
+	external := func(name string) *resource.State {
+		res := existing(name)
+		res.External = true
+		return res
+	}
+
+	steps := map[string]Step{
+		"create": NewCreateStep(deployment, doneEvent{}, newStepTestResource("create", ref)),
+		"update": NewUpdateStep(deployment, doneEvent{}, existing("update"), newStepTestResource("update", ref),
+			nil, nil, nil, nil),
+		"delete":  NewDeleteStep(deployment, map[resource.URN]bool{}, existing("delete")),
+		"read":    NewReadStep(deployment, nil, nil, external("read")),
+		"refresh": NewRefreshStep(deployment, existing("refresh"), nil),
+		"import":  NewImportStep(deployment, doneEvent{}, existing("import"), nil, []byte{}),
+		"adopt":   NewAdoptStep(deployment, doneEvent{}, external("adopt"), newStepTestResource("adopt", ref)),
+	}
+	for name, step := range steps {
+		name, step := name, step
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			timestamped, ok := step.(TimestampedStep)
+			require.True(t, ok)
+			assert.Nil(t, timestamped.AppliedAt())
+
+			before := time.Now()
+			_, _, err := step.Apply(false)
+			require.NoError(t, err)
+
+			appliedAt := timestamped.AppliedAt()
+			require.NotNil(t, appliedAt)
+			assert.Equal(t, time.UTC, appliedAt.Location())
+			assert.False(t, appliedAt.Before(before.UTC()))
+			assert.False(t, appliedAt.After(time.Now()))
+		})
+	}
+}
+
+func TestStepMaxInitErrors(t *testing.T) {
+	t.Parallel()
+
+	reasons := make([]string, 100)
+	for i := range reasons {
+		reasons[i] = fmt.Sprintf("reason %d", i)
+	}
+	initErr := &plugin.InitError{Reasons: reasons}
+
+	cases := []struct {
+		name     string
+		max      int
+		expected []string
+	}{
+		{"unlimited", 0, reasons},
+		{"at limit", 100, reasons},
+		{"truncated", 3, []string{"reason 0", "reason 1", "reason 2", "(97 more omitted)"}},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+				CreateF: func(urn resource.URN, inputs resource.PropertyMap, timeout float64,
+					preview bool,
+				) (resource.ID, resource.PropertyMap, resource.Status, error) {
+					return "created-id", resource.PropertyMap{}, resource.StatusPartialFailure, initErr
+				},
+			})
+			deployment.MaxInitErrors = c.max
+
+			res := newStepTestResource("create", ref)
+			_, _, err := NewCreateStep(deployment, doneEvent{}, res).Apply(false)
+			assert.Equal(t, initErr, err)
+			assert.Equal(t, c.expected, res.InitErrors)
+		})
+	}
+}
+
+func TestGetProviderResolveProviderRef(t *testing.T) {
+	t.Parallel()
+
+	provState := newProviderResource("pkgA", "default", "provider-id", resource.PropertyMap{})
+	ref, err := providers.NewReference(provState.URN, provState.ID)
+	require.NoError(t, err)
+
+	otherURN := resource.NewURN("teststack", "pkg", "", providers.MakeProviderType("pkgA"), "other")
+	other, err := providers.NewReference(otherURN, "other-id")
+	require.NoError(t, err)
+	denied := providers.NewDenyDefaultProvider("pkgA")
+
+	cases := []struct {
+		name    string
+		ref     providers.Reference
+		resolve func(providers.Reference) providers.Reference
+		err     string
+	}{
+		{
+			name: "no hook",
+			ref:  ref,
+		},
+		{
+			name: "no hook unknown provider",
+			ref:  other,
+			err:  "unknown provider '" + other.String() + "'",
+		},
+		{
+			name:    "remapped",
+			ref:     other,
+			resolve: func(providers.Reference) providers.Reference { return ref },
+		},
+		{
+			name:    "remapped from denied default",
+			ref:     denied,
+			resolve: func(providers.Reference) providers.Reference { return ref },
+		},
+		{
+			name:    "remapped to denied default",
+			ref:     ref,
+			resolve: func(providers.Reference) providers.Reference { return denied },
+			err:     "Default provider for 'pkgA' disabled.",
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			prov := &deploytest.Provider{}
+			deployment, _ := newStepTestDeployment(t, prov)
+			deployment.ResolveProviderRef = c.resolve
+			step := NewCreateStep(deployment, doneEvent{}, newStepTestResource("res", c.ref))
+
+			actual, err := getProvider(step)
+			if c.err != "" {
+				assert.ErrorContains(t, err, c.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, prov, actual)
+		})
+	}
+}
+
+func TestResolveProvider(t *testing.T) {
+	t.Parallel()
+
+	prov := &deploytest.Provider{}
+	deployment, ref := newStepTestDeployment(t, prov)
+
+	actual, err := ResolveProvider(NewCreateStep(deployment, doneEvent{}, newStepTestResource("res", ref)))
+	require.NoError(t, err)
+	assert.Equal(t, prov, actual)
+
+	denied := NewCreateStep(deployment, doneEvent{}, newStepTestResource("res", providers.NewDenyDefaultProvider("pkgA")))
+	_, err = ResolveProvider(denied)
+	assert.ErrorContains(t, err, "Default provider for 'pkgA' disabled.")
+
+	provState := newProviderResource("pkgA", "other", "", resource.PropertyMap{})
+	actual, err = ResolveProvider(NewCreateStep(deployment, doneEvent{}, provState))
+	require.NoError(t, err)
+	assert.Equal(t, deployment.providers, actual)
+}
+
+func TestResolveDeletedWith(t *testing.T) {
+	t.Parallel()
+
+	newResource := func(name string, with resource.URN) *resource.State {
+		res := newStepTestResource(name, providers.Reference{})
+		res.DeletedWith = with
+		return res
+	}
+
+	a, b, c := newResource("a", ""), newResource("b", ""), newResource("c", "")
+	a.DeletedWith, b.DeletedWith = b.URN, c.URN
+
+	x, y := newResource("x", ""), newResource("y", "")
+	x.DeletedWith, y.DeletedWith = y.URN, x.URN
+
+	deployment := &Deployment{olds: map[resource.URN]*resource.State{}}
+	for _, res := range []*resource.State{a, b, c, x, y} {
+		deployment.olds[res.URN] = res
+	}
+
+	cases := []struct {
+		name      string
+		res       *resource.State
+		deletions []*resource.State
+		expected  bool
+	}{
+		{"no owner", c, []*resource.State{a, b, c}, false},
+		{"unknown resource", newResource("d", c.URN), []*resource.State{c}, false},
+		{"direct owner deleted", b, []*resource.State{b, c}, true},
+		{"direct owner not deleted", b, []*resource.State{b}, false},
+		{"transitive owner deleted", a, []*resource.State{a, c}, true},
+		{"chain fully deleted", a, []*resource.State{a, b, c}, true},
+		{"chain not deleted", a, []*resource.State{a}, false},
+		{"cycle deleted", x, []*resource.State{x, y}, true},
+		{"cycle not deleted", x, []*resource.State{x}, false},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			deletions := map[resource.URN]bool{}
+			for _, res := range tt.deletions {
+				deletions[res.URN] = true
+			}
+			assert.Equal(t, tt.expected, deployment.ResolveDeletedWith(tt.res.URN, deletions))
+		})
+	}
+
+	t.Run("delete step", func(t *testing.T) {
+		t.Parallel()
+
+		deleted := map[resource.URN]bool{}
+		prov := &deploytest.Provider{
+			DeleteF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs resource.PropertyMap,
+				timeout float64,
+			) (resource.Status, error) {
+				deleted[urn] = true
+				return resource.StatusOK, nil
+			},
+		}
+		deployment, ref := newStepTestDeployment(t, prov)
+
+		urns := map[string]resource.URN{}
+		for _, name := range []string{"a", "b", "c"} {
+			urns[name] = newStepTestResource(name, ref).URN
+		}
+		withs := map[string]resource.URN{"a": urns["b"], "b": urns["c"]}
+
+		// a is deleted with b, which is deleted with c. Only a and c are being deleted, so only c is deleted by the provider.
+		deletions := map[resource.URN]bool{urns["a"]: true, urns["c"]: true}
+		for _, name := range []string{"a", "b", "c"} {
+			res := newStepTestResource(name, ref)
+			res.ID = "existing-id"
+			res.DeletedWith = withs[name]
+			deployment.olds[res.URN] = res
+		}
+		for _, name := range []string{"a", "c"} {
+			_, _, err := NewDeleteStep(deployment, deletions, deployment.olds[urns[name]]).Apply(false)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, map[resource.URN]bool{urns["c"]: true}, deleted)
+	})
+}
+
+func TestDeleteStepDeletedWithOwnerOutcome(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name              string
+		trustDependencies bool
+		ownerSucceeded    bool
+		expected          []string
+	}{
+		{name: "owner succeeded", trustDependencies: true, ownerSucceeded: true, expected: []string{"owner"}},
+		{name: "owner failed", trustDependencies: true, expected: []string{"owner", "dependent"}},
+		{name: "owner succeeded serially", ownerSucceeded: true, expected: []string{"owner"}},
+		{name: "owner failed serially", expected: []string{"owner", "dependent"}},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			var lock sync.Mutex
+			var attempted []string
+			deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+				DeleteF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs resource.PropertyMap,
+					timeout float64,
+				) (resource.Status, error) {
+					lock.Lock()
+					defer lock.Unlock()
+					attempted = append(attempted, urn.Name())
+					if urn.Name() == "owner" && !c.ownerSucceeded {
+						return resource.StatusOK, errors.New("owner could not be deleted")
+					}
+					return resource.StatusOK, nil
+				},
+			})
+			var output bytes.Buffer
+			deployment.ctx.Diag = diag.DefaultSink(&output, &output, diag.FormatOptions{Color: colors.Never})
+
+			// The dependent both depends on and is deleted with its owner, so the dependency graph alone would
+			// delete it first.
+			owner := newStepTestResource("owner", ref)
+			owner.ID = "owner-id"
+			dependent := newStepTestResource("dependent", ref)
+			dependent.ID = "dependent-id"
+			dependent.DeletedWith = owner.URN
+			dependent.Dependencies = []resource.URN{owner.URN}
+			deployment.olds[owner.URN], deployment.olds[dependent.URN] = owner, dependent
+			deployment.depGraph = graph.NewDependencyGraph([]*resource.State{owner, dependent})
+			deletions := map[resource.URN]bool{owner.URN: true, dependent.URN: true}
+
+			opts := Options{Parallel: 4, TrustDependencies: c.trustDependencies}
+			stepGen := newStepGenerator(deployment, opts, UrnTargets{}, UrnTargets{})
+			deletes := stepGen.ScheduleDeletes([]Step{
+				NewDeleteStep(deployment, deletions, dependent),
+				NewDeleteStep(deployment, deletions, owner),
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			stepExec := newStepExecutor(ctx, cancel, deployment, opts, false /*preview*/, true /*continueOnError*/)
+			for _, antichain := range deletes {
+				tok := stepExec.ExecuteParallel(antichain)
+				tok.Wait(ctx)
+			}
+			stepExec.SignalCompletion()
+			stepExec.WaitForCompletion()
+
+			assert.Equal(t, c.expected, attempted)
+			if c.ownerSucceeded {
+				assert.NoError(t, stepExec.Errored())
+				assert.NotContains(t, output.String(), "deleting")
+			} else {
+				assert.Error(t, stepExec.Errored())
+				assert.Contains(t, output.String(), fmt.Sprintf(
+					"deleting %v directly because the deletion of %v failed", dependent.URN, owner.URN))
+			}
+		})
+	}
+}
+
+func TestCreateStepPreviewOutputs(t *testing.T) {
+	t.Parallel()
+
+	outs := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"arn":     "arn:pkgA:::res",
+		"address": plugin.UnknownStringValue,
+		"nested": map[string]interface{}{
+			"name": "res",
+			"ip":   plugin.UnknownStringValue,
+		},
+		"ports": []interface{}{80, plugin.UnknownStringValue},
+	})
+	outs["token"] = resource.MakeSecret(resource.NewStringProperty(plugin.UnknownStringValue))
+
+	newProvider := func() plugin.Provider {
+		return &deploytest.Provider{
+			CreateF: func(urn resource.URN, inputs resource.PropertyMap, timeout float64,
+				preview bool,
+			) (resource.ID, resource.PropertyMap, resource.Status, error) {
+				if preview {
+					return "", outs.Copy(), resource.StatusOK, nil
+				}
+				return "created-id", resource.PropertyMap{"arn": outs["arn"]}, resource.StatusOK, nil
+			},
+		}
+	}
+
+	t.Run("preview", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, ref := newStepTestDeployment(t, newProvider())
+		deployment.preview = true
+
+		res := newStepTestResource("res", ref)
+		step := NewCreateStep(deployment, doneEvent{}, res).(*CreateStep)
+		_, _, err := step.Apply(true)
+		require.NoError(t, err)
+
+		unknown := resource.MakeComputed(resource.NewStringProperty(""))
+		expected := resource.PropertyMap{
+			"arn":     resource.NewStringProperty("arn:pkgA:::res"),
+			"address": unknown,
+			"nested": resource.NewObjectProperty(resource.PropertyMap{
+				"name": resource.NewStringProperty("res"),
+				"ip":   unknown,
+			}),
+			"ports": resource.NewArrayProperty([]resource.PropertyValue{resource.NewNumberProperty(80), unknown}),
+			"token": resource.MakeSecret(unknown),
+		}
+		assert.Equal(t, expected, step.PreviewOutputs())
+		assert.Equal(t, expected, res.Outputs)
+		assert.True(t, step.PreviewOutputs()["arn"].IsString())
+		assert.True(t, step.PreviewOutputs()["address"].IsComputed())
+	})
+
+	t.Run("update", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, ref := newStepTestDeployment(t, newProvider())
+
+		step := NewCreateStep(deployment, doneEvent{}, newStepTestResource("res", ref)).(*CreateStep)
+		_, _, err := step.Apply(false)
+		require.NoError(t, err)
+		assert.Nil(t, step.PreviewOutputs())
+	})
+}
+
+// compositeIDProvider is a test provider that supports composite IDs joined by a separator.
+type compositeIDProvider struct {
+	*deploytest.Provider
+
+	separator string
+}
+
+func (p *compositeIDProvider) CompositeIDSeparators() (map[tokens.Type]string, error) {
+	return map[tokens.Type]string{"pkgA:m:typA": p.separator}, nil
+}
+
+func TestImportStepCompositeID(t *testing.T) {
+	t.Parallel()
+
+	newProvider := func(t *testing.T) *deploytest.Provider {
+		return &deploytest.Provider{
+			ReadF: func(urn resource.URN, id resource.ID,
+				inputs, state resource.PropertyMap,
+			) (plugin.ReadResult, resource.Status, error) {
+				assert.Equal(t, resource.ID("vpc-1/subnet-2"), id)
+				return plugin.ReadResult{
+					ID:      "subnet-2",
+					Inputs:  resource.PropertyMap{},
+					Outputs: resource.PropertyMap{},
+				}, resource.StatusOK, nil
+			},
+		}
+	}
+	newImport := func(deployment *Deployment, ref providers.Reference) (*ImportStep, *resource.State) {
+		res := newStepTestResource("res", ref)
+		res.ID = "placeholder"
+		step := NewImportStep(deployment, doneEvent{}, res, nil, []byte{}).(*ImportStep)
+		step.CompositeID = []string{"vpc-1", "subnet-2"}
+		return step, res
+	}
+
+	t.Run("supported", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, ref := newStepTestDeployment(t, &compositeIDProvider{Provider: newProvider(t), separator: "/"})
+
+		step, res := newImport(deployment, ref)
+		_, _, err := step.Apply(false)
+		require.NoError(t, err)
+		assert.Equal(t, resource.ID("subnet-2"), res.ID)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, ref := newStepTestDeployment(t, newProvider(t))
+
+		step, _ := newImport(deployment, ref)
+		_, _, err := step.Apply(false)
+		assert.ErrorContains(t, err, "does not support composite IDs")
+	})
+
+	t.Run("part contains separator", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, ref := newStepTestDeployment(t, &compositeIDProvider{Provider: newProvider(t), separator: "/"})
+
+		step, _ := newImport(deployment, ref)
+		step.CompositeID = []string{"vpc-1/a", "subnet-2"}
+		_, _, err := step.Apply(false)
+		assert.ErrorContains(t, err, `part 0 of the composite ID for resource type 'pkgA:m:typA' contains the separator "/"`)
+	})
+}
+
+func TestImportStepReconciliationPatch(t *testing.T) {
+	t.Parallel()
+
+	deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+		ReadF: func(urn resource.URN, id resource.ID,
+			inputs, state resource.PropertyMap,
+		) (plugin.ReadResult, resource.Status, error) {
+			actual := resource.PropertyMap{
+				"name": resource.NewStringProperty("actual-name"),
+				"size": resource.NewNumberProperty(2),
+				"tags": resource.NewObjectProperty(resource.PropertyMap{"env": resource.NewStringProperty("prod")}),
+			}
+			return plugin.ReadResult{Inputs: actual, Outputs: actual}, resource.StatusOK, nil
+		},
+	})
+
+	res := newStepTestResource("res", ref)
+	res.ID = "import-id"
+	res.Inputs = resource.PropertyMap{
+		"name":  resource.NewStringProperty("wanted-name"),
+		"size":  resource.NewNumberProperty(2),
+		"tags":  resource.NewObjectProperty(resource.PropertyMap{"env": resource.NewStringProperty("dev")}),
+		"extra": resource.NewBoolProperty(true),
+	}
+	step := NewImportStep(deployment, doneEvent{}, res, nil, []byte{}).(*ImportStep)
+	assert.Nil(t, step.ReconciliationPatch())
+
+	_, _, err := step.Apply(false)
+	assert.ErrorContains(t, err, "inputs to import do not match the existing resource")
+
+	// The patch contains exactly the differing keys, with the values from the actual state.
+	assert.Equal(t, resource.PropertyMap{
+		"name":  resource.NewStringProperty("actual-name"),
+		"tags":  resource.NewObjectProperty(resource.PropertyMap{"env": resource.NewStringProperty("prod")}),
+		"extra": resource.NewNullProperty(),
+	}, step.ReconciliationPatch())
+}
+
+func TestImportStepReplacementKeys(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		diff     map[string]plugin.PropertyDiff
+		expected []resource.PropertyKey
+	}{
+		{
+			name: "replace",
+			diff: map[string]plugin.PropertyDiff{
+				"name":          {Kind: plugin.DiffUpdateReplace},
+				"tags.env":      {Kind: plugin.DiffUpdate},
+				"zones[0]":      {Kind: plugin.DiffAddReplace},
+				"zones[1].name": {Kind: plugin.DiffDeleteReplace},
+			},
+			expected: []resource.PropertyKey{"name", "zones"},
+		},
+		{
+			name: "no replace",
+			diff: map[string]plugin.PropertyDiff{
+				"tags.env": {Kind: plugin.DiffUpdate},
+				"size":     {Kind: plugin.DiffAdd},
+			},
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+				ReadF: func(urn resource.URN, id resource.ID,
+					inputs, state resource.PropertyMap,
+				) (plugin.ReadResult, resource.Status, error) {
+					return plugin.ReadResult{Inputs: resource.PropertyMap{}, Outputs: resource.PropertyMap{}},
+						resource.StatusOK, nil
+				},
+				DiffF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap,
+					ignoreChanges []string,
+				) (plugin.DiffResult, error) {
+					return plugin.DiffResult{Changes: plugin.DiffSome, DetailedDiff: c.diff}, nil
+				},
+			})
+			var output bytes.Buffer
+			deployment.ctx.Diag = diag.DefaultSink(&output, &output, diag.FormatOptions{Color: colors.Never})
+
+			res := newStepTestResource("res", ref)
+			res.ID = "import-id"
+			step := NewImportStep(deployment, doneEvent{}, res, nil, []byte{}).(*ImportStep)
+			assert.Empty(t, step.ReplacementKeys())
+
+			_, _, err := step.Apply(true)
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, step.ReplacementKeys())
+			if len(c.expected) == 0 {
+				assert.NotContains(t, output.String(), "would force replacement")
+				return
+			}
+			assert.Contains(t, output.String(),
+				"importing with these inputs would force replacement because of changes to [name zones]")
+		})
+	}
+}
+
+func TestImportStepLenientCheck(t *testing.T) {
+	t.Parallel()
+
+	failure := plugin.CheckFailure{Property: "size", Reason: "size must be positive"}
+	for _, lenient := range []bool{false, true} {
+		lenient := lenient
+		t.Run(fmt.Sprintf("lenient=%v", lenient), func(t *testing.T) {
+			t.Parallel()
+
+			actual := resource.PropertyMap{"size": resource.NewNumberProperty(-1)}
+			deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+				ReadF: func(urn resource.URN, id resource.ID,
+					inputs, state resource.PropertyMap,
+				) (plugin.ReadResult, resource.Status, error) {
+					return plugin.ReadResult{Inputs: actual, Outputs: actual}, resource.StatusOK, nil
+				},
+				CheckF: func(urn resource.URN, olds, news resource.PropertyMap,
+					randomSeed []byte,
+				) (resource.PropertyMap, []plugin.CheckFailure, error) {
+					return nil, []plugin.CheckFailure{failure}, nil
+				},
+			})
+			deployment.ImportLenientCheck = lenient
+			var output bytes.Buffer
+			deployment.ctx.Diag = diag.DefaultSink(&output, &output, diag.FormatOptions{Color: colors.Never})
+
+			res := newStepTestResource("res", ref)
+			res.ID = "import-id"
+			res.Inputs = actual.Copy()
+			step := NewImportStep(deployment, doneEvent{}, res, nil, []byte{}).(*ImportStep)
+
+			_, _, err := step.Apply(false)
+			assert.Contains(t, output.String(), "size must be positive")
+			if !lenient {
+				assert.ErrorContains(t, err, "one or more inputs failed to validate")
+				assert.Contains(t, output.String(), "error:")
+				assert.Nil(t, step.CheckFailures())
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, output.String(), "warning:")
+			assert.NotContains(t, output.String(), "error:")
+			assert.Equal(t, []plugin.CheckFailure{failure}, step.CheckFailures())
+			assert.Equal(t, actual, res.Inputs)
+		})
+	}
+}
+
+func TestImportStepDeriveRandomSeed(t *testing.T) {
+	t.Parallel()
+
+	// run performs a planned import of a resource named name and returns the step's seed along with the inputs
+	// returned by Check, which generates a value from the seed.
+	run := func(t *testing.T, name string) ([]byte, resource.PropertyMap) {
+		var checked resource.PropertyMap
+		prov := &deploytest.Provider{
+			ReadF: func(urn resource.URN, id resource.ID,
+				inputs, state resource.PropertyMap,
+			) (plugin.ReadResult, resource.Status, error) {
+				return plugin.ReadResult{
+					Inputs:  resource.PropertyMap{"name": resource.NewStringProperty(name)},
+					Outputs: resource.PropertyMap{},
+				}, resource.StatusOK, nil
+			},
+			CheckF: func(urn resource.URN,
+				olds, news resource.PropertyMap, randomSeed []byte,
+			) (resource.PropertyMap, []plugin.CheckFailure, error) {
+				checked = news.Copy()
+				checked["password"] = resource.NewStringProperty(fmt.Sprintf("%x", randomSeed[:8]))
+				return checked, nil, nil
+			},
+		}
+		deployment, ref := newStepTestDeployment(t, prov)
+
+		res := newStepTestResource(name, ref)
+		res.ID = "import-id"
+		res.Inputs = nil
+		res.Parent = resource.NewURN("teststack", "pkg", "", resource.RootStackType, "teststack-pkg")
+		step := newImportDeploymentStep(deployment, res, DeriveRandomSeed(res.URN)).(*ImportStep)
+		_, _, err := step.Apply(false)
+		require.NoError(t, err)
+		return step.RandomSeed(), checked
+	}
+
+	seed, inputs := run(t, "res")
+	assert.Len(t, seed, 32)
+
+	// Re-running the import over the same URN should produce the same seed and the same generated inputs.
+	seedAgain, inputsAgain := run(t, "res")
+	assert.Equal(t, seed, seedAgain)
+	assert.Equal(t, inputs, inputsAgain)
+
+	// A different URN should produce a different seed.
+	otherSeed, otherInputs := run(t, "other")
+	assert.NotEqual(t, seed, otherSeed)
+	assert.NotEqual(t, inputs["password"], otherInputs["password"])
+}
+
+func TestRefreshConcurrency(t *testing.T) {
+	t.Parallel()
+
+	const limit, resources = 2, 8
+
+	var active, peak int32
+	prov := &deploytest.Provider{
+		ReadF: func(urn resource.URN, id resource.ID,
+			inputs, state resource.PropertyMap,
+		) (plugin.ReadResult, resource.Status, error) {
+			n := atomic.AddInt32(&active, 1)
+			defer atomic.AddInt32(&active, -1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			return plugin.ReadResult{Inputs: inputs, Outputs: state}, resource.StatusOK, nil
+		},
+	}
+	deployment, ref := newStepTestDeployment(t, prov)
+	deployment.RefreshConcurrency = limit
+
+	var wg sync.WaitGroup
+	for i := 0; i < resources; i++ {
+		res := newStepTestResource(fmt.Sprintf("res%d", i), ref)
+		res.ID = "existing-id"
+		step := NewRefreshStep(deployment, res, nil)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, err := step.Apply(false)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, peak, int32(limit))
+	assert.Positive(t, peak)
+}
+
+func TestRefreshConcurrencyPerProvider(t *testing.T) {
+	t.Parallel()
+
+	deployment := &Deployment{RefreshConcurrency: 1, RefreshConcurrencyPerProvider: true}
+
+	releaseA := deployment.acquireRefreshRead("provider-a")
+	// A different provider has its own limit, so this must not block.
+	releaseB := deployment.acquireRefreshRead("provider-b")
+
+	acquired := make(chan struct{})
+	go func() {
+		release := deployment.acquireRefreshRead("provider-a")
+		close(acquired)
+		release()
+	}()
+
+	select {
+	case <-acquired:
+		assert.Fail(t, "acquired a second read for provider-a while the first was outstanding")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	releaseA()
+	<-acquired
+	releaseB()
+}
+
+func TestDeleteStepProtected(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		allow   bool
+		deleted bool
+	}{
+		{name: "blocked", allow: false, deleted: false},
+		{name: "allowed", allow: true, deleted: true},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			deleted := false
+			deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+				DeleteF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs resource.PropertyMap,
+					timeout float64,
+				) (resource.Status, error) {
+					deleted = true
+					return resource.StatusOK, nil
+				},
+			})
+			deployment.AllowDeleteProtected = c.allow
+			var output bytes.Buffer
+			deployment.ctx.Diag = diag.DefaultSink(&output, &output, diag.FormatOptions{Color: colors.Never})
+
+			res := newStepTestResource("res", ref)
+			res.ID = "existing-id"
+			res.Protect = true
+
+			_, _, err := NewDeleteStep(deployment, map[resource.URN]bool{}, res).Apply(false)
+			assert.Equal(t, c.deleted, deleted)
+			if !c.allow {
+				assert.ErrorContains(t, err, "because it is protected")
+				assert.Empty(t, output.String())
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, output.String(),
+				"deleting protected resource "+string(res.URN)+" because deletion of protected resources is allowed")
+		})
+	}
+}
+
+func TestUpdateStepEffectiveOp(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		outputs  resource.PropertyMap
+		preview  bool
+		expected display.StepOp
+	}{
+		{
+			name:     "identical outputs",
+			outputs:  resource.PropertyMap{"foo": resource.NewStringProperty("bar")},
+			expected: OpSame,
+		},
+		{
+			name:     "changed outputs",
+			outputs:  resource.PropertyMap{"foo": resource.NewStringProperty("baz")},
+			expected: OpUpdate,
+		},
+		{
+			name:     "preview",
+			outputs:  resource.PropertyMap{"foo": resource.NewStringProperty("bar")},
+			preview:  true,
+			expected: OpUpdate,
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+				UpdateF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap,
+					timeout float64, ignoreChanges []string, preview bool,
+				) (resource.PropertyMap, resource.Status, error) {
+					return c.outputs, resource.StatusOK, nil
+				},
+			})
+
+			modified := time.Now().Add(-time.Hour).UTC()
+			old := newStepTestResource("res", ref)
+			old.ID = "existing-id"
+			old.Inputs = resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+			old.Outputs = resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+			old.Modified = &modified
+			new := newStepTestResource("res", ref)
+			new.Inputs = resource.PropertyMap{"foo": resource.NewStringProperty("qux")}
+
+			step := NewUpdateStep(deployment, doneEvent{}, old, new, nil, nil, nil, nil).(*UpdateStep)
+			_, _, err := step.Apply(c.preview)
+			require.NoError(t, err)
+			assert.Equal(t, OpUpdate, step.Op())
+			assert.Equal(t, c.expected, step.EffectiveOp())
+			require.NotNil(t, new.Modified)
+			assert.Equal(t, c.expected == OpSame, new.Modified.Equal(modified))
+		})
+	}
+}
+
+func TestDeleteStepGracePeriod(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		age       time.Duration
+		allow     bool
+		replacing bool
+		deleted   bool
+	}{
+		{name: "in window", age: time.Minute, deleted: false},
+		{name: "out of window", age: 2 * time.Hour, deleted: true},
+		{name: "in window allowed", age: time.Minute, allow: true, deleted: true},
+		{name: "in window replacing", age: time.Minute, replacing: true, deleted: true},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			deleted := false
+			deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+				DeleteF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs resource.PropertyMap,
+					timeout float64,
+				) (resource.Status, error) {
+					deleted = true
+					return resource.StatusOK, nil
+				},
+			})
+			deployment.DeleteGracePeriod = time.Hour
+			deployment.AllowDeleteWithinGracePeriod = c.allow
+
+			created := time.Now().Add(-c.age)
+			res := newStepTestResource("res", ref)
+			res.ID = "existing-id"
+			res.Created = &created
+
+			step := NewDeleteStep(deployment, map[resource.URN]bool{}, res)
+			if c.replacing {
+				step = NewDeleteReplacementStep(deployment, map[resource.URN]bool{}, res, true)
+			}
+			_, _, err := step.Apply(false)
+			assert.Equal(t, c.deleted, deleted)
+			if c.deleted {
+				require.NoError(t, err)
+				return
+			}
+			var tooSoon *DeleteTooSoonError
+			require.ErrorAs(t, err, &tooSoon)
+			assert.Equal(t, res.URN, tooSoon.URN)
+			assert.Equal(t, time.Hour, tooSoon.GracePeriod)
+			assert.True(t, created.Equal(tooSoon.Created))
+		})
+	}
+}
+
+func TestStepSkipReason(t *testing.T) {
+	t.Parallel()
+
+	ref, err := providers.NewReference("urn:pulumi:teststack::pkg::pulumi:providers:pkgA::default", "provider-id")
+	require.NoError(t, err)
+
+	newResource := func(name string, mutate func(*resource.State)) *resource.State {
+		res := newStepTestResource(name, ref)
+		res.ID = "id"
+		if mutate != nil {
+			mutate(res)
+		}
+		return res
+	}
+	owner := newResource("owner", nil)
+	deployment := &Deployment{olds: map[resource.URN]*resource.State{owner.URN: owner}}
+	ownerDeleted := map[resource.URN]bool{owner.URN: true}
+
+	cases := []struct {
+		name     string
+		step     Step
+		skipped  bool
+		expected string
+	}{
+		{
+			name: "same",
+			step: NewSameStep(deployment, doneEvent{}, newResource("res", nil), newStepTestResource("res", ref)),
+		},
+		{
+			name:     "skipped create",
+			step:     NewSkippedCreateStep(deployment, doneEvent{}, newStepTestResource("res", ref), "not in --target set"),
+			skipped:  true,
+			expected: "the resource was not targeted for creation",
+		},
+		{
+			name: "delete",
+			step: NewDeleteStep(deployment, map[resource.URN]bool{}, newResource("res", nil)),
+		},
+		{
+			name: "delete external",
+			step: NewDeleteStep(deployment, map[resource.URN]bool{}, newResource("res", func(res *resource.State) {
+				res.External = true
+			})),
+			skipped:  true,
+			expected: "the resource is external, so Pulumi does not own its lifecycle",
+		},
+		{
+			name: "delete retained",
+			step: NewDeleteStep(deployment, map[resource.URN]bool{}, newResource("res", func(res *resource.State) {
+				res.RetainOnDelete = true
+			})),
+			skipped:  true,
+			expected: "the resource is retained on delete",
+		},
+		{
+			name: "delete deleted with",
+			step: NewDeleteStep(deployment, ownerDeleted, newResource("res", func(res *resource.State) {
+				res.DeletedWith = owner.URN
+			})),
+			skipped:  true,
+			expected: "the resource will be deleted along with " + string(owner.URN),
+		},
+		{
+			name: "delete deleted with survivor",
+			step: NewDeleteStep(deployment, map[resource.URN]bool{}, newResource("res", func(res *resource.State) {
+				res.DeletedWith = owner.URN
+			})),
+		},
+		{
+			name: "refresh",
+			step: NewRefreshStep(deployment, newResource("res", nil), nil),
+		},
+		{
+			name: "refresh component",
+			step: NewRefreshStep(deployment, newResource("res", func(res *resource.State) {
+				res.Custom = false
+				res.Provider = ""
+			}), nil),
+			skipped:  true,
+			expected: "component resources are not refreshed",
+		},
+		{
+			name:     "refresh provider",
+			step:     NewRefreshStep(deployment, newProviderResource("pkgA", "default", "provider-id", nil), nil),
+			skipped:  true,
+			expected: "provider resources are not refreshed",
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			skipped, reason := c.step.(SkippableStep).SkipReason()
+			assert.Equal(t, c.skipped, skipped)
+			assert.Equal(t, c.expected, reason)
+		})
+	}
+}
+
+func TestDeleteStepNotFound(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		status resource.Status
+		err    error
+	}{
+		{name: "status", status: resource.StatusNotFound},
+		{name: "error", status: resource.StatusOK, err: status.Error(codes.NotFound, "no such resource")},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+				DeleteF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs resource.PropertyMap,
+					timeout float64,
+				) (resource.Status, error) {
+					return c.status, c.err
+				},
+			})
+			var output bytes.Buffer
+			deployment.ctx.Diag = diag.DefaultSink(&output, &output, diag.FormatOptions{Color: colors.Never})
+
+			old := newStepTestResource("res", ref)
+			old.ID = "gone-id"
+			rst, complete, err := NewDeleteStep(deployment, map[resource.URN]bool{}, old).Apply(false)
+			require.NoError(t, err)
+			assert.Equal(t, resource.StatusOK, rst)
+			assert.NotNil(t, complete)
+			assert.Contains(t, output.String(), "resource gone-id was not found, so it is assumed to have already been deleted")
+		})
+	}
+
+	t.Run("other error", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+			DeleteF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs resource.PropertyMap,
+				timeout float64,
+			) (resource.Status, error) {
+				return resource.StatusOK, status.Error(codes.PermissionDenied, "access denied")
+			},
+		})
+
+		old := newStepTestResource("res", ref)
+		old.ID = "id"
+		_, complete, err := NewDeleteStep(deployment, map[resource.URN]bool{}, old).Apply(false)
+		assert.ErrorContains(t, err, "access denied")
+		assert.Nil(t, complete)
+	})
+}
+
+func TestReadStepNotFound(t *testing.T) {
+	t.Parallel()
+
+	newReadState := func(ref providers.Reference, id resource.ID) *resource.State {
+		state := newStepTestResource("res", ref)
+		state.ID = id
+		state.External = true
+		return state
+	}
+
+	t.Run("nil outputs", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+			ReadF: func(urn resource.URN, id resource.ID,
+				inputs, state resource.PropertyMap,
+			) (plugin.ReadResult, resource.Status, error) {
+				return plugin.ReadResult{}, resource.StatusOK, nil
+			},
+		})
+
+		new := newReadState(ref, "gone-id")
+		rst, complete, err := NewReadStep(deployment, nil, nil, new).Apply(false)
+		assert.Equal(t, resource.StatusNotFound, rst)
+		assert.Nil(t, complete)
+		assert.EqualError(t, err, "resource 'gone-id' does not exist")
+
+		var notFound *ResourceNotFoundError
+		require.True(t, errors.As(err, &notFound))
+		assert.Equal(t, new.URN, notFound.URN)
+		assert.Equal(t, resource.ID("gone-id"), notFound.ID)
+	})
+
+	t.Run("unknown ID", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, ref := newStepTestDeployment(t, &deploytest.Provider{})
+
+		// During a preview, an unknown ID is expected and the read is skipped.
+		rst, complete, err := NewReadStep(deployment, nil, nil, newReadState(ref, plugin.UnknownStringValue)).Apply(true)
+		require.NoError(t, err)
+		assert.Equal(t, resource.StatusOK, rst)
+		assert.NotNil(t, complete)
+
+		rst, complete, err = NewReadStep(deployment, nil, nil, newReadState(ref, plugin.UnknownStringValue)).Apply(false)
+		assert.Equal(t, resource.StatusNotFound, rst)
+		assert.Nil(t, complete)
+		var notFound *ResourceNotFoundError
+		assert.True(t, errors.As(err, &notFound))
+	})
+}
+
+func TestAdoptStep(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	newStates := func(ref providers.Reference) (*resource.State, *resource.State) {
+		old := newStepTestResource("res", ref)
+		old.ID = "external-id"
+		old.External = true
+		old.Created = &created
+		old.Outputs = resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+
+		new := newStepTestResource("res", ref)
+		new.Inputs = resource.PropertyMap{"foo": resource.NewStringProperty("baz")}
+		return old, new
+	}
+
+	t.Run("external to owned", func(t *testing.T) {
+		t.Parallel()
+
+		var readID resource.ID
+		var readInputs, readState resource.PropertyMap
+		deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+			ReadF: func(urn resource.URN, id resource.ID,
+				inputs, state resource.PropertyMap,
+			) (plugin.ReadResult, resource.Status, error) {
+				readID, readInputs, readState = id, inputs, state
+				return plugin.ReadResult{
+					ID:      "other-id",
+					Outputs: resource.PropertyMap{"foo": resource.NewStringProperty("qux")},
+				}, resource.StatusOK, nil
+			},
+		})
+
+		old, new := newStates(ref)
+		var results []resource.URN
+		step := NewAdoptStep(deployment, recordingEvent{lock: &sync.Mutex{}, results: &results}, old, new)
+		assert.Equal(t, OpAdopt, step.Op())
+
+		rst, complete, err := step.Apply(false)
+		require.NoError(t, err)
+		assert.Equal(t, resource.StatusOK, rst)
+
+		assert.Equal(t, resource.ID("external-id"), readID)
+		assert.Equal(t, new.Inputs, readInputs)
+		assert.Equal(t, old.Outputs, readState)
+
+		assert.Equal(t, resource.ID("external-id"), new.ID)
+		assert.False(t, new.External)
+		assert.True(t, old.External)
+		assert.Equal(t, resource.PropertyMap{"foo": resource.NewStringProperty("qux")}, new.Outputs)
+		assert.Equal(t, &created, new.Created)
+		assert.NotNil(t, new.Modified)
+
+		require.NotNil(t, complete)
+		complete()
+		assert.Equal(t, []resource.URN{new.URN}, results)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+			ReadF: func(urn resource.URN, id resource.ID,
+				inputs, state resource.PropertyMap,
+			) (plugin.ReadResult, resource.Status, error) {
+				return plugin.ReadResult{}, resource.StatusOK, nil
+			},
+		})
+
+		old, new := newStates(ref)
+		rst, complete, err := NewAdoptStep(deployment, doneEvent{}, old, new).Apply(false)
+		assert.Equal(t, resource.StatusNotFound, rst)
+		assert.Nil(t, complete)
+		var notFound *ResourceNotFoundError
+		require.True(t, errors.As(err, &notFound))
+		assert.Equal(t, resource.ID("external-id"), notFound.ID)
+	})
+
+	t.Run("init errors", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+			ReadF: func(urn resource.URN, id resource.ID,
+				inputs, state resource.PropertyMap,
+			) (plugin.ReadResult, resource.Status, error) {
+				return plugin.ReadResult{ID: id, Outputs: state}, resource.StatusPartialFailure,
+					&plugin.InitError{Reasons: []string{"not ready"}}
+			},
+		})
+
+		old, new := newStates(ref)
+		rst, complete, err := NewAdoptStep(deployment, doneEvent{}, old, new).Apply(false)
+		assert.Equal(t, resource.StatusPartialFailure, rst)
+		assert.NotNil(t, complete)
+		var initErr *plugin.InitError
+		require.True(t, errors.As(err, &initErr))
+		assert.Equal(t, []string{"not ready"}, new.InitErrors)
+		assert.Equal(t, resource.ID("external-id"), new.ID)
+	})
+
+	t.Run("constraints", func(t *testing.T) {
+		t.Parallel()
+
+		assert.True(t, ConstrainedTo(OpAdopt, OpAdopt))
+		assert.True(t, ConstrainedTo(OpSame, OpAdopt))
+		assert.True(t, ConstrainedTo(OpAdopt, OpCreateReplacement))
+		assert.False(t, ConstrainedTo(OpCreateReplacement, OpAdopt))
+		assert.False(t, ConstrainedTo(OpAdopt, OpUpdate))
+		assert.Equal(t, "adopted", PastTense(OpAdopt))
+		assert.Equal(t, "& ", RawPrefix(OpAdopt))
+		assert.Contains(t, StepOps, OpAdopt)
+	})
+}
+
+func TestDeleteStepDependsOnDeletions(t *testing.T) {
+	t.Parallel()
+
+	ref, err := providers.NewReference("urn:pulumi:teststack::pkg::pulumi:providers:pkgA::default", "provider-id")
+	require.NoError(t, err)
+
+	parent := newStepTestResource("parent", ref)
+	dep := newStepTestResource("dep", ref)
+	survivor := newStepTestResource("survivor", ref)
+
+	child := newStepTestResource("child", ref)
+	child.ID = "child-id"
+	child.Parent = parent.URN
+	child.Dependencies = []resource.URN{dep.URN, survivor.URN, parent.URN}
+
+	t.Run("parent and dependency deleted", func(t *testing.T) {
+		t.Parallel()
+
+		deletions := map[resource.URN]bool{parent.URN: true, dep.URN: true, child.URN: true}
+		step := NewDeleteStep(nil, deletions, child).(*DeleteStep)
+		assert.Equal(t, []resource.URN{parent.URN, dep.URN}, step.DependsOnDeletions())
+	})
+
+	t.Run("nothing else deleted", func(t *testing.T) {
+		t.Parallel()
+
+		step := NewDeleteStep(nil, map[resource.URN]bool{child.URN: true}, child).(*DeleteStep)
+		assert.Empty(t, step.DependsOnDeletions())
+	})
+}
+
+func TestRefreshStepSecretOutputs(t *testing.T) {
+	t.Parallel()
+
+	prov := &deploytest.Provider{
+		ReadF: func(urn resource.URN, id resource.ID,
+			inputs, state resource.PropertyMap,
+		) (plugin.ReadResult, resource.Status, error) {
+			return plugin.ReadResult{
+				ID:     id,
+				Inputs: inputs,
+				Outputs: resource.PropertyMap{
+					"password": resource.MakeSecret(resource.NewStringProperty("hunter2")),
+					"token":    resource.MakeSecret(resource.NewStringProperty("abc")),
+					"name":     resource.NewStringProperty("res"),
+				},
+			}, resource.StatusOK, nil
+		},
+	}
+	deployment, ref := newStepTestDeployment(t, prov)
+
+	old := newStepTestResource("res", ref)
+	old.ID = "id"
+	old.Outputs = resource.PropertyMap{
+		"password": resource.NewStringProperty("hunter2"),
+		"token":    resource.NewStringProperty("abc"),
+		"name":     resource.NewStringProperty("res"),
+	}
+	old.AdditionalSecretOutputs = []resource.PropertyKey{"token"}
+
+	step := NewRefreshStep(deployment, old, nil)
+	_, _, err := step.Apply(false)
+	require.NoError(t, err)
+
+	// The newly secret password output should be recorded as an additional secret output, and the old state should
+	// be left untouched.
+	assert.Equal(t, []resource.PropertyKey{"token", "password"}, step.New().AdditionalSecretOutputs)
+	assert.True(t, step.New().Outputs["password"].IsSecret())
+	assert.Equal(t, []resource.PropertyKey{"token"}, old.AdditionalSecretOutputs)
+}
+
+func TestRefreshStepNormalizeInputs(t *testing.T) {
+	t.Parallel()
+
+	// The provider fills in a default region when checking inputs, but does not report it when reading inputs.
+	newProvider := func() *deploytest.Provider {
+		return &deploytest.Provider{
+			CheckF: func(urn resource.URN,
+				olds, news resource.PropertyMap, randomSeed []byte,
+			) (resource.PropertyMap, []plugin.CheckFailure, error) {
+				checked := news.Copy()
+				if _, has := checked["region"]; !has {
+					checked["region"] = resource.NewStringProperty("us-east-1")
+				}
+				return checked, nil, nil
+			},
+			ReadF: func(urn resource.URN, id resource.ID,
+				inputs, state resource.PropertyMap,
+			) (plugin.ReadResult, resource.Status, error) {
+				return plugin.ReadResult{
+					ID:      id,
+					Inputs:  resource.PropertyMap{"size": resource.NewStringProperty("small")},
+					Outputs: state,
+				}, resource.StatusOK, nil
+			},
+		}
+	}
+
+	// programInputs are the checked inputs of the program, which the next update diffs the refreshed inputs against.
+	programInputs := resource.PropertyMap{
+		"size":   resource.NewStringProperty("small"),
+		"region": resource.NewStringProperty("us-east-1"),
+	}
+
+	refresh := func(t *testing.T, normalize bool) *resource.State {
+		deployment, ref := newStepTestDeployment(t, newProvider())
+		deployment.NormalizeRefreshInputs = normalize
+
+		old := newStepTestResource("res", ref)
+		old.ID = "id"
+		old.Inputs = programInputs.Copy()
+		step := NewRefreshStep(deployment, old, nil)
+		_, _, err := step.Apply(false)
+		require.NoError(t, err)
+		return step.New()
+	}
+
+	t.Run("refresh only", func(t *testing.T) {
+		t.Parallel()
+
+		diff := refresh(t, false).Inputs.Diff(programInputs)
+		require.NotNil(t, diff)
+		assert.Equal(t, []resource.PropertyKey{"region"}, diff.ChangedKeys())
+	})
+
+	t.Run("refresh and normalize", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, refresh(t, true).Inputs.Diff(programInputs))
+	})
+}
+
+func TestReplaceStepStrategy(t *testing.T) {
+	t.Parallel()
+
+	ref, err := providers.NewReference("urn:pulumi:teststack::pkg::pulumi:providers:pkgA::default", "provider-id")
+	require.NoError(t, err)
+
+	old := newStepTestResource("res", ref)
+	old.ID = "id"
+	new := newStepTestResource("res", ref)
+
+	createBeforeDelete := NewReplaceStep(nil, old, new, nil, nil, nil, true).(*ReplaceStep)
+	assert.True(t, createBeforeDelete.PendingDelete())
+	assert.False(t, createBeforeDelete.DeleteBeforeReplace())
+
+	deleteBeforeReplace := NewReplaceStep(nil, old, new, nil, nil, nil, false).(*ReplaceStep)
+	assert.False(t, deleteBeforeReplace.PendingDelete())
+	assert.True(t, deleteBeforeReplace.DeleteBeforeReplace())
+}
+
+func TestCreateStepMissingID(t *testing.T) {
+	t.Parallel()
+
+	newDeployment := func(t *testing.T) (*Deployment, providers.Reference) {
+		return newStepTestDeployment(t, &deploytest.Provider{
+			CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+				preview bool,
+			) (resource.ID, resource.PropertyMap, resource.Status, error) {
+				return "", resource.PropertyMap{}, resource.StatusOK, nil
+			},
+		})
+	}
+
+	t.Run("create", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, ref := newDeployment(t)
+		new := newStepTestResource("res", ref)
+		_, complete, err := NewCreateStep(deployment, doneEvent{}, new).Apply(false)
+		assert.Nil(t, complete)
+		assert.EqualError(t, err, "provider for package 'pkgA' did not return an ID from Create")
+
+		var missingID *MissingCreateIDError
+		require.True(t, errors.As(err, &missingID))
+		assert.Equal(t, new.URN, missingID.URN)
+		assert.Equal(t, tokens.Package("pkgA"), missingID.Provider)
+	})
+
+	t.Run("preview", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, ref := newDeployment(t)
+		_, _, err := NewCreateStep(deployment, doneEvent{}, newStepTestResource("res", ref)).Apply(true)
+		assert.NoError(t, err)
+	})
+}
+
+func TestCreateStepPreviewCheck(t *testing.T) {
+	t.Parallel()
+
+	newDeployment := func(t *testing.T, checks *int) (*Deployment, providers.Reference, *bytes.Buffer) {
+		deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+			CheckF: func(urn resource.URN,
+				olds, news resource.PropertyMap, randomSeed []byte,
+			) (resource.PropertyMap, []plugin.CheckFailure, error) {
+				*checks++
+				if _, ok := news["name"]; !ok {
+					return news, []plugin.CheckFailure{{Property: "name", Reason: "missing required property"}}, nil
+				}
+				return news, nil, nil
+			},
+			CreateF: func(urn resource.URN, inputs resource.PropertyMap, timeout float64,
+				preview bool,
+			) (resource.ID, resource.PropertyMap, resource.Status, error) {
+				return "", inputs, resource.StatusOK, nil
+			},
+		})
+		deployment.preview = true
+		var output bytes.Buffer
+		deployment.ctx.Diag = diag.DefaultSink(&output, &output, diag.FormatOptions{Color: colors.Never})
+		return deployment, ref, &output
+	}
+
+	t.Run("missing required input", func(t *testing.T) {
+		t.Parallel()
+
+		checks := 0
+		deployment, ref, output := newDeployment(t, &checks)
+
+		_, complete, err := NewCreateStep(deployment, doneEvent{}, newStepTestResource("res", ref)).Apply(true)
+		require.NoError(t, err)
+		assert.NotNil(t, complete)
+		assert.Equal(t, 1, checks)
+		assert.Contains(t, output.String(), "warning:")
+		assert.Contains(t, output.String(), "missing required property")
+	})
+
+	t.Run("valid inputs", func(t *testing.T) {
+		t.Parallel()
+
+		checks := 0
+		deployment, ref, output := newDeployment(t, &checks)
+
+		res := newStepTestResource("res", ref)
+		res.Inputs = resource.PropertyMap{"name": resource.NewStringProperty("res")}
+		_, _, err := NewCreateStep(deployment, doneEvent{}, res).Apply(true)
+		require.NoError(t, err)
+		assert.Equal(t, 1, checks)
+		assert.Empty(t, output.String())
+	})
+
+	t.Run("already checked", func(t *testing.T) {
+		t.Parallel()
+
+		checks := 0
+		deployment, ref, _ := newDeployment(t, &checks)
+
+		step := NewCreateStep(deployment, doneEvent{}, newStepTestResource("res", ref)).(*CreateStep)
+		step.inputsChecked = true
+		_, _, err := step.Apply(true)
+		require.NoError(t, err)
+		assert.Equal(t, 0, checks)
+	})
+}
+
+func TestCreateStepVerifyAfterCreate(t *testing.T) {
+	t.Parallel()
+
+	newDeployment := func(t *testing.T, reads *int, read func() (resource.PropertyMap, error)) (*Deployment,
+		providers.Reference,
+	) {
+		deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+			CreateF: func(urn resource.URN, inputs resource.PropertyMap, timeout float64,
+				preview bool,
+			) (resource.ID, resource.PropertyMap, resource.Status, error) {
+				return "created-id", resource.PropertyMap{"arn": resource.NewStringProperty("arn")}, resource.StatusOK, nil
+			},
+			ReadF: func(urn resource.URN, id resource.ID,
+				inputs, state resource.PropertyMap,
+			) (plugin.ReadResult, resource.Status, error) {
+				*reads++
+				outs, err := read()
+				return plugin.ReadResult{ID: id, Outputs: outs}, resource.StatusOK, err
+			},
+		})
+		deployment.VerifyAfterCreate = true
+		return deployment, ref
+	}
+
+	t.Run("verified", func(t *testing.T) {
+		t.Parallel()
+
+		reads := 0
+		deployment, ref := newDeployment(t, &reads, func() (resource.PropertyMap, error) {
+			return resource.PropertyMap{"status": resource.NewStringProperty("ready")}, nil
+		})
+
+		res := newStepTestResource("res", ref)
+		status, complete, err := NewCreateStep(deployment, doneEvent{}, res).Apply(false)
+		require.NoError(t, err)
+		assert.Equal(t, resource.StatusOK, status)
+		assert.NotNil(t, complete)
+		assert.Equal(t, 1, reads)
+		assert.Equal(t, resource.PropertyMap{
+			"arn":    resource.NewStringProperty("arn"),
+			"status": resource.NewStringProperty("ready"),
+		}, res.Outputs)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		reads := 0
+		deployment, ref := newDeployment(t, &reads, func() (resource.PropertyMap, error) { return nil, nil })
+
+		res := newStepTestResource("res", ref)
+		status, complete, err := NewCreateStep(deployment, doneEvent{}, res).Apply(false)
+		assert.ErrorContains(t, err, "resource created-id was created but could not be read back")
+		assert.Equal(t, resource.StatusPartialFailure, status)
+		assert.NotNil(t, complete)
+		assert.Equal(t, resource.ID("created-id"), res.ID)
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		t.Parallel()
+
+		reads := 0
+		deployment, ref := newDeployment(t, &reads, func() (resource.PropertyMap, error) {
+			return nil, errors.New("throttled")
+		})
+
+		_, _, err := NewCreateStep(deployment, doneEvent{}, newStepTestResource("res", ref)).Apply(false)
+		assert.ErrorContains(t, err, "verifying created resource: throttled")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		reads := 0
+		deployment, ref := newDeployment(t, &reads, func() (resource.PropertyMap, error) { return nil, nil })
+		deployment.VerifyAfterCreate = false
+
+		_, _, err := NewCreateStep(deployment, doneEvent{}, newStepTestResource("res", ref)).Apply(false)
+		require.NoError(t, err)
+		assert.Equal(t, 0, reads)
+	})
+
+	t.Run("preview", func(t *testing.T) {
+		t.Parallel()
+
+		reads := 0
+		deployment, ref := newDeployment(t, &reads, func() (resource.PropertyMap, error) { return nil, nil })
+
+		step := NewCreateStep(deployment, doneEvent{}, newStepTestResource("res", ref)).(*CreateStep)
+		step.inputsChecked = true
+		_, _, err := step.Apply(true)
+		require.NoError(t, err)
+		assert.Equal(t, 0, reads)
+	})
+}
+
+func TestSkippedCreateReason(t *testing.T) {
+	t.Parallel()
+
+	ref, err := providers.NewReference("urn:pulumi:teststack::pkg::pulumi:providers:pkgA::default", "provider-id")
+	require.NoError(t, err)
+
+	skipped := NewSkippedCreateStep(nil, doneEvent{}, newStepTestResource("res", ref), "not in --target set")
+	assert.True(t, skipped.(*SameStep).IsSkippedCreate())
+	assert.Equal(t, "not in --target set", skipped.(*SameStep).SkippedCreateReason())
+
+	old := newStepTestResource("res", ref)
+	old.ID = "id"
+	same := NewSameStep(nil, doneEvent{}, old, newStepTestResource("res", ref))
+	assert.False(t, same.(*SameStep).IsSkippedCreate())
+	assert.Equal(t, "", same.(*SameStep).SkippedCreateReason())
+
+	sg := newStepGenerator(&Deployment{}, Options{}, NewUrnTargets(nil), NewUrnTargets(nil))
+	assert.Equal(t, "not in --target set", sg.skippedCreateReason())
+	sg = newStepGenerator(&Deployment{}, Options{TargetDependents: true}, NewUrnTargets(nil), NewUrnTargets(nil))
+	assert.Equal(t, "not in --target set and does not depend on a targeted resource", sg.skippedCreateReason())
+}
+
+// outputsEvent is a RegisterResourceOutputsEvent that ignores its completion.
+type outputsEvent struct {
+	urn     resource.URN
+	outputs resource.PropertyMap
+}
+
+var _ RegisterResourceOutputsEvent = outputsEvent{}
+
+func (outputsEvent) event()                          {}
+func (e outputsEvent) URN() resource.URN             { return e.urn }
+func (e outputsEvent) Outputs() resource.PropertyMap { return e.outputs }
+func (outputsEvent) Done()                           {}
+
+func TestSameStepComponentOutputs(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		retained    resource.PropertyMap
+		childOutput string
+		registered  string
+		expected    resource.PropertyMap
+	}{
+		{
+			name:        "child output changed",
+			retained:    resource.PropertyMap{"foo": resource.NewStringProperty("bar")},
+			childOutput: "baz",
+			expected:    resource.PropertyMap{"foo": resource.NewStringProperty("baz")},
+		},
+		{
+			name: "child output changed with other outputs",
+			retained: resource.PropertyMap{
+				"foo":   resource.NewStringProperty("bar"),
+				"other": resource.NewStringProperty("bar"),
+			},
+			childOutput: "baz",
+			expected: resource.PropertyMap{
+				"foo":   resource.NewStringProperty("baz"),
+				"other": resource.NewStringProperty("bar"),
+			},
+		},
+		{
+			name:        "child output changed and outputs registered",
+			retained:    resource.PropertyMap{"foo": resource.NewStringProperty("bar")},
+			childOutput: "baz",
+			registered:  "new",
+			expected:    resource.PropertyMap{"foo": resource.NewStringProperty("new")},
+		},
+		{
+			name:        "child output unchanged",
+			retained:    resource.PropertyMap{"foo": resource.NewStringProperty("bar")},
+			childOutput: "bar",
+			expected:    resource.PropertyMap{"foo": resource.NewStringProperty("bar")},
+		},
+		{
+			name:        "no retained outputs",
+			childOutput: "baz",
+			expected:    nil,
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+				UpdateF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap,
+					timeout float64, ignoreChanges []string, preview bool,
+				) (resource.PropertyMap, resource.Status, error) {
+					return resource.PropertyMap{"foo": resource.NewStringProperty(c.childOutput)}, resource.StatusOK, nil
+				},
+			})
+			deployment.goals = &goalMap{}
+			se := &stepExecutor{deployment: deployment}
+
+			compType := tokens.Type("pkgA:m:comp")
+			compURN := resource.NewURN(tokens.QName("teststack"), tokens.PackageName("pkg"), "", compType, "comp")
+			component := func(outputs resource.PropertyMap) *resource.State {
+				return &resource.State{
+					Type:    compType,
+					URN:     compURN,
+					Inputs:  resource.PropertyMap{"value": resource.NewStringProperty("new")},
+					Outputs: outputs,
+				}
+			}
+			goal := &resource.Goal{
+				Type:       compType,
+				Name:       "comp",
+				Properties: resource.PropertyMap{"value": resource.NewStringProperty("new")},
+			}
+			old, newComp := component(c.retained), component(nil)
+			deployment.olds[compURN] = old
+			same := NewSameStep(deployment, &testRegEvent{goal: goal}, old, newComp)
+			require.NoError(t, se.executeStep(0, same))
+			assert.Equal(t, c.retained, newComp.Outputs)
+
+			oldChild := newStepTestResource("child", ref)
+			oldChild.ID = "child-id"
+			oldChild.Parent = compURN
+			oldChild.Outputs = resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+			newChild := newStepTestResource("child", ref)
+			newChild.Parent = compURN
+			update := NewUpdateStep(deployment, doneEvent{}, oldChild, newChild, nil, nil, nil, nil)
+			require.NoError(t, se.executeStep(0, update))
+
+			if c.registered != "" {
+				require.NoError(t, se.ExecuteRegisterResourceOutputs(outputsEvent{
+					urn:     compURN,
+					outputs: resource.PropertyMap{"foo": resource.NewStringProperty(c.registered)},
+				}))
+			}
+
+			assert.Equal(t, c.expected, newComp.Outputs)
+			// The component's old state is never modified.
+			assert.Equal(t, c.retained, old.Outputs)
+		})
+	}
+
+	t.Run("nested components", func(t *testing.T) {
+		t.Parallel()
+
+		deployment := &Deployment{}
+		outer := &resource.State{URN: "urn:pulumi:stack::proj::pkgA:m:comp::outer",
+			Outputs: resource.PropertyMap{"foo": resource.NewStringProperty("bar")}}
+		inner := &resource.State{URN: "urn:pulumi:stack::proj::pkgA:m:comp$pkgA:m:comp::inner", Parent: outer.URN,
+			Outputs: resource.PropertyMap{"foo": resource.NewStringProperty("bar")}}
+		deployment.retainComponentOutputs(&SameStep{old: outer, new: outer})
+		deployment.retainComponentOutputs(&SameStep{old: inner, new: inner})
+
+		child := &resource.State{Parent: inner.URN, Outputs: resource.PropertyMap{"foo": resource.NewStringProperty("bar")}}
+		deployment.childOutputsChanged(child, &resource.State{
+			Parent:  inner.URN,
+			Outputs: resource.PropertyMap{"foo": resource.NewStringProperty("baz")},
+		})
+		assert.Equal(t, "baz", inner.Outputs["foo"].StringValue())
+		assert.Equal(t, "baz", outer.Outputs["foo"].StringValue())
+	})
+}
+
+func TestStepTargeted(t *testing.T) {
+	t.Parallel()
+
+	ref, err := providers.NewReference("urn:pulumi:teststack::pkg::pulumi:providers:pkgA::default", "provider-id")
+	require.NoError(t, err)
+
+	newSteps := func(name string) []Step {
+		old := newStepTestResource(name, ref)
+		old.ID = "id"
+		return []Step{
+			NewSameStep(nil, doneEvent{}, old, newStepTestResource(name, ref)),
+			NewSkippedCreateStep(nil, doneEvent{}, newStepTestResource(name, ref), "not in --target set"),
+			NewCreateStep(nil, doneEvent{}, newStepTestResource(name, ref)),
+			NewUpdateStep(nil, doneEvent{}, old, newStepTestResource(name, ref), nil, nil, nil, nil),
+			NewDeleteStep(nil, map[resource.URN]bool{}, old),
+		}
+	}
+
+	targets := NewUrnTargets([]string{
+		"urn:pulumi:teststack::pkg::pkgA:m:typA::a",
+		"urn:pulumi:teststack::pkg::pkgA:m:typA::b*",
+	})
+	for _, name := range []string{"a", "b1", "c"} {
+		for _, step := range newSteps(name) {
+			// Steps are untargeted until the step generator marks them.
+			assert.False(t, step.(TargetedStep).Targeted(), "%v %v", step.Op(), name)
+
+			markTargeted(step, targets)
+			assert.Equal(t, name != "c", step.(TargetedStep).Targeted(), "%v %v", step.Op(), name)
+
+			// Without any targets, no resource is explicitly targeted.
+			markTargeted(step, NewUrnTargets(nil))
+			assert.False(t, step.(TargetedStep).Targeted(), "%v %v", step.Op(), name)
+		}
+	}
+}
+
+func TestNewUpdateStepCreateBeforeUpdate(t *testing.T) {
+	t.Parallel()
+
+	ref, err := providers.NewReference("urn:pulumi:teststack::pkg::pulumi:providers:pkgA::default", "provider-id")
+	require.NoError(t, err)
+
+	old := newStepTestResource("res", ref)
+	old.ID = "id"
+	old.CreateBeforeUpdate = true
+
+	new := newStepTestResource("res", ref)
+	new.CreateBeforeUpdate = true
+	assert.PanicsWithValue(t,
+		"fatal: A precondition has failed for new: must not be marked to be created before it is updated", func() {
+			NewUpdateStep(nil, doneEvent{}, old, new, nil, nil, nil, nil)
+		})
+
+	// The create+delete sequence that replaces the update is allowed, and the create reports its role in it.
+	var create Step
+	assert.NotPanics(t, func() {
+		create = NewCreateReplacementStep(nil, doneEvent{}, old, new, nil, nil, nil, true)
+	})
+	assert.True(t, create.(*CreateStep).CreateBeforeUpdate())
+	assert.False(t, NewCreateStep(nil, doneEvent{}, new).(*CreateStep).CreateBeforeUpdate())
+}
+
+func TestNewUpdateStepAlwaysReplace(t *testing.T) {
+	t.Parallel()
+
+	ref, err := providers.NewReference("urn:pulumi:teststack::pkg::pulumi:providers:pkgA::default", "provider-id")
+	require.NoError(t, err)
+
+	old := newStepTestResource("res", ref)
+	old.ID = "id"
+	old.AlwaysReplace = true
+
+	new := newStepTestResource("res", ref)
+	assert.NotPanics(t, func() {
+		NewUpdateStep(nil, doneEvent{}, old, new, nil, nil, nil, nil)
+	})
+
+	new.AlwaysReplace = true
+	const msg = "fatal: A precondition has failed for new: must not be marked to always be replaced"
+	assert.PanicsWithValue(t, msg, func() {
+		NewUpdateStep(nil, doneEvent{}, old, new, nil, nil, nil, nil)
+	})
+	assert.NotPanics(t, func() {
+		NewReplaceStep(nil, old, new, nil, nil, nil, true)
+	})
+}
+
+func TestDeleteStepVerifyAfterDelete(t *testing.T) {
+	t.Parallel()
+
+	newDeployment := func(t *testing.T, reads *int, read func(n int) resource.PropertyMap) (*Deployment,
+		providers.Reference,
+	) {
+		deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+			DeleteF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs resource.PropertyMap,
+				timeout float64,
+			) (resource.Status, error) {
+				return resource.StatusOK, nil
+			},
+			ReadF: func(urn resource.URN, id resource.ID,
+				inputs, state resource.PropertyMap,
+			) (plugin.ReadResult, resource.Status, error) {
+				*reads++
+				return plugin.ReadResult{ID: id, Outputs: read(*reads)}, resource.StatusOK, nil
+			},
+		})
+		deployment.VerifyAfterDelete = true
+		deployment.verifyDeleteInterval = time.Millisecond
+		return deployment, ref
+	}
+	exists := resource.PropertyMap{"status": resource.NewStringProperty("deleting")}
+
+	t.Run("gone after one read", func(t *testing.T) {
+		t.Parallel()
+
+		reads := 0
+		deployment, ref := newDeployment(t, &reads, func(n int) resource.PropertyMap {
+			if n == 1 {
+				return exists
+			}
+			return nil
+		})
+
+		res := newStepTestResource("res", ref)
+		res.ID = "existing-id"
+		status, complete, err := NewDeleteStep(deployment, map[resource.URN]bool{}, res).Apply(false)
+		require.NoError(t, err)
+		assert.Equal(t, resource.StatusOK, status)
+		assert.NotNil(t, complete)
+		assert.Equal(t, 2, reads)
+	})
+
+	t.Run("still exists", func(t *testing.T) {
+		t.Parallel()
+
+		reads := 0
+		deployment, ref := newDeployment(t, &reads, func(int) resource.PropertyMap { return exists })
+
+		res := newStepTestResource("res", ref)
+		res.ID = "existing-id"
+		res.CustomTimeouts.Delete = 0.05
+		_, complete, err := NewDeleteStep(deployment, map[resource.URN]bool{}, res).Apply(false)
+		assert.ErrorContains(t, err, "resource existing-id was deleted but could still be read after 50ms")
+		assert.Nil(t, complete)
+		assert.Greater(t, reads, 1)
+	})
+
+	t.Run("skipped", func(t *testing.T) {
+		t.Parallel()
+
+		cases := map[string]func(res *resource.State){
+			"external":         func(res *resource.State) { res.External = true },
+			"retain on delete": func(res *resource.State) { res.RetainOnDelete = true },
+		}
+		for name, mutate := range cases {
+			mutate := mutate
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				reads := 0
+				deployment, ref := newDeployment(t, &reads, func(int) resource.PropertyMap { return exists })
+
+				res := newStepTestResource("res", ref)
+				res.ID = "existing-id"
+				mutate(res)
+				_, _, err := NewDeleteStep(deployment, map[resource.URN]bool{}, res).Apply(false)
+				require.NoError(t, err)
+				assert.Equal(t, 0, reads)
+			})
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		reads := 0
+		deployment, ref := newDeployment(t, &reads, func(int) resource.PropertyMap { return exists })
+		deployment.VerifyAfterDelete = false
+
+		res := newStepTestResource("res", ref)
+		res.ID = "existing-id"
+		_, _, err := NewDeleteStep(deployment, map[resource.URN]bool{}, res).Apply(false)
+		require.NoError(t, err)
+		assert.Equal(t, 0, reads)
+	})
+}
+
+func TestReadResultsAreCopied(t *testing.T) {
+	t.Parallel()
+
+	// newDeployment returns a deployment whose provider returns the same property maps from every read, along with a
+	// function that mutates those maps as a provider that retained them might.
+	newDeployment := func(t *testing.T) (*Deployment, providers.Reference, func()) {
+		props := resource.PropertyMap{
+			"foo":    resource.NewStringProperty("bar"),
+			"nested": resource.NewObjectProperty(resource.PropertyMap{"baz": resource.NewStringProperty("qux")}),
+		}
+		deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+			ReadF: func(urn resource.URN, id resource.ID,
+				inputs, state resource.PropertyMap,
+			) (plugin.ReadResult, resource.Status, error) {
+				return plugin.ReadResult{ID: id, Inputs: props, Outputs: props}, resource.StatusOK, nil
+			},
+			DiffF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap,
+				ignoreChanges []string,
+			) (plugin.DiffResult, error) {
+				return plugin.DiffResult{Changes: plugin.DiffNone}, nil
+			},
+		})
+		mutate := func() {
+			props["foo"] = resource.NewStringProperty("mutated")
+			props["nested"].ObjectValue()["baz"] = resource.NewStringProperty("mutated")
+			props["added"] = resource.NewBoolProperty(true)
+		}
+		return deployment, ref, mutate
+	}
+
+	expected := resource.PropertyMap{
+		"foo":    resource.NewStringProperty("bar"),
+		"nested": resource.NewObjectProperty(resource.PropertyMap{"baz": resource.NewStringProperty("qux")}),
+	}
+
+	t.Run("read", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, ref, mutate := newDeployment(t)
+		res := newStepTestResource("res", ref)
+		res.ID = "existing-id"
+		res.External = true
+
+		_, _, err := NewReadStep(deployment, nil, nil, res).Apply(false)
+		require.NoError(t, err)
+		mutate()
+		assert.Equal(t, expected, res.Outputs)
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, ref, mutate := newDeployment(t)
+		res := newStepTestResource("res", ref)
+		res.ID = "existing-id"
+
+		step := NewRefreshStep(deployment, res, nil)
+		_, _, err := step.Apply(false)
+		require.NoError(t, err)
+		mutate()
+		assert.Equal(t, expected, step.New().Inputs)
+		assert.Equal(t, expected, step.New().Outputs)
+	})
+
+	t.Run("import", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, ref, mutate := newDeployment(t)
+		res := newStepTestResource("res", ref)
+		res.ID = "existing-id"
+
+		step := NewImportStep(deployment, doneEvent{}, res, nil, []byte{})
+		_, _, err := step.Apply(false)
+		require.NoError(t, err)
+		mutate()
+		assert.Equal(t, expected, step.Old().Inputs)
+		assert.Equal(t, expected, step.New().Outputs)
+	})
+}
+
+func TestFreezeTimestamps(t *testing.T) {
+	t.Parallel()
+
+	changed := resource.PropertyMap{"changed": resource.NewBoolProperty(true)}
+	stamp := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// Each case applies a step whose resource changes and returns the step's new state. Existing resources carry
+	// the fixed timestamp.
+	cases := map[string]func(t *testing.T, deployment *Deployment, ref providers.Reference) *resource.State{
+		"create": func(t *testing.T, deployment *Deployment, ref providers.Reference) *resource.State {
+			res := newStepTestResource("res", ref)
+			_, _, err := NewCreateStep(deployment, doneEvent{}, res).Apply(false)
+			require.NoError(t, err)
+			return res
+		},
+		"update": func(t *testing.T, deployment *Deployment, ref providers.Reference) *resource.State {
+			old := newStepTestResource("res", ref)
+			old.ID, old.Created, old.Modified = "existing-id", &stamp, &stamp
+			res := newStepTestResource("res", ref)
+			_, _, err := NewUpdateStep(deployment, doneEvent{}, old, res, nil, nil, nil, nil).Apply(false)
+			require.NoError(t, err)
+			return res
+		},
+		"read": func(t *testing.T, deployment *Deployment, ref providers.Reference) *resource.State {
+			old := newStepTestResource("res", ref)
+			old.ID, old.External, old.Created, old.Modified = "existing-id", true, &stamp, &stamp
+			res := newStepTestResource("res", ref)
+			res.ID, res.External = "existing-id", true
+			_, _, err := NewReadStep(deployment, nil, old, res).Apply(false)
+			require.NoError(t, err)
+			return res
+		},
+		"refresh": func(t *testing.T, deployment *Deployment, ref providers.Reference) *resource.State {
+			old := newStepTestResource("res", ref)
+			old.ID, old.Created, old.Modified = "existing-id", &stamp, &stamp
+			step := NewRefreshStep(deployment, old, nil)
+			_, _, err := step.Apply(false)
+			require.NoError(t, err)
+			return step.New()
+		},
+		"import": func(t *testing.T, deployment *Deployment, ref providers.Reference) *resource.State {
+			res := newStepTestResource("res", ref)
+			res.ID = "existing-id"
+			_, _, err := NewImportStep(deployment, doneEvent{}, res, nil, []byte{}).Apply(false)
+			require.NoError(t, err)
+			return res
+		},
+	}
+	for name, apply := range cases {
+		name, apply := name, apply
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, frozen := range []bool{true, false} {
+				deployment, ref := newStepTestDeployment(t, &deploytest.Provider{
+					CreateF: func(urn resource.URN, inputs resource.PropertyMap, timeout float64,
+						preview bool,
+					) (resource.ID, resource.PropertyMap, resource.Status, error) {
+						return "created-id", changed, resource.StatusOK, nil
+					},
+					UpdateF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap,
+						timeout float64, ignoreChanges []string, preview bool,
+					) (resource.PropertyMap, resource.Status, error) {
+						return changed, resource.StatusOK, nil
+					},
+					ReadF: func(urn resource.URN, id resource.ID,
+						inputs, state resource.PropertyMap,
+					) (plugin.ReadResult, resource.Status, error) {
+						return plugin.ReadResult{ID: id, Inputs: changed, Outputs: changed}, resource.StatusOK, nil
+					},
+					DiffF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap,
+						ignoreChanges []string,
+					) (plugin.DiffResult, error) {
+						return plugin.DiffResult{Changes: plugin.DiffNone}, nil
+					},
+				})
+				deployment.FreezeTimestamps = frozen
+
+				res := apply(t, deployment, ref)
+				if !frozen {
+					// Without the option, the step stamps the resource with the time of the change.
+					require.NotNil(t, res.Modified)
+					assert.NotEqual(t, stamp, *res.Modified)
+					continue
+				}
+				if name == "create" || name == "import" {
+					assert.Nil(t, res.Created)
+					assert.Nil(t, res.Modified)
+				} else {
+					assert.Equal(t, &stamp, res.Created)
+					assert.Equal(t, &stamp, res.Modified)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+)
+
+// targetState returns the target state override for the resource with the given URN, if any.
+func (d *Deployment) targetState(urn resource.URN) (*resource.State, bool) {
+	if d == nil || d.TargetStateOverride == nil {
+		return nil, false
+	}
+	target, ok := d.TargetStateOverride[urn]
+	return target, ok && target != nil
+}
+
+// diffTargetState computes the keys that differ and the detailed diff between the inputs of a target state and the
+// given new inputs. The diff is computed purely from state, without consulting the resource's provider.
+func diffTargetState(target *resource.State,
+	news resource.PropertyMap,
+) ([]resource.PropertyKey, map[string]plugin.PropertyDiff) {
+	diff := target.Inputs.Diff(news)
+	return diff.ChangedKeys(), plugin.NewDetailedDiffFromObjectDiff(diff, true)
+}
+
+// applyTargetStateOverride replaces the diff of a previewed update with a diff against the resource's target state
+// override, if it has one.
+func (s *UpdateStep) applyTargetStateOverride() {
+	if target, ok := s.deployment.targetState(s.URN()); ok {
+		s.diffs, s.detailedDiff = diffTargetState(target, s.new.Inputs)
+	}
+}
+
+// applyTargetStateOverride replaces the diff of a previewed create with a diff against the resource's target state
+// override, if it has one.
+func (s *CreateStep) applyTargetStateOverride() {
+	if target, ok := s.deployment.targetState(s.URN()); ok {
+		s.diffs, s.detailedDiff = diffTargetState(target, s.new.Inputs)
+	}
+}
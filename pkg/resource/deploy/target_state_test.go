@@ -0,0 +1,103 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+)
+
+func TestTargetStateOverride(t *testing.T) {
+	t.Parallel()
+
+	newStates := func(ref providers.Reference) (*resource.State, *resource.State) {
+		old, new := newStepTestResource("res", ref), newStepTestResource("res", ref)
+		old.ID = "id"
+		old.Inputs = resource.PropertyMap{"a": resource.NewStringProperty("1"), "b": resource.NewStringProperty("1")}
+		new.Inputs = resource.PropertyMap{"a": resource.NewStringProperty("2"), "b": resource.NewStringProperty("1")}
+		return old, new
+	}
+
+	t.Run("update", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, ref := newStepTestDeployment(t, &deploytest.Provider{})
+		old, new := newStates(ref)
+
+		// Without an override, the diff is the one computed against the old state.
+		normalDiffs, normalDetailed := diffTargetState(old, new.Inputs)
+		normal := NewUpdateStep(deployment, doneEvent{}, old, new, nil, normalDiffs, normalDetailed, nil).(*UpdateStep)
+		_, _, err := normal.Apply(true)
+		require.NoError(t, err)
+		assert.Equal(t, []resource.PropertyKey{"a"}, normal.Diffs())
+		assert.Equal(t, map[string]plugin.PropertyDiff{"a": {Kind: plugin.DiffUpdate, InputDiff: true}},
+			normal.DetailedDiff())
+
+		// With an override, the diff is computed against the target state instead.
+		target, _ := newStates(ref)
+		target.Inputs = resource.PropertyMap{"a": resource.NewStringProperty("2"), "b": resource.NewStringProperty("3")}
+		deployment.TargetStateOverride = map[resource.URN]*resource.State{old.URN: target}
+
+		_, new = newStates(ref)
+		overridden := NewUpdateStep(deployment, doneEvent{}, old, new, nil, normalDiffs, normalDetailed,
+			nil).(*UpdateStep)
+		_, _, err = overridden.Apply(true)
+		require.NoError(t, err)
+		assert.Equal(t, []resource.PropertyKey{"b"}, overridden.Diffs())
+		assert.Equal(t, map[string]plugin.PropertyDiff{"b": {Kind: plugin.DiffUpdate, InputDiff: true}},
+			overridden.DetailedDiff())
+
+		// The override is ignored outside of previews.
+		_, new = newStates(ref)
+		applied := NewUpdateStep(deployment, doneEvent{}, old, new, nil, normalDiffs, normalDetailed,
+			nil).(*UpdateStep)
+		_, _, err = applied.Apply(false)
+		require.NoError(t, err)
+		assert.Equal(t, normal.Diffs(), applied.Diffs())
+		assert.Equal(t, normal.DetailedDiff(), applied.DetailedDiff())
+	})
+
+	t.Run("create", func(t *testing.T) {
+		t.Parallel()
+
+		deployment, ref := newStepTestDeployment(t, &deploytest.Provider{})
+		_, new := newStates(ref)
+
+		normal := NewCreateStep(deployment, doneEvent{}, new).(*CreateStep)
+		_, _, err := normal.Apply(true)
+		require.NoError(t, err)
+		assert.Empty(t, normal.Diffs())
+		assert.Empty(t, normal.DetailedDiff())
+
+		_, target := newStates(ref)
+		target.Inputs = resource.PropertyMap{"a": resource.NewStringProperty("2")}
+		deployment.TargetStateOverride = map[resource.URN]*resource.State{new.URN: target}
+
+		_, new = newStates(ref)
+		overridden := NewCreateStep(deployment, doneEvent{}, new).(*CreateStep)
+		_, _, err = overridden.Apply(true)
+		require.NoError(t, err)
+		assert.Equal(t, []resource.PropertyKey{"b"}, overridden.Diffs())
+		assert.Equal(t, map[string]plugin.PropertyDiff{"b": {Kind: plugin.DiffAdd, InputDiff: true}},
+			overridden.DetailedDiff())
+	})
+}
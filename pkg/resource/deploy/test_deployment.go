@@ -0,0 +1,124 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/pkg/v3/util/testutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// testProviderID is the ID of the default providers registered by NewTestDeployment.
+const testProviderID = "test-provider"
+
+// NewTestDeployment returns a minimal deployment for unit testing steps against in-memory providers, without a
+// program, plugin host, or snapshot. A default provider is registered for each package in provs; resources refer to
+// it using the reference returned by TestProviderRef. Provider resources in olds are also registered, using the
+// provider for their package. The deployment's Diag() is a *testutil.TestDiagSink that captures its diagnostics.
+//
+// Steps applied against the deployment may use its providers, old resources, and diagnostics. Anything that
+// requires a full deployment, e.g. a source or a plan, is not available.
+func NewTestDeployment(provs map[tokens.Package]plugin.Provider,
+	olds map[resource.URN]*resource.State,
+) *Deployment {
+	if olds == nil {
+		olds = map[resource.URN]*resource.State{}
+	}
+
+	registry := providers.NewRegistry(&testProviderHost{providers: provs}, false, nil)
+	for pkg := range provs {
+		ref, err := providers.ParseReference(TestProviderRef(pkg))
+		contract.AssertNoErrorf(err, "parsing test provider reference")
+		err = registry.Same(&resource.State{
+			Type:    ref.URN().Type(),
+			URN:     ref.URN(),
+			Custom:  true,
+			ID:      ref.ID(),
+			Inputs:  resource.PropertyMap{},
+			Outputs: resource.PropertyMap{},
+		})
+		contract.AssertNoErrorf(err, "registering test provider for %v", pkg)
+	}
+	for _, old := range olds {
+		if providers.IsProviderType(old.Type) && !old.Delete {
+			err := registry.Same(old)
+			contract.AssertNoErrorf(err, "registering old provider %v", old.URN)
+		}
+	}
+
+	return &Deployment{
+		ctx:       &plugin.Context{Diag: testutil.NewTestDiagSink("")},
+		olds:      olds,
+		providers: registry,
+		news:      &resourceMap{},
+	}
+}
+
+// TestProviderRef returns the provider reference of the default provider that NewTestDeployment registers for the
+// given package.
+func TestProviderRef(pkg tokens.Package) string {
+	ty := providers.MakeProviderType(pkg)
+	urn := resource.NewURN(tokens.QName("test"), tokens.PackageName("test"), "", ty, "default")
+	ref, err := providers.NewReference(urn, testProviderID)
+	contract.AssertNoErrorf(err, "creating test provider reference")
+	return ref.String()
+}
+
+// testProviderHost is a plugin host that serves a fixed set of in-memory providers. Only the methods used by the
+// provider registry are implemented; calling any other method panics.
+//
+// The same provider serves every provider resource of its package, so it is only configured the first time it is
+// loaded. Later loads wrap it to ignore configuration, which hides any optional interfaces it implements.
+type testProviderHost struct {
+	plugin.Host
+
+	providers map[tokens.Package]plugin.Provider
+	loaded    map[tokens.Package]bool
+}
+
+func (h *testProviderHost) Provider(pkg tokens.Package, version *semver.Version) (plugin.Provider, error) {
+	prov, ok := h.providers[pkg]
+	if !ok {
+		return nil, fmt.Errorf("no test provider for package %v", pkg)
+	}
+	if h.loaded[pkg] {
+		return configuredProvider{prov}, nil
+	}
+	if h.loaded == nil {
+		h.loaded = map[tokens.Package]bool{}
+	}
+	h.loaded[pkg] = true
+	return prov, nil
+}
+
+func (h *testProviderHost) CloseProvider(provider plugin.Provider) error {
+	return nil
+}
+
+// configuredProvider is a provider that has already been configured. Further configuration is ignored.
+type configuredProvider struct {
+	plugin.Provider
+}
+
+func (configuredProvider) Configure(inputs resource.PropertyMap) error {
+	return nil
+}
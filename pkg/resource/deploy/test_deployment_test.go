@@ -0,0 +1,132 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/pkg/v3/util/testutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+func newTestDeploymentResource(name string, provider string) *resource.State {
+	ty := tokens.Type("pkgA:m:typA")
+	return &resource.State{
+		Type:     ty,
+		URN:      resource.NewURN(tokens.QName("test"), tokens.PackageName("test"), "", ty, name),
+		Custom:   true,
+		Provider: provider,
+		Inputs:   resource.PropertyMap{"foo": resource.NewStringProperty("bar")},
+		Outputs:  resource.PropertyMap{},
+	}
+}
+
+func TestNewTestDeployment(t *testing.T) {
+	t.Parallel()
+
+	t.Run("create", func(t *testing.T) {
+		t.Parallel()
+
+		deployment := NewTestDeployment(map[tokens.Package]plugin.Provider{
+			"pkgA": &deploytest.Provider{
+				CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+					preview bool,
+				) (resource.ID, resource.PropertyMap, resource.Status, error) {
+					return "created-id", resource.PropertyMap{"baz": news["foo"]}, resource.StatusOK, nil
+				},
+			},
+		}, nil)
+
+		res := newTestDeploymentResource("res", TestProviderRef("pkgA"))
+		status, complete, err := NewCreateStep(deployment, doneEvent{}, res).Apply(false)
+		require.NoError(t, err)
+		assert.Equal(t, resource.StatusOK, status)
+		assert.NotNil(t, complete)
+		assert.Equal(t, resource.ID("created-id"), res.ID)
+		assert.Equal(t, resource.PropertyMap{"baz": resource.NewStringProperty("bar")}, res.Outputs)
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		t.Parallel()
+
+		// The old resource uses an explicit provider, which is registered from the old state.
+		prov := newProviderResource("pkgA", "explicit", "explicit-id", resource.PropertyMap{})
+		ref, err := providers.NewReference(prov.URN, prov.ID)
+		require.NoError(t, err)
+
+		old := newTestDeploymentResource("res", ref.String())
+		old.ID = "old-id"
+		deployment := NewTestDeployment(map[tokens.Package]plugin.Provider{
+			"pkgA": &deploytest.Provider{
+				ReadF: func(urn resource.URN, id resource.ID,
+					inputs, state resource.PropertyMap,
+				) (plugin.ReadResult, resource.Status, error) {
+					assert.Equal(t, old.URN, urn)
+					assert.Equal(t, resource.ID("old-id"), id)
+					return plugin.ReadResult{
+						ID:      id,
+						Inputs:  inputs,
+						Outputs: resource.PropertyMap{"baz": resource.NewStringProperty("refreshed")},
+					}, resource.StatusOK, nil
+				},
+			},
+		}, map[resource.URN]*resource.State{prov.URN: prov, old.URN: old})
+		assert.Equal(t, old, deployment.Olds()[old.URN])
+
+		step := NewRefreshStep(deployment, old, nil)
+		_, _, err = step.Apply(false)
+		require.NoError(t, err)
+		assert.Equal(t, resource.ID("old-id"), step.New().ID)
+		assert.Equal(t, resource.PropertyMap{"baz": resource.NewStringProperty("refreshed")}, step.New().Outputs)
+	})
+
+	t.Run("diagnostics", func(t *testing.T) {
+		t.Parallel()
+
+		deployment := NewTestDeployment(map[tokens.Package]plugin.Provider{
+			"pkgA": &reissuingProvider{
+				progressProvider: &progressProvider{Provider: &deploytest.Provider{}},
+				id:               "new-id",
+			},
+		}, nil)
+
+		old := newTestDeploymentResource("res", TestProviderRef("pkgA"))
+		old.ID = "old-id"
+		new := newTestDeploymentResource("res", TestProviderRef("pkgA"))
+		_, _, err := NewUpdateStep(deployment, doneEvent{}, old, new, nil, nil, nil, nil).Apply(false)
+		require.NoError(t, err)
+
+		sink, ok := deployment.Diag().(*testutil.TestDiagSink)
+		require.True(t, ok)
+		require.Len(t, sink.WarningMsgs(), 1)
+		assert.Contains(t, sink.WarningMsgs()[0], "provider recreated the resource during update")
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		t.Parallel()
+
+		deployment := NewTestDeployment(nil, nil)
+		res := newTestDeploymentResource("res", TestProviderRef("pkgA"))
+		_, _, err := NewCreateStep(deployment, doneEvent{}, res).Apply(false)
+		assert.ErrorContains(t, err, "unknown provider")
+	})
+}
@@ -339,6 +339,9 @@ func SerializeResource(res *resource.State, enc config.Encrypter, showSecrets bo
 		Created:                 res.Created,
 		Modified:                res.Modified,
 		SourcePosition:          res.SourcePosition,
+		AlwaysReplace:           res.AlwaysReplace,
+		CreateBeforeUpdate:      res.CreateBeforeUpdate,
+		ReplacementCause:        res.ReplacementCause,
 	}
 
 	if res.CustomTimeouts.IsNotEmpty() {
@@ -521,11 +524,15 @@ func DeserializeResource(res apitype.ResourceV3, dec config.Decrypter, enc confi
 		return nil, fmt.Errorf("resource '%s' has 'custom' false but non-empty ID", res.URN)
 	}
 
-	return resource.NewState(
+	state := resource.NewState(
 		res.Type, res.URN, res.Custom, res.Delete, res.ID,
 		inputs, outputs, res.Parent, res.Protect, res.External, res.Dependencies, res.InitErrors, res.Provider,
 		res.PropertyDependencies, res.PendingReplacement, res.AdditionalSecretOutputs, res.Aliases, res.CustomTimeouts,
-		res.ImportID, res.RetainOnDelete, res.DeletedWith, res.Created, res.Modified, res.SourcePosition), nil
+		res.ImportID, res.RetainOnDelete, res.DeletedWith, res.Created, res.Modified, res.SourcePosition)
+	state.AlwaysReplace = res.AlwaysReplace
+	state.CreateBeforeUpdate = res.CreateBeforeUpdate
+	state.ReplacementCause = res.ReplacementCause
+	return state, nil
 }
 
 // DeserializeOperation hydrates a pending resource/operation pair.
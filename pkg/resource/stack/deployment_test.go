@@ -192,6 +192,30 @@ func TestDeploymentSerialization(t *testing.T) {
 	assert.Equal(t, 0, len(dep.Outputs["out-empty-map"].(map[string]interface{})))
 }
 
+func TestReplacementCauseSerialization(t *testing.T) {
+	t.Parallel()
+
+	res := &resource.State{
+		Type:             "Test",
+		URN:              resource.NewURN("test", "test", "", "Test", "resource-x"),
+		Custom:           true,
+		ID:               "test-resource-x",
+		ReplacementCause: "diff:foo",
+	}
+
+	dep, err := SerializeResource(res, config.NopEncrypter, false /* showSecrets */)
+	require.NoError(t, err)
+	b, err := json.Marshal(dep)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"replacementCause":"diff:foo"`)
+
+	var loaded apitype.ResourceV3
+	require.NoError(t, json.Unmarshal(b, &loaded))
+	state, err := DeserializeResource(loaded, config.NopDecrypter, config.NopEncrypter)
+	require.NoError(t, err)
+	assert.Equal(t, "diff:foo", state.ReplacementCause)
+}
+
 func TestLoadTooNewDeployment(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
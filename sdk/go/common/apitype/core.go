@@ -335,6 +335,12 @@ type ResourceV3 struct {
 	Modified *time.Time `json:"modified,omitempty" yaml:"modified,omitempty"`
 	// SourcePosition tracks the source location of this resource's registration
 	SourcePosition string `json:"sourcePosition,omitempty" yaml:"sourcePosition,omitempty"`
+	// If set to True, changes to this resource are always applied by replacing it rather than updating it in place.
+	AlwaysReplace bool `json:"alwaysReplace,omitempty" yaml:"alwaysReplace,omitempty"`
+	// If set to True, changes to this resource are applied by creating a new resource before the old one is removed.
+	CreateBeforeUpdate bool `json:"createBeforeUpdate,omitempty" yaml:"createBeforeUpdate,omitempty"`
+	// If set, the reason that the most recent deployment replaced this resource.
+	ReplacementCause string `json:"replacementCause,omitempty" yaml:"replacementCause,omitempty"`
 }
 
 // ManifestV1 captures meta-information about this checkpoint file, such as versions of binaries, etc.
@@ -93,6 +93,8 @@ const (
 	OpImport OpType = "import"
 	// OpImportReplacement indicates replacement of an existing resource with an imported resource.
 	OpImportReplacement OpType = "import-replacement"
+	// OpAdopt indicates taking ownership of an external resource without replacing it.
+	OpAdopt OpType = "adopt"
 )
 
 // UpdateInfo describes a previous update.
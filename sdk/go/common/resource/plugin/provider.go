@@ -71,7 +71,7 @@ type Provider interface {
 	// Update updates an existing resource with new values.
 	Update(urn resource.URN, id resource.ID,
 		oldInputs, oldOutputs, newInputs resource.PropertyMap, timeout float64,
-		ignoreChanges []string, preview bool) (resource.PropertyMap, resource.Status, error)
+		ignoreChanges []string, preview bool) (UpdateResult, resource.Status, error)
 	// Delete tears down an existing resource. The inputs and outputs are the last recorded ones from state.
 	Delete(urn resource.URN, id resource.ID,
 		inputs, outputs resource.PropertyMap, timeout float64) (resource.Status, error)
@@ -112,6 +112,47 @@ type Provider interface {
 	GetMappings(key string) ([]string, error)
 }
 
+// CapabilitiesProvider is an optional interface that a Provider may implement to report the optional engine
+// capabilities that it supports. Providers that do not implement it are assumed to support none of them.
+type CapabilitiesProvider interface {
+	// Capabilities returns the optional engine capabilities that the provider supports, e.g. "batchDelete". A
+	// provider reports its capabilities when it is configured, so this must not be called before Configure.
+	Capabilities() ([]string, error)
+}
+
+// CompositeIDProvider is an optional interface that a Provider may implement to support importing resources by
+// composite ID. Providers that do not implement it cannot import any resources by composite ID.
+type CompositeIDProvider interface {
+	// CompositeIDSeparators returns the separator that the provider uses to join the parts of a composite ID, keyed by
+	// the resource types that can be imported by composite ID. Like Capabilities, this must not be called before
+	// Configure.
+	CompositeIDSeparators() (map[tokens.Type]string, error)
+}
+
+// TaggingProvider is an optional interface that a Provider may implement to declare where the tags of its resources
+// are held. The engine does not merge automatic tags into the inputs of resources whose providers do not implement it.
+type TaggingProvider interface {
+	// TagPropertyPaths returns the path of the input property that holds the tags of resources as a map of strings,
+	// keyed by the resource types that can be tagged. Like Capabilities, this must not be called before Configure.
+	TagPropertyPaths() (map[tokens.Type]resource.PropertyPath, error)
+}
+
+// HealthCheckProvider is an optional interface that a Provider may implement to report whether it can currently service
+// requests. Providers that do not implement it are assumed to be healthy.
+type HealthCheckProvider interface {
+	// Health returns an error if the provider cannot currently service requests, e.g. because the service it manages
+	// resources in is unreachable.
+	Health() error
+}
+
+// AnnotatingProvider is an optional interface that a Provider may implement to attach annotations, such as tags or
+// labels, to an existing resource without updating it. The engine only calls Annotate on providers that report the
+// "annotate" capability.
+type AnnotatingProvider interface {
+	// Annotate attaches the given annotations to the resource with the given URN and ID.
+	Annotate(urn resource.URN, id resource.ID, annotations map[string]string) error
+}
+
 type GrpcProvider interface {
 	Provider
 
@@ -234,6 +275,9 @@ type DiffResult struct {
 	ChangedKeys         []resource.PropertyKey  // an optional list of keys that changed.
 	DetailedDiff        map[string]PropertyDiff // an optional structured diff
 	DeleteBeforeReplace bool                    // if true, this resource must be deleted before recreating it.
+	// ReplaceReasons optionally explains why changes to each of the replacement keys require the resource to be
+	// replaced, e.g. "name is immutable".
+	ReplaceReasons map[resource.PropertyKey]string
 }
 
 // NewDetailedDiffFromObjectDiff computes the detailed diff of Updated, Added and Deleted keys.
@@ -343,6 +387,16 @@ type ReadResult struct {
 	Outputs resource.PropertyMap
 }
 
+// UpdateResult is the result of a call to Update.
+type UpdateResult struct {
+	// ID is the resource's new ID if the provider had to recreate the resource in order to update it, e.g. because the
+	// service it manages the resource in cannot change one of the resource's properties in place. If this field is
+	// empty, the resource's ID did not change.
+	ID resource.ID
+	// Outputs contains the new outputs/state for the resource.
+	Outputs resource.PropertyMap
+}
+
 // ConstructInfo contains all of the information required to register resources as part of a call to Construct.
 type ConstructInfo struct {
 	Project          string                // the project name housing the program being run.
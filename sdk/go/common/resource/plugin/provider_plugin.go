@@ -82,6 +82,10 @@ type pluginConfig struct {
 	acceptResources bool // true if this plugin accepts strongly-typed resource refs.
 	acceptOutputs   bool // true if this plugin accepts output values.
 	supportsPreview bool // true if this plugin supports previews for Create and Update.
+
+	capabilities          []string                              // the optional engine capabilities this plugin supports.
+	compositeIDSeparators map[tokens.Type]string                // the composite ID separator of each supported type.
+	tagPropertyPaths      map[tokens.Type]resource.PropertyPath // the tag property path of each taggable type.
 }
 
 // NewProvider attempts to bind to a given package's resource plugin and then creates a gRPC connection to it.  If the
@@ -716,18 +720,78 @@ func (p *provider) Configure(inputs resource.PropertyMap) error {
 			return
 		}
 
+		var separators map[tokens.Type]string
+		if seps := resp.GetCompositeIdSeparators(); len(seps) > 0 {
+			separators = make(map[tokens.Type]string, len(seps))
+			for typ, sep := range seps {
+				separators[tokens.Type(typ)] = sep
+			}
+		}
+
+		var tagPaths map[tokens.Type]resource.PropertyPath
+		if paths := resp.GetTagPropertyPaths(); len(paths) > 0 {
+			tagPaths = make(map[tokens.Type]resource.PropertyPath, len(paths))
+			for typ, path := range paths {
+				parsed, err := resource.ParsePropertyPath(path)
+				if err != nil {
+					err = fmt.Errorf("parsing the tag property path for resource type '%v': %w", typ, err)
+					p.configSource.MustReject(err)
+					return
+				}
+				tagPaths[tokens.Type(typ)] = parsed
+			}
+		}
+
 		p.configSource.MustFulfill(pluginConfig{
-			known:           true,
-			acceptSecrets:   resp.GetAcceptSecrets(),
-			acceptResources: resp.GetAcceptResources(),
-			supportsPreview: resp.GetSupportsPreview(),
-			acceptOutputs:   resp.GetAcceptOutputs(),
+			known:                 true,
+			acceptSecrets:         resp.GetAcceptSecrets(),
+			acceptResources:       resp.GetAcceptResources(),
+			supportsPreview:       resp.GetSupportsPreview(),
+			acceptOutputs:         resp.GetAcceptOutputs(),
+			capabilities:          resp.GetCapabilities(),
+			compositeIDSeparators: separators,
+			tagPropertyPaths:      tagPaths,
 		})
 	}()
 
 	return nil
 }
 
+var (
+	_ CapabilitiesProvider = (*provider)(nil)
+	_ CompositeIDProvider  = (*provider)(nil)
+	_ TaggingProvider      = (*provider)(nil)
+	_ HealthCheckProvider  = (*provider)(nil)
+	_ AnnotatingProvider   = (*provider)(nil)
+)
+
+// Capabilities returns the optional engine capabilities that the provider reported when it was configured.
+func (p *provider) Capabilities() ([]string, error) {
+	pcfg, err := p.configSource.Promise().Result(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return pcfg.capabilities, nil
+}
+
+// CompositeIDSeparators returns the composite ID separators that the provider reported when it was configured.
+func (p *provider) CompositeIDSeparators() (map[tokens.Type]string, error) {
+	pcfg, err := p.configSource.Promise().Result(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return pcfg.compositeIDSeparators, nil
+}
+
+// TagPropertyPaths returns the tag property paths that the provider reported when it was configured.
+func (p *provider) TagPropertyPaths() (map[tokens.Type]resource.PropertyPath, error) {
+	pcfg, err := p.configSource.Promise().Result(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return pcfg.tagPropertyPaths, nil
+}
+
 // Check validates that the given property bag is valid for a resource of the given type.
 func (p *provider) Check(urn resource.URN,
 	olds, news resource.PropertyMap,
@@ -1155,7 +1219,7 @@ func (p *provider) Read(urn resource.URN, id resource.ID,
 func (p *provider) Update(urn resource.URN, id resource.ID,
 	oldInputs, oldOutputs, newInputs resource.PropertyMap, timeout float64,
 	ignoreChanges []string, preview bool,
-) (resource.PropertyMap, resource.Status, error) {
+) (UpdateResult, resource.Status, error) {
 	contract.Assertf(urn != "", "Update requires a URN")
 	contract.Assertf(id != "", "Update requires an ID")
 	contract.Assertf(oldInputs != nil, "Update requires old inputs")
@@ -1170,7 +1234,7 @@ func (p *provider) Update(urn resource.URN, id resource.ID,
 	client := p.clientRaw
 	pcfg, err := p.configSource.Promise().Result(context.Background())
 	if err != nil {
-		return newInputs, resource.StatusOK, err
+		return UpdateResult{Outputs: newInputs}, resource.StatusOK, err
 	}
 
 	// If this is a preview and the plugin does not support provider previews, or if the configuration for the provider
@@ -1187,12 +1251,12 @@ func (p *provider) Update(urn resource.URN, id resource.ID,
 		// by extending the provider gRPC interface with a `SupportsFeature` API similar to the language monitor.
 		if !pcfg.known {
 			if p.legacyPreview {
-				return newInputs, resource.StatusOK, nil
+				return UpdateResult{Outputs: newInputs}, resource.StatusOK, nil
 			}
-			return resource.PropertyMap{}, resource.StatusOK, nil
+			return UpdateResult{Outputs: resource.PropertyMap{}}, resource.StatusOK, nil
 		}
 		if !pcfg.supportsPreview || p.disableProviderPreview {
-			return newInputs, resource.StatusOK, nil
+			return UpdateResult{Outputs: newInputs}, resource.StatusOK, nil
 		}
 	}
 
@@ -1206,7 +1270,7 @@ func (p *provider) Update(urn resource.URN, id resource.ID,
 		KeepResources:      pcfg.acceptResources,
 	})
 	if err != nil {
-		return nil, resource.StatusOK, err
+		return UpdateResult{}, resource.StatusOK, err
 	}
 	mOldOutputs, err := MarshalProperties(oldOutputs, MarshalOptions{
 		Label:              fmt.Sprintf("%s.oldOutputs", label),
@@ -1215,7 +1279,7 @@ func (p *provider) Update(urn resource.URN, id resource.ID,
 		KeepResources:      pcfg.acceptResources,
 	})
 	if err != nil {
-		return nil, resource.StatusOK, err
+		return UpdateResult{}, resource.StatusOK, err
 	}
 	mNewInputs, err := MarshalProperties(newInputs, MarshalOptions{
 		Label:         fmt.Sprintf("%s.newInputs", label),
@@ -1224,10 +1288,11 @@ func (p *provider) Update(urn resource.URN, id resource.ID,
 		KeepResources: pcfg.acceptResources,
 	})
 	if err != nil {
-		return nil, resource.StatusOK, err
+		return UpdateResult{}, resource.StatusOK, err
 	}
 
 	var liveObject *_struct.Struct
+	var newID resource.ID
 	var resourceError error
 	resourceStatus := resource.StatusOK
 	resp, err := client.Update(p.requestContext(), &pulumirpc.UpdateRequest{
@@ -1245,11 +1310,12 @@ func (p *provider) Update(urn resource.URN, id resource.ID,
 		logging.V(7).Infof("%s failed: %v", label, resourceError)
 
 		if resourceStatus != resource.StatusPartialFailure {
-			return nil, resourceStatus, resourceError
+			return UpdateResult{}, resourceStatus, resourceError
 		}
 		// Else it's a `StatusPartialFailure`.
 	} else {
 		liveObject = resp.GetProperties()
+		newID = resource.ID(resp.GetId())
 	}
 
 	outs, err := UnmarshalProperties(liveObject, MarshalOptions{
@@ -1260,7 +1326,7 @@ func (p *provider) Update(urn resource.URN, id resource.ID,
 		KeepResources:  true,
 	})
 	if err != nil {
-		return nil, resourceStatus, err
+		return UpdateResult{}, resourceStatus, err
 	}
 
 	// If we could not pass secrets to the provider, retain the secret bit on any property with the same name. This
@@ -1270,10 +1336,11 @@ func (p *provider) Update(urn resource.URN, id resource.ID,
 		annotateSecrets(outs, newInputs)
 	}
 	logging.V(7).Infof("%s success; #outs=%d", label, len(outs))
+	result := UpdateResult{ID: newID, Outputs: outs}
 	if resourceError == nil {
-		return outs, resourceStatus, nil
+		return result, resourceStatus, nil
 	}
-	return outs, resourceStatus, resourceError
+	return result, resourceStatus, resourceError
 }
 
 // Delete tears down an existing resource.
@@ -1841,6 +1908,13 @@ func resourceStateAndError(err error) (resource.Status, *rpcerror.Error) {
 	return resource.StatusOK, rpcError
 }
 
+// IsNotFoundError returns true if the given error was returned by a provider to indicate that the resource an
+// operation targeted does not exist.
+func IsNotFoundError(err error) bool {
+	rpcError, ok := rpcerror.FromError(err)
+	return ok && rpcError != nil && rpcError.Code() == codes.NotFound
+}
+
 // parseError parses a gRPC error into a set of values that represent the state of a resource. They
 // are: (1) the `resourceStatus`, indicating the last known state (e.g., `StatusOK`, representing
 // success, `StatusUnknown`, representing internal failure); (2) the `*rpcerror.Error`, our internal
@@ -1967,3 +2041,44 @@ func (p *provider) GetMappings(key string) ([]string, error) {
 	}
 	return resp.Providers, nil
 }
+
+// Health checks whether the provider can currently service requests.
+func (p *provider) Health() error {
+	label := fmt.Sprintf("%s.Health", p.label())
+	logging.V(7).Infof("%s executing", label)
+
+	_, err := p.clientRaw.Health(p.requestContext(), &pbempty.Empty{})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		if rpcError.Code() == codes.Unimplemented {
+			// For backwards compatibility, assume that providers without health checks are healthy.
+			logging.V(7).Infof("%s unimplemented", label)
+			return nil
+		}
+		logging.V(7).Infof("%s failed: %v", label, rpcError)
+		return rpcError
+	}
+
+	logging.V(7).Infof("%s success", label)
+	return nil
+}
+
+// Annotate attaches annotations to an existing resource without updating it.
+func (p *provider) Annotate(urn resource.URN, id resource.ID, annotations map[string]string) error {
+	label := fmt.Sprintf("%s.Annotate(%s)", p.label(), urn)
+	logging.V(7).Infof("%s executing (#annotations=%d)", label, len(annotations))
+
+	_, err := p.clientRaw.Annotate(p.requestContext(), &pulumirpc.AnnotateRequest{
+		Id:          string(id),
+		Urn:         string(urn),
+		Annotations: annotations,
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		logging.V(7).Infof("%s failed: %v", label, rpcError)
+		return rpcError
+	}
+
+	logging.V(7).Infof("%s success", label)
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/testing/diagtest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
 )
 
@@ -610,6 +611,9 @@ type stubClient struct {
 	ConstructF  func(*pulumirpc.ConstructRequest) (*pulumirpc.ConstructResponse, error)
 	ConfigureF  func(*pulumirpc.ConfigureRequest) (*pulumirpc.ConfigureResponse, error)
 	DeleteF     func(*pulumirpc.DeleteRequest) error
+	UpdateF     func(*pulumirpc.UpdateRequest) (*pulumirpc.UpdateResponse, error)
+	HealthF     func() error
+	AnnotateF   func(*pulumirpc.AnnotateRequest) error
 }
 
 func (c *stubClient) DiffConfig(
@@ -657,6 +661,177 @@ func (c *stubClient) Delete(
 	return c.ResourceProviderClient.Delete(ctx, req, opts...)
 }
 
+func (c *stubClient) Update(
+	ctx context.Context,
+	req *pulumirpc.UpdateRequest,
+	opts ...grpc.CallOption,
+) (*pulumirpc.UpdateResponse, error) {
+	if f := c.UpdateF; f != nil {
+		return f(req)
+	}
+	return c.ResourceProviderClient.Update(ctx, req, opts...)
+}
+
+func (c *stubClient) Health(
+	ctx context.Context,
+	req *emptypb.Empty,
+	opts ...grpc.CallOption,
+) (*emptypb.Empty, error) {
+	if f := c.HealthF; f != nil {
+		err := f()
+		return &emptypb.Empty{}, err
+	}
+	return c.ResourceProviderClient.Health(ctx, req, opts...)
+}
+
+func (c *stubClient) Annotate(
+	ctx context.Context,
+	req *pulumirpc.AnnotateRequest,
+	opts ...grpc.CallOption,
+) (*emptypb.Empty, error) {
+	if f := c.AnnotateF; f != nil {
+		err := f(req)
+		return &emptypb.Empty{}, err
+	}
+	return c.ResourceProviderClient.Annotate(ctx, req, opts...)
+}
+
+// Validate that Health reports the provider's errors, and that providers that do not implement health checks are
+// assumed to be healthy.
+func TestProvider_Health(t *testing.T) {
+	t.Parallel()
+
+	unreachable := status.Error(codes.Unavailable, "service unreachable")
+	client := &stubClient{
+		HealthF: func() error { return unreachable },
+	}
+	p := NewProviderWithClient(newTestContext(t), "foo", client, false /* disablePreview */)
+	assert.ErrorContains(t, p.(HealthCheckProvider).Health(), "service unreachable")
+
+	client.HealthF = func() error { return status.Error(codes.Unimplemented, "Health is not yet implemented") }
+	assert.NoError(t, p.(HealthCheckProvider).Health())
+}
+
+// Validate that Annotate sends the resource's identity and annotations to the provider and reports its errors.
+func TestProvider_Annotate(t *testing.T) {
+	t.Parallel()
+
+	var got *pulumirpc.AnnotateRequest
+	client := &stubClient{
+		AnnotateF: func(req *pulumirpc.AnnotateRequest) error {
+			got = req
+			return nil
+		},
+	}
+	p := NewProviderWithClient(newTestContext(t), "foo", client, false /* disablePreview */)
+	urn := resource.URN("urn:pulumi:stack::project::pkgA:m:typA::res")
+	err := p.(AnnotatingProvider).Annotate(urn, "res-id", map[string]string{"owner": "team"})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "res-id", got.GetId())
+	assert.Equal(t, string(urn), got.GetUrn())
+	assert.Equal(t, map[string]string{"owner": "team"}, got.GetAnnotations())
+
+	client.AnnotateF = func(*pulumirpc.AnnotateRequest) error {
+		return status.Error(codes.Unimplemented, "Annotate is not yet implemented")
+	}
+	err = p.(AnnotatingProvider).Annotate(urn, "res-id", map[string]string{"owner": "team"})
+	assert.ErrorContains(t, err, "Annotate is not yet implemented")
+}
+
+// Validate that Capabilities returns the capabilities that the provider reported when it was configured.
+func TestProvider_Capabilities(t *testing.T) {
+	t.Parallel()
+
+	client := &stubClient{
+		ConfigureF: func(req *pulumirpc.ConfigureRequest) (*pulumirpc.ConfigureResponse, error) {
+			return &pulumirpc.ConfigureResponse{Capabilities: []string{"batchDelete", "preDelete"}}, nil
+		},
+	}
+
+	p := NewProviderWithClient(newTestContext(t), "foo", client, false /* disablePreview */)
+	require.NoError(t, p.Configure(resource.PropertyMap{}))
+
+	caps, err := p.(CapabilitiesProvider).Capabilities()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"batchDelete", "preDelete"}, caps)
+}
+
+// Validate that CompositeIDSeparators returns the separators that the provider reported when it was configured.
+func TestProvider_CompositeIDSeparators(t *testing.T) {
+	t.Parallel()
+
+	client := &stubClient{
+		ConfigureF: func(req *pulumirpc.ConfigureRequest) (*pulumirpc.ConfigureResponse, error) {
+			return &pulumirpc.ConfigureResponse{
+				CompositeIdSeparators: map[string]string{"pkg:m:slash": "/", "pkg:m:empty": ""},
+			}, nil
+		},
+	}
+
+	p := NewProviderWithClient(newTestContext(t), "foo", client, false /* disablePreview */)
+	require.NoError(t, p.Configure(resource.PropertyMap{}))
+
+	separators, err := p.(CompositeIDProvider).CompositeIDSeparators()
+	require.NoError(t, err)
+	assert.Equal(t, map[tokens.Type]string{"pkg:m:slash": "/", "pkg:m:empty": ""}, separators)
+}
+
+// Validate that TagPropertyPaths returns the paths that the provider reported when it was configured, and that a
+// provider reporting an invalid path fails to configure.
+func TestProvider_TagPropertyPaths(t *testing.T) {
+	t.Parallel()
+
+	paths := map[string]string{"pkg:m:tags": "tags", "pkg:m:labels": "metadata.labels"}
+	client := &stubClient{
+		ConfigureF: func(req *pulumirpc.ConfigureRequest) (*pulumirpc.ConfigureResponse, error) {
+			return &pulumirpc.ConfigureResponse{TagPropertyPaths: paths}, nil
+		},
+	}
+
+	p := NewProviderWithClient(newTestContext(t), "foo", client, false /* disablePreview */)
+	require.NoError(t, p.Configure(resource.PropertyMap{}))
+
+	tagPaths, err := p.(TaggingProvider).TagPropertyPaths()
+	require.NoError(t, err)
+	assert.Equal(t, map[tokens.Type]resource.PropertyPath{
+		"pkg:m:tags":   {"tags"},
+		"pkg:m:labels": {"metadata", "labels"},
+	}, tagPaths)
+
+	paths = map[string]string{"pkg:m:bad": "tags["}
+	p = NewProviderWithClient(newTestContext(t), "foo", client, false /* disablePreview */)
+	require.NoError(t, p.Configure(resource.PropertyMap{}))
+	_, err = p.(TaggingProvider).TagPropertyPaths()
+	assert.ErrorContains(t, err, "parsing the tag property path for resource type 'pkg:m:bad'")
+}
+
+// Validate that the ID a provider reissues during an update is returned from Update.
+func TestProvider_UpdateReissuedID(t *testing.T) {
+	t.Parallel()
+
+	client := &stubClient{
+		ConfigureF: func(req *pulumirpc.ConfigureRequest) (*pulumirpc.ConfigureResponse, error) {
+			return &pulumirpc.ConfigureResponse{}, nil
+		},
+		UpdateF: func(req *pulumirpc.UpdateRequest) (*pulumirpc.UpdateResponse, error) {
+			assert.Equal(t, "old-id", req.GetId())
+			return &pulumirpc.UpdateResponse{Properties: req.GetNews(), Id: "new-id"}, nil
+		},
+	}
+
+	p := NewProviderWithClient(newTestContext(t), "foo", client, false /* disablePreview */)
+	require.NoError(t, p.Configure(resource.PropertyMap{}))
+
+	props := resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+	result, _, err := p.Update(
+		resource.NewURN("org/proj/dev", "foo", "", "bar:baz", "qux"),
+		"old-id", props, props, props, 1000, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, resource.ID("new-id"), result.ID)
+	assert.Equal(t, props, result.Outputs)
+}
+
 // Test for https://github.com/pulumi/pulumi/issues/14529, ensure a kubernetes DiffConfig error is ignored
 func TestKubernetesDiffError(t *testing.T) {
 	t.Parallel()
@@ -697,3 +872,12 @@ func TestKubernetesDiffError(t *testing.T) {
 		false, nil)
 	assert.Error(t, err)
 }
+
+func TestIsNotFoundError(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsNotFoundError(status.Error(codes.NotFound, "no such resource")))
+	assert.False(t, IsNotFoundError(status.Error(codes.Unavailable, "try again")))
+	assert.False(t, IsNotFoundError(fmt.Errorf("not found")))
+	assert.False(t, IsNotFoundError(nil))
+}
@@ -255,9 +255,53 @@ func (p *providerServer) Configure(ctx context.Context,
 		return nil, err
 	}
 
+	// Providers that do not report their capabilities, composite ID separators, or tag property paths are assumed to
+	// support none of them.
+	var capabilities []string
+	if cp, ok := p.provider.(CapabilitiesProvider); ok {
+		caps, err := cp.Capabilities()
+		if err != nil {
+			return nil, err
+		}
+		capabilities = caps
+	}
+	var compositeIDSeparators map[string]string
+	if cp, ok := p.provider.(CompositeIDProvider); ok {
+		separators, err := cp.CompositeIDSeparators()
+		if err != nil {
+			return nil, err
+		}
+		if len(separators) > 0 {
+			compositeIDSeparators = make(map[string]string, len(separators))
+			for typ, sep := range separators {
+				compositeIDSeparators[string(typ)] = sep
+			}
+		}
+	}
+	var tagPropertyPaths map[string]string
+	if tp, ok := p.provider.(TaggingProvider); ok {
+		paths, err := tp.TagPropertyPaths()
+		if err != nil {
+			return nil, err
+		}
+		if len(paths) > 0 {
+			tagPropertyPaths = make(map[string]string, len(paths))
+			for typ, path := range paths {
+				tagPropertyPaths[string(typ)] = path.String()
+			}
+		}
+	}
+
 	p.keepSecrets = req.GetAcceptSecrets()
 	p.keepResources = req.GetAcceptResources()
-	return &pulumirpc.ConfigureResponse{AcceptSecrets: true, SupportsPreview: true, AcceptResources: true}, nil
+	return &pulumirpc.ConfigureResponse{
+		AcceptSecrets:         true,
+		SupportsPreview:       true,
+		AcceptResources:       true,
+		Capabilities:          capabilities,
+		CompositeIdSeparators: compositeIDSeparators,
+		TagPropertyPaths:      tagPropertyPaths,
+	}, nil
 }
 
 func (p *providerServer) Check(ctx context.Context, req *pulumirpc.CheckRequest) (*pulumirpc.CheckResponse, error) {
@@ -393,19 +437,19 @@ func (p *providerServer) Update(ctx context.Context, req *pulumirpc.UpdateReques
 		return nil, err
 	}
 
-	newState, _, err := p.provider.Update(
+	result, _, err := p.provider.Update(
 		urn, id, oldOutputs, oldInputs, newInputs,
 		req.GetTimeout(), req.GetIgnoreChanges(), req.GetPreview())
 	if err != nil {
 		return nil, err
 	}
 
-	rpcState, err := MarshalProperties(newState, p.marshalOptions("newState"))
+	rpcState, err := MarshalProperties(result.Outputs, p.marshalOptions("newState"))
 	if err != nil {
 		return nil, err
 	}
 
-	return &pulumirpc.UpdateResponse{Properties: rpcState}, nil
+	return &pulumirpc.UpdateResponse{Properties: rpcState, Id: string(result.ID)}, nil
 }
 
 func (p *providerServer) Delete(ctx context.Context, req *pulumirpc.DeleteRequest) (*pbempty.Empty, error) {
@@ -664,3 +708,24 @@ func (p *providerServer) GetMappings(ctx context.Context,
 	}
 	return &pulumirpc.GetMappingsResponse{Providers: providers}, nil
 }
+
+func (p *providerServer) Health(ctx context.Context, req *pbempty.Empty) (*pbempty.Empty, error) {
+	// Providers that do not implement health checks are assumed to be healthy.
+	if hp, ok := p.provider.(HealthCheckProvider); ok {
+		if err := hp.Health(); err != nil {
+			return nil, err
+		}
+	}
+	return &pbempty.Empty{}, nil
+}
+
+func (p *providerServer) Annotate(ctx context.Context, req *pulumirpc.AnnotateRequest) (*pbempty.Empty, error) {
+	ap, ok := p.provider.(AnnotatingProvider)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "Annotate is not yet implemented")
+	}
+	if err := ap.Annotate(resource.URN(req.GetUrn()), resource.ID(req.GetId()), req.GetAnnotations()); err != nil {
+		return nil, err
+	}
+	return &pbempty.Empty{}, nil
+}
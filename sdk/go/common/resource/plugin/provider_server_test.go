@@ -2,12 +2,18 @@ package plugin
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Validate that Configure can read inputs from variables instead of args.
@@ -40,6 +46,70 @@ func TestProviderServer_Configure_variables(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// Validate that Configure reports the provider's capabilities to the engine.
+func TestProviderServer_Configure_capabilities(t *testing.T) {
+	t.Parallel()
+
+	provider := stubProvider{
+		ConfigureFunc: func(pm resource.PropertyMap) error {
+			return nil
+		},
+		CapabilitiesFunc: func() ([]string, error) {
+			return []string{"batchDelete"}, nil
+		},
+	}
+	srv := NewProviderServer(&provider)
+
+	ctx := context.Background()
+	resp, err := srv.Configure(ctx, &pulumirpc.ConfigureRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"batchDelete"}, resp.GetCapabilities())
+
+	// Providers that do not implement capabilities are assumed to support none of them.
+	srv = NewProviderServer(struct{ Provider }{&provider})
+	resp, err = srv.Configure(ctx, &pulumirpc.ConfigureRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.GetCapabilities())
+}
+
+// Validate that Configure reports the provider's composite ID separators to the engine.
+func TestProviderServer_Configure_composite_ID_separators(t *testing.T) {
+	t.Parallel()
+
+	provider := stubProvider{
+		ConfigureFunc: func(pm resource.PropertyMap) error {
+			return nil
+		},
+		CompositeIDSeparatorsFunc: func() (map[tokens.Type]string, error) {
+			return map[tokens.Type]string{"pkg:m:typ": "/"}, nil
+		},
+	}
+	srv := NewProviderServer(&provider)
+
+	resp, err := srv.Configure(context.Background(), &pulumirpc.ConfigureRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"pkg:m:typ": "/"}, resp.GetCompositeIdSeparators())
+}
+
+// Validate that Configure reports the provider's tag property paths to the engine.
+func TestProviderServer_Configure_tag_property_paths(t *testing.T) {
+	t.Parallel()
+
+	provider := stubProvider{
+		ConfigureFunc: func(pm resource.PropertyMap) error {
+			return nil
+		},
+		TagPropertyPathsFunc: func() (map[tokens.Type]resource.PropertyPath, error) {
+			return map[tokens.Type]resource.PropertyPath{"pkg:m:typ": {"metadata", "labels"}}, nil
+		},
+	}
+	srv := NewProviderServer(&provider)
+
+	resp, err := srv.Configure(context.Background(), &pulumirpc.ConfigureRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"pkg:m:typ": "metadata.labels"}, resp.GetTagPropertyPaths())
+}
+
 // stubProvider is a Provider implementation
 // with support for stubbing out specific methods.
 type stubProvider struct {
@@ -50,7 +120,34 @@ type stubProvider struct {
 		inputs, state resource.PropertyMap,
 	) (ReadResult, resource.Status, error)
 
+	UpdateFunc func(
+		urn resource.URN, id resource.ID,
+		oldInputs, oldOutputs, newInputs resource.PropertyMap,
+	) (UpdateResult, resource.Status, error)
+
 	ConfigureFunc func(resource.PropertyMap) error
+
+	CapabilitiesFunc          func() ([]string, error)
+	CompositeIDSeparatorsFunc func() (map[tokens.Type]string, error)
+	TagPropertyPathsFunc      func() (map[tokens.Type]resource.PropertyPath, error)
+
+	HealthFunc func() error
+
+	AnnotateFunc func(urn resource.URN, id resource.ID, annotations map[string]string) error
+}
+
+func (p *stubProvider) Health() error {
+	if p.HealthFunc != nil {
+		return p.HealthFunc()
+	}
+	return nil
+}
+
+func (p *stubProvider) Annotate(urn resource.URN, id resource.ID, annotations map[string]string) error {
+	if p.AnnotateFunc != nil {
+		return p.AnnotateFunc(urn, id, annotations)
+	}
+	return nil
 }
 
 func (p *stubProvider) Configure(inputs resource.PropertyMap) error {
@@ -60,6 +157,30 @@ func (p *stubProvider) Configure(inputs resource.PropertyMap) error {
 	return p.Provider.Configure(inputs)
 }
 
+func (p *stubProvider) Capabilities() ([]string, error) {
+	if p.CapabilitiesFunc != nil {
+		return p.CapabilitiesFunc()
+	}
+	// Configure asks for the provider's capabilities, but most tests do not care about them.
+	return nil, nil
+}
+
+func (p *stubProvider) CompositeIDSeparators() (map[tokens.Type]string, error) {
+	if p.CompositeIDSeparatorsFunc != nil {
+		return p.CompositeIDSeparatorsFunc()
+	}
+	// Configure asks for the provider's composite ID separators, but most tests do not care about them.
+	return nil, nil
+}
+
+func (p *stubProvider) TagPropertyPaths() (map[tokens.Type]resource.PropertyPath, error) {
+	if p.TagPropertyPathsFunc != nil {
+		return p.TagPropertyPathsFunc()
+	}
+	// Configure asks for the provider's tag property paths, but most tests do not care about them.
+	return nil, nil
+}
+
 func (p *stubProvider) Read(
 	urn resource.URN,
 	id resource.ID,
@@ -72,6 +193,20 @@ func (p *stubProvider) Read(
 	return p.Provider.Read(urn, id, inputs, state)
 }
 
+func (p *stubProvider) Update(
+	urn resource.URN,
+	id resource.ID,
+	oldInputs, oldOutputs, newInputs resource.PropertyMap,
+	timeout float64,
+	ignoreChanges []string,
+	preview bool,
+) (UpdateResult, resource.Status, error) {
+	if p.UpdateFunc != nil {
+		return p.UpdateFunc(urn, id, oldInputs, oldOutputs, newInputs)
+	}
+	return p.Provider.Update(urn, id, oldInputs, oldOutputs, newInputs, timeout, ignoreChanges, preview)
+}
+
 // When importing random passwords, the secret passed as "ID" should not leak in plain text into the final ID.
 func TestProviderServer_Read_respects_ID(t *testing.T) {
 	t.Parallel()
@@ -100,3 +235,78 @@ func TestProviderServer_Read_respects_ID(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEqual(t, secret, resp.Id)
 }
+
+// Validate that the ID a provider reissues during an update is sent back to the engine.
+func TestProviderServer_Update_reissued_ID(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	provider := stubProvider{
+		UpdateFunc: func(
+			urn resource.URN, id resource.ID,
+			oldInputs, oldOutputs, newInputs resource.PropertyMap,
+		) (UpdateResult, resource.Status, error) {
+			return UpdateResult{ID: "new-id", Outputs: newInputs}, resource.StatusOK, nil
+		},
+	}
+	srv := NewProviderServer(&provider)
+	resp, err := srv.Update(ctx, &pulumirpc.UpdateRequest{
+		Urn: "urn:pulumi:v2::re::random:index/randomPassword:RandomPassword::newPassword",
+		Id:  "old-id",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "new-id", resp.GetId())
+}
+
+// Validate that Health reports the provider's health check errors to the engine.
+func TestProviderServer_Health(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	provider := stubProvider{
+		HealthFunc: func() error {
+			return errors.New("service unreachable")
+		},
+	}
+	srv := NewProviderServer(&provider)
+	_, err := srv.Health(ctx, &pbempty.Empty{})
+	assert.ErrorContains(t, err, "service unreachable")
+
+	provider.HealthFunc = func() error { return nil }
+	_, err = srv.Health(ctx, &pbempty.Empty{})
+	assert.NoError(t, err)
+
+	// Providers that do not implement health checks are assumed to be healthy.
+	provider.HealthFunc = func() error { return errors.New("service unreachable") }
+	srv = NewProviderServer(struct{ Provider }{&provider})
+	_, err = srv.Health(ctx, &pbempty.Empty{})
+	assert.NoError(t, err)
+}
+
+// Validate that Annotate passes requests through to providers that implement AnnotatingProvider.
+func TestProviderServer_Annotate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	var gotURN resource.URN
+	var gotID resource.ID
+	var gotAnnotations map[string]string
+	provider := stubProvider{
+		AnnotateFunc: func(urn resource.URN, id resource.ID, annotations map[string]string) error {
+			gotURN, gotID, gotAnnotations = urn, id, annotations
+			return nil
+		},
+	}
+	srv := NewProviderServer(&provider)
+	_, err := srv.Annotate(ctx, &pulumirpc.AnnotateRequest{
+		Urn:         "urn:pulumi:stack::project::pkgA:m:typA::res",
+		Id:          "res-id",
+		Annotations: map[string]string{"owner": "team"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.URN("urn:pulumi:stack::project::pkgA:m:typA::res"), gotURN)
+	assert.Equal(t, resource.ID("res-id"), gotID)
+	assert.Equal(t, map[string]string{"owner": "team"}, gotAnnotations)
+
+	// Providers that do not implement AnnotatingProvider report that the method is unimplemented.
+	srv = NewProviderServer(struct{ Provider }{&provider})
+	_, err = srv.Annotate(ctx, &pulumirpc.AnnotateRequest{Id: "res-id"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
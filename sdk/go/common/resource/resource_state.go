@@ -51,6 +51,9 @@ type State struct {
 	Created                 *time.Time            // If set, the time when the state was initially added to the state file. (i.e. Create, Import)
 	Modified                *time.Time            // If set, the time when the state was last modified in the state file.
 	SourcePosition          string                // If set, the source location of the resource registration
+	AlwaysReplace           bool                  // if set to True, the resource is always replaced rather than updated in place.
+	CreateBeforeUpdate      bool                  // if set to True, a new resource is created before the old one is updated away.
+	ReplacementCause        string                // If set, why the most recent deployment replaced this resource.
 }
 
 func (s *State) GetAliasURNs() []URN {
@@ -22,4 +22,6 @@ const (
 	StatusOK Status = iota
 	StatusPartialFailure
 	StatusUnknown
+	// StatusNotFound indicates that the resource targeted by the operation does not exist.
+	StatusNotFound
 )
@@ -17,8 +17,16 @@ package tools
 import (
 	"bufio"
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
 )
@@ -26,19 +34,34 @@ import (
 // GenWriter adds some convenient helpers atop a buffered writer.
 type GenWriter struct {
 	tool string        // the name of the code-generator.
-	f    *os.File      // the file being written to.
+	file string        // the path of the file being generated (if there is a file).
+	f    *os.File      // the temporary file being written to.
 	buff *bytes.Buffer // the buffer (if there is no file).
 	w    *bufio.Writer // the buffered writer used to emit code.
+
+	checksum bool              // true if a checksum footer should be appended on Close.
+	done     bool              // true once the writer has been closed or aborted.
+	imports  map[string]string // the Go imports to emit with WriteImportBlock, mapped to their aliases.
+	region   string            // the name of the region currently being written, if any.
+
+	out          *bufio.Writer            // the writer for the default section, once Section has been called.
+	sections     map[string]*bytes.Buffer // the content of each named section that has not yet been emitted.
+	sectionOrder []string                 // the names of the sections, in the order in which they were created.
 }
 
+// NewGenWriter creates a writer that emits into the given file, or into an in-memory buffer if file is empty.
+//
+// File-backed writers are all-or-nothing: content is written to a temporary file alongside the target, which replaces
+// the target only when Close succeeds. Calling Abort instead discards the temporary file and leaves any existing
+// file untouched.
 func NewGenWriter(tool string, file string) (*GenWriter, error) {
-	// If the file is non-empty, open up a writer and overwrite whatever file contents already exist.
 	if file != "" {
-		f, err := os.OpenFile(file, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600)
+		// Create the temporary file in the same directory as the target so that the final rename is atomic.
+		f, err := os.CreateTemp(filepath.Dir(file), "."+filepath.Base(file)+".*.tmp")
 		if err != nil {
 			return nil, err
 		}
-		return &GenWriter{tool: tool, f: f, w: bufio.NewWriter(f)}, nil
+		return &GenWriter{tool: tool, file: file, f: f, w: bufio.NewWriter(f)}, nil
 	}
 
 	// Otherwise, we are emitting into an in-memory buffer.
@@ -51,16 +74,130 @@ func (g *GenWriter) Flush() error {
 	return g.w.Flush()
 }
 
-// Close flushes and closes the underlying writer.
+// Close flushes and closes the underlying writer. If EmitChecksumFooter has been called, the checksum footer is
+// appended before the writer is closed. For file-backed writers, the generated file then atomically replaces the
+// target; if any of this fails, the temporary file is removed and the target is left untouched.
 func (g *GenWriter) Close() error {
-	err := g.w.Flush()
-	contract.IgnoreError(err)
-	if g.f != nil {
-		return g.f.Close()
+	if g.done {
+		return nil
+	}
+	g.done = true
+
+	if g.out != nil {
+		g.FlushSections()
+	}
+	if err := g.w.Flush(); err != nil {
+		g.discard()
+		return err
+	}
+	if g.checksum {
+		if err := g.writeChecksumFooter(); err != nil {
+			g.discard()
+			return err
+		}
+	}
+	if g.f == nil {
+		return nil
+	}
+
+	if err := g.f.Close(); err != nil {
+		contract.IgnoreError(os.Remove(g.f.Name()))
+		return err
+	}
+	if err := os.Rename(g.f.Name(), g.file); err != nil {
+		contract.IgnoreError(os.Remove(g.f.Name()))
+		return err
 	}
 	return nil
 }
 
+// Abort discards everything written so far. For file-backed writers, the temporary file is removed and the target
+// file is left as it was before the writer was created. Abort has no effect once the writer has been closed, so it
+// is safe to defer it immediately after creating the writer.
+func (g *GenWriter) Abort() {
+	if g.done {
+		return
+	}
+	g.done = true
+	g.discard()
+}
+
+// discard closes and removes the temporary file, if there is one.
+func (g *GenWriter) discard() {
+	if g.f != nil {
+		contract.IgnoreClose(g.f)
+		contract.IgnoreError(os.Remove(g.f.Name()))
+	}
+}
+
+// checksumMarker precedes the hex-encoded digest in a checksum footer.
+const checksumMarker = "checksum: sha256:"
+
+// EmitChecksumFooter requests that a comment containing the SHA-256 digest of the generated content be appended to
+// the output when the writer is closed. The digest covers everything written before the footer, with line endings
+// normalized to "\n" so that it is stable across platforms. Use VerifyChecksum to detect later edits to the file.
+func (g *GenWriter) EmitChecksumFooter() {
+	g.checksum = true
+}
+
+// writeChecksumFooter computes the digest of the content written so far and appends the checksum footer.
+func (g *GenWriter) writeChecksumFooter() error {
+	var content []byte
+	if g.f != nil {
+		if _, err := g.f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		b, err := io.ReadAll(g.f)
+		if err != nil {
+			return err
+		}
+		content = b
+	} else {
+		content = g.buff.Bytes()
+	}
+
+	body := normalizeLineEndings(string(content))
+	if body != "" && !strings.HasSuffix(body, "\n") {
+		body += "\n"
+		g.WriteString("\n")
+	}
+	g.Writefmtln("// %s%s", checksumMarker, contentDigest(body))
+	return g.w.Flush()
+}
+
+// VerifyChecksum reports whether the content of the file at path matches the checksum in its footer. It returns an
+// error if the file cannot be read or does not end with a checksum footer.
+func VerifyChecksum(path string) (bool, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+
+	content := strings.TrimSuffix(normalizeLineEndings(string(b)), "\n")
+	body, footer := "", content
+	if i := strings.LastIndexByte(content, '\n'); i != -1 {
+		body, footer = content[:i+1], content[i+1:]
+	}
+
+	i := strings.Index(footer, checksumMarker)
+	if i == -1 {
+		return false, errors.New("file does not have a checksum footer")
+	}
+	expected := strings.TrimSpace(footer[i+len(checksumMarker):])
+	return expected == contentDigest(body), nil
+}
+
+// normalizeLineEndings converts "\r\n" line endings to "\n".
+func normalizeLineEndings(text string) string {
+	return strings.ReplaceAll(text, "\r\n", "\n")
+}
+
+// contentDigest returns the hex-encoded SHA-256 digest of text.
+func contentDigest(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
+
 // WriteString writes the provided string to the underlying buffer _without_ formatting it.
 func (g *GenWriter) WriteString(msg string) {
 	_, err := g.w.WriteString(msg)
@@ -84,6 +221,483 @@ func (g *GenWriter) EmitHeaderWarning(commentChars string) {
 	g.Writefmtln("")
 }
 
+// Section switches subsequent writes to the named section. Named sections are buffered separately from the output
+// until they are emitted by FlushSections, so that a generator can, for example, write the body of a file before the
+// imports that the body turns out to need. The unnamed default section, "", is the output itself: writes to it are
+// emitted immediately, and a writer that never calls Section only uses the default section. Switching to a section
+// that has already been written to appends to it.
+func (g *GenWriter) Section(name string) {
+	if g.out == nil {
+		g.out = g.w
+	}
+	err := g.w.Flush()
+	contract.IgnoreError(err)
+
+	if name == "" {
+		g.w = g.out
+		return
+	}
+	buff, has := g.sections[name]
+	if !has {
+		if g.sections == nil {
+			g.sections = map[string]*bytes.Buffer{}
+		}
+		buff = &bytes.Buffer{}
+		g.sections[name] = buff
+		g.sectionOrder = append(g.sectionOrder, name)
+	}
+	g.w = bufio.NewWriter(buff)
+}
+
+// FlushSections switches to the default section, then emits the named sections to the output in the given order and
+// discards them. Names that do not refer to a section that has been written to are ignored, and each section is
+// emitted at most once. Sections that are not named are emitted after those that are, in the order in which they were
+// created, so that no output is lost. Close and MergeIntoFile call FlushSections if any sections have not been
+// emitted.
+func (g *GenWriter) FlushSections(order ...string) {
+	if g.out == nil {
+		return
+	}
+	g.Section("")
+
+	names := make([]string, 0, len(order)+len(g.sectionOrder))
+	names = append(append(names, order...), g.sectionOrder...)
+
+	emitted := map[string]bool{}
+	for _, name := range names {
+		buff, has := g.sections[name]
+		if !has || emitted[name] {
+			continue
+		}
+		emitted[name] = true
+		_, err := g.out.Write(buff.Bytes())
+		contract.IgnoreError(err)
+	}
+	g.sections, g.sectionOrder = nil, nil
+}
+
+// provenanceMarker precedes each key=value pair in a provenance block.
+const provenanceMarker = "// pulumi-codegen: "
+
+// Provenance describes how a generated file was produced.
+type Provenance struct {
+	Generator        string    // the name of the code-generator.
+	GeneratorVersion string    // the version of the code-generator.
+	SchemaHash       string    // a hash of the schema that the file was generated from.
+	Timestamp        time.Time // the time at which the file was generated.
+}
+
+// EmitProvenance emits a block of comments that records the given provenance, one "// pulumi-codegen: key=value"
+// line per field, followed by a blank line. Fields are always emitted in the same order and empty fields are omitted.
+// If the provenance does not name a generator, the writer's tool is used. The timestamp is written in UTC using RFC
+// 3339. Use ParseProvenance to read the block back.
+func (g *GenWriter) EmitProvenance(p Provenance) {
+	if p.Generator == "" {
+		p.Generator = g.tool
+	}
+	timestamp := ""
+	if !p.Timestamp.IsZero() {
+		timestamp = p.Timestamp.UTC().Format(time.RFC3339Nano)
+	}
+
+	for _, field := range []struct{ key, value string }{
+		{"generator", p.Generator},
+		{"version", p.GeneratorVersion},
+		{"schema-hash", p.SchemaHash},
+		{"timestamp", timestamp},
+	} {
+		if field.value == "" {
+			continue
+		}
+		contract.Requiref(!strings.ContainsAny(field.value, "\r\n"), "p", "%s must not contain line breaks", field.key)
+		g.Writefmtln("%s%s=%s", provenanceMarker, field.key, field.value)
+	}
+	g.Writefmtln("")
+}
+
+// ParseProvenance reads the provenance block emitted by EmitProvenance from the file at path. Unrecognized keys are
+// ignored so that older readers can parse blocks written by newer generators. It returns an error if the file cannot
+// be read, does not contain a provenance block, or contains a malformed one.
+func ParseProvenance(path string) (Provenance, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return Provenance{}, err
+	}
+
+	var p Provenance
+	found := false
+	seen := map[string]bool{}
+	for i, line := range strings.Split(normalizeLineEndings(string(b)), "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, provenanceMarker) {
+			continue
+		}
+		found = true
+
+		entry := strings.TrimPrefix(line, provenanceMarker)
+
+		key, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return Provenance{}, fmt.Errorf("%s:%d: malformed provenance entry %q", path, i+1, entry)
+		}
+		if seen[key] {
+			return Provenance{}, fmt.Errorf("%s:%d: duplicate provenance key %q", path, i+1, key)
+		}
+		seen[key] = true
+
+		switch key {
+		case "generator":
+			p.Generator = value
+		case "version":
+			p.GeneratorVersion = value
+		case "schema-hash":
+			p.SchemaHash = value
+		case "timestamp":
+			t, err := time.Parse(time.RFC3339Nano, value)
+			if err != nil {
+				return Provenance{}, fmt.Errorf("%s:%d: invalid provenance timestamp: %w", path, i+1, err)
+			}
+			p.Timestamp = t
+		}
+	}
+	if !found {
+		return Provenance{}, errors.New("file does not have a provenance block")
+	}
+	return p, nil
+}
+
+// WriteGoComment writes text as a sequence of Go line comments. Each line of text is wrapped so that, including the
+// "// " prefix, it is no wider than maxWidth; words too long to fit are written on a line of their own rather than
+// being broken. Line breaks and indentation in text are preserved. Any "*/" sequences are escaped so that the comment
+// remains safe to embed in a block comment. A maxWidth of zero or less disables wrapping.
+func (g *GenWriter) WriteGoComment(text string, maxWidth int) {
+	const prefix = "// "
+
+	width := 0
+	if maxWidth > 0 {
+		width = maxWidth - len(prefix)
+		if width < 1 {
+			width = 1
+		}
+	}
+
+	text = escapeCommentEnd(text, "*/")
+	for _, line := range wrapText(text, width) {
+		if line == "" {
+			g.WriteString(strings.TrimSpace(prefix) + "\n")
+			continue
+		}
+		g.WriteString(prefix + line + "\n")
+	}
+}
+
+// WriteBlockComment writes text as a block comment delimited by commentStart and commentEnd, each on a line of its
+// own. Any occurrences of commentEnd within text are escaped so that they do not terminate the comment early.
+func (g *GenWriter) WriteBlockComment(commentStart, commentEnd, text string) {
+	g.WriteString(commentStart + "\n")
+	for _, line := range wrapText(escapeCommentEnd(text, commentEnd), 0) {
+		g.WriteString(line + "\n")
+	}
+	g.WriteString(commentEnd + "\n")
+}
+
+// escapeCommentEnd escapes each occurrence of commentEnd in text by inserting a backslash after its first character.
+// Surrounding whitespace in commentEnd is ignored.
+func escapeCommentEnd(text, commentEnd string) string {
+	commentEnd = strings.TrimSpace(commentEnd)
+	if commentEnd == "" {
+		return text
+	}
+	escaped := commentEnd[:1] + "\\" + commentEnd[1:]
+	return strings.ReplaceAll(text, commentEnd, escaped)
+}
+
+// wrapText splits text into lines no wider than width, breaking on whitespace. Existing line breaks are preserved, as
+// is the leading indentation of each line, which is repeated on any lines it wraps onto. Words wider than width are
+// placed on a line of their own. A width of zero or less disables wrapping.
+func wrapText(text string, width int) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		line = strings.TrimRight(line, " \t")
+		if width <= 0 || len(line) <= width {
+			lines = append(lines, line)
+			continue
+		}
+
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		current := ""
+		for _, word := range strings.Fields(line) {
+			switch {
+			case current == "":
+				current = indent + word
+			case len(current)+1+len(word) <= width:
+				current += " " + word
+			default:
+				lines = append(lines, current)
+				current = indent + word
+			}
+		}
+		lines = append(lines, current)
+	}
+	return lines
+}
+
+// AddImport records a Go import to be emitted by WriteImportBlock. The alias may be empty to import the package under
+// its own name. Adding the same import more than once has no effect. An error is returned if the path has already been
+// added with a different alias, or if the alias is already used by another path.
+func (g *GenWriter) AddImport(path, alias string) error {
+	if existing, has := g.imports[path]; has {
+		if existing != alias {
+			return fmt.Errorf("import %q cannot be aliased as both %q and %q", path, existing, alias)
+		}
+		return nil
+	}
+	if alias != "" && alias != "_" && alias != "." {
+		for other, otherAlias := range g.imports {
+			if otherAlias == alias {
+				return fmt.Errorf("alias %q cannot be used for both %q and %q", alias, other, path)
+			}
+		}
+	}
+
+	if g.imports == nil {
+		g.imports = map[string]string{}
+	}
+	g.imports[path] = alias
+	return nil
+}
+
+// WriteImportBlock writes the imports recorded by AddImport as a single Go import block at the current position, then
+// forgets them. As with goimports, standard library packages are grouped ahead of all other packages, and each group
+// is sorted by path. Nothing is written if no imports have been added.
+func (g *GenWriter) WriteImportBlock() {
+	if len(g.imports) == 0 {
+		return
+	}
+
+	var std, other []string
+	for path := range g.imports {
+		if isStandardImport(path) {
+			std = append(std, path)
+		} else {
+			other = append(other, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+
+	g.WriteString("import (\n")
+	for i, group := range [][]string{std, other} {
+		if i > 0 && len(std) > 0 && len(group) > 0 {
+			g.WriteString("\n")
+		}
+		for _, path := range group {
+			if alias := g.imports[path]; alias != "" {
+				g.Writefmtln("\t%s %q", alias, path)
+			} else {
+				g.Writefmtln("\t%q", path)
+			}
+		}
+	}
+	g.WriteString(")\n\n")
+	g.imports = nil
+}
+
+// isStandardImport reports whether the given import path belongs to the standard library. Like goimports, this treats
+// any path whose first element does not contain a dot as a standard library path.
+func isStandardImport(path string) bool {
+	first, _, _ := strings.Cut(path, "/")
+	return !strings.Contains(first, ".")
+}
+
+// Region markers delimit the named regions written by BeginRegion and EndRegion. Each marker is a line of its own,
+// optionally indented, that consists of the marker followed by the region's name, e.g.:
+//
+//	// pulumi:begin-region imports
+//	...
+//	// pulumi:end-region imports
+const (
+	regionBeginMarker = "// pulumi:begin-region "
+	regionEndMarker   = "// pulumi:end-region "
+)
+
+// BeginRegion writes a marker that begins the named region. Regions cannot be nested, and each region must be ended
+// with a call to EndRegion with the same name. Names must be non-empty and must not contain whitespace.
+func (g *GenWriter) BeginRegion(name string) {
+	contract.Requiref(name != "" && !strings.ContainsAny(name, " \t\r\n"), "name", "must be a non-empty word")
+	contract.Requiref(g.region == "", "name", "cannot begin region %q inside region %q", name, g.region)
+	g.region = name
+	g.WriteString(regionBeginMarker + name + "\n")
+}
+
+// EndRegion writes a marker that ends the named region, which must be the region most recently begun.
+func (g *GenWriter) EndRegion(name string) {
+	contract.Requiref(g.region == name, "name", "cannot end region %q while in region %q", name, g.region)
+	g.region = ""
+	g.WriteString(regionEndMarker + name + "\n")
+}
+
+// MergeIntoFile merges what has been written to the in-memory buffer into the file at path. The content of each
+// named region in the file is replaced with the content of the region of the same name in the buffer, while
+// everything outside of the regions is preserved. If the file does not exist, it is created with the buffer's
+// content. An error is returned if either the buffer or the file has unterminated, unmatched, or duplicate region
+// markers, or if the regions in the buffer and the file differ. The file is replaced atomically.
+func (g *GenWriter) MergeIntoFile(path string) error {
+	contract.Requiref(g.f == nil, "g", "must be an in-memory writer")
+	contract.Requiref(g.region == "", "g", "must not be inside region %q", g.region)
+	if g.out != nil {
+		g.FlushSections()
+	}
+	if err := g.w.Flush(); err != nil {
+		return err
+	}
+	generated := g.buff.String()
+
+	existing, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return writeFileAtomic(path, []byte(generated), 0o600)
+	} else if err != nil {
+		return err
+	}
+
+	merged, err := mergeRegions(string(existing), path, generated)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, []byte(merged), info.Mode().Perm())
+}
+
+// textRegion is a named region within a text. The region's content spans the bytes [start, end) of the text, which
+// exclude the region's markers.
+type textRegion struct {
+	name       string
+	start, end int
+}
+
+// parseRegions returns the named regions in text in the order in which they appear. The source names the text in
+// error messages.
+func parseRegions(text, source string) ([]textRegion, error) {
+	var regions []textRegion
+	seen := map[string]bool{}
+
+	var open *textRegion
+	lineNumber := 0
+	for offset := 0; offset < len(text); {
+		lineNumber++
+		lineEnd := len(text)
+		next := len(text)
+		if i := strings.IndexByte(text[offset:], '\n'); i != -1 {
+			lineEnd, next = offset+i, offset+i+1
+		}
+		line := strings.TrimSpace(text[offset:lineEnd])
+
+		switch {
+		case strings.HasPrefix(line, regionBeginMarker):
+			name := strings.TrimSpace(strings.TrimPrefix(line, regionBeginMarker))
+			if open != nil {
+				return nil, fmt.Errorf("%s:%d: region %q begins inside region %q", source, lineNumber, name, open.name)
+			}
+			if seen[name] {
+				return nil, fmt.Errorf("%s:%d: duplicate region %q", source, lineNumber, name)
+			}
+			seen[name] = true
+			open = &textRegion{name: name, start: next}
+		case strings.HasPrefix(line, regionEndMarker):
+			name := strings.TrimSpace(strings.TrimPrefix(line, regionEndMarker))
+			if open == nil || open.name != name {
+				return nil, fmt.Errorf("%s:%d: end of region %q does not match a beginning", source, lineNumber, name)
+			}
+			open.end = offset
+			regions = append(regions, *open)
+			open = nil
+		}
+		offset = next
+	}
+	if open != nil {
+		return nil, fmt.Errorf("%s: region %q is never ended", source, open.name)
+	}
+	return regions, nil
+}
+
+// mergeRegions replaces the content of each region in existing with the content of the same region in generated.
+func mergeRegions(existing, path, generated string) (string, error) {
+	existingRegions, err := parseRegions(existing, path)
+	if err != nil {
+		return "", err
+	}
+	generatedRegions, err := parseRegions(generated, "generated code")
+	if err != nil {
+		return "", err
+	}
+
+	contents := make(map[string]string, len(generatedRegions))
+	for _, r := range generatedRegions {
+		contents[r.name] = generated[r.start:r.end]
+	}
+	for _, r := range existingRegions {
+		if _, has := contents[r.name]; !has {
+			return "", fmt.Errorf("%s: region %q is not generated", path, r.name)
+		}
+	}
+	if len(existingRegions) != len(generatedRegions) {
+		for _, r := range generatedRegions {
+			if !containsRegion(existingRegions, r.name) {
+				return "", fmt.Errorf("%s: generated region %q is missing", path, r.name)
+			}
+		}
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, r := range existingRegions {
+		b.WriteString(existing[last:r.start])
+		b.WriteString(contents[r.name])
+		last = r.end
+	}
+	b.WriteString(existing[last:])
+	return b.String(), nil
+}
+
+func containsRegion(regions []textRegion, name string) bool {
+	for _, r := range regions {
+		if r.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// writeFileAtomic writes data to a temporary file alongside path and then renames it over path.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		contract.IgnoreClose(f)
+		contract.IgnoreError(os.Remove(f.Name()))
+		return err
+	}
+	if err := f.Chmod(perm); err != nil {
+		contract.IgnoreClose(f)
+		contract.IgnoreError(os.Remove(f.Name()))
+		return err
+	}
+	if err := f.Close(); err != nil {
+		contract.IgnoreError(os.Remove(f.Name()))
+		return err
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		contract.IgnoreError(os.Remove(f.Name()))
+		return err
+	}
+	return nil
+}
+
 // Buffer returns whatever has been written to the in-memory buffer (in non-file cases).
 func (g *GenWriter) Buffer() string {
 	return g.buff.String()
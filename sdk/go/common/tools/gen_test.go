@@ -0,0 +1,613 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tools
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newBufferedGenWriter(t *testing.T) *GenWriter {
+	g, err := NewGenWriter("test", "")
+	require.NoError(t, err)
+	return g
+}
+
+func TestWriteGoComment(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		text     string
+		maxWidth int
+		expected string
+	}{
+		{
+			name:     "short",
+			text:     "A short comment.",
+			maxWidth: 40,
+			expected: "// A short comment.\n",
+		},
+		{
+			name:     "wrapped",
+			text:     "The quick brown fox jumps over the lazy dog.",
+			maxWidth: 20,
+			expected: "// The quick brown\n// fox jumps over\n// the lazy dog.\n",
+		},
+		{
+			name:     "long word",
+			text:     "See https://www.pulumi.com/docs/concepts/resources/ for details.",
+			maxWidth: 20,
+			expected: "// See\n// https://www.pulumi.com/docs/concepts/resources/\n// for details.\n",
+		},
+		{
+			name:     "embedded newlines",
+			text:     "First paragraph.\n\nSecond paragraph\r\nwith a break.",
+			maxWidth: 80,
+			expected: "// First paragraph.\n//\n// Second paragraph\n// with a break.\n",
+		},
+		{
+			name:     "indentation",
+			text:     "Example:\n\n    one two three four",
+			maxWidth: 18,
+			expected: "// Example:\n//\n//     one two\n//     three four\n",
+		},
+		{
+			name:     "escaped",
+			text:     "Matches /* and */ literally.",
+			maxWidth: 80,
+			expected: "// Matches /* and *\\/ literally.\n",
+		},
+		{
+			name:     "unwrapped",
+			text:     "The quick brown fox jumps over the lazy dog.",
+			maxWidth: 0,
+			expected: "// The quick brown fox jumps over the lazy dog.\n",
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			g := newBufferedGenWriter(t)
+			g.WriteGoComment(c.text, c.maxWidth)
+			require.NoError(t, g.Flush())
+			assert.Equal(t, c.expected, g.Buffer())
+		})
+	}
+}
+
+func TestWriteBlockComment(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name                     string
+		commentStart, commentEnd string
+		text                     string
+		expected                 string
+	}{
+		{
+			name:         "c-style",
+			commentStart: "/**",
+			commentEnd:   " */",
+			text:         "Line one.\nLine two */ ends.",
+			expected:     "/**\nLine one.\nLine two *\\/ ends.\n */\n",
+		},
+		{
+			name:         "escaped",
+			commentStart: "/*",
+			commentEnd:   "*/",
+			text:         "Ends early */ unless escaped.",
+			expected:     "/*\nEnds early *\\/ unless escaped.\n*/\n",
+		},
+		{
+			name:         "docstring",
+			commentStart: `"""`,
+			commentEnd:   `"""`,
+			text:         `Contains """ quotes.`,
+			expected:     "\"\"\"\nContains \"\\\"\" quotes.\n\"\"\"\n",
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			g := newBufferedGenWriter(t)
+			g.WriteBlockComment(c.commentStart, c.commentEnd, c.text)
+			require.NoError(t, g.Flush())
+			assert.Equal(t, c.expected, g.Buffer())
+		})
+	}
+}
+
+func TestChecksumFooter(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "gen.go")
+	g, err := NewGenWriter("test", path)
+	require.NoError(t, err)
+	g.EmitChecksumFooter()
+	g.Writefmtln("package gen")
+	g.Writefmt("var x = 1")
+	require.NoError(t, g.Close())
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Regexp(t, "^package gen\nvar x = 1\n// checksum: sha256:[0-9a-f]{64}\n$", string(b))
+
+	ok, err := VerifyChecksum(path)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	t.Run("line endings", func(t *testing.T) {
+		t.Parallel()
+
+		crlf := filepath.Join(t.TempDir(), "crlf.go")
+		require.NoError(t, os.WriteFile(crlf, []byte(strings.ReplaceAll(string(b), "\n", "\r\n")), 0o600))
+
+		ok, err := VerifyChecksum(crlf)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("edited", func(t *testing.T) {
+		t.Parallel()
+
+		edited := filepath.Join(t.TempDir(), "edited.go")
+		require.NoError(t, os.WriteFile(edited, []byte(strings.Replace(string(b), "x = 1", "x = 2", 1)), 0o600))
+
+		ok, err := VerifyChecksum(edited)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("missing footer", func(t *testing.T) {
+		t.Parallel()
+
+		plain := filepath.Join(t.TempDir(), "plain.go")
+		require.NoError(t, os.WriteFile(plain, []byte("package gen\n"), 0o600))
+
+		_, err := VerifyChecksum(plain)
+		assert.ErrorContains(t, err, "does not have a checksum footer")
+	})
+
+	t.Run("buffer", func(t *testing.T) {
+		t.Parallel()
+
+		buffered := newBufferedGenWriter(t)
+		buffered.EmitChecksumFooter()
+		buffered.Writefmtln("package gen")
+		buffered.Writefmt("var x = 1")
+		require.NoError(t, buffered.Close())
+		assert.Equal(t, string(b), buffered.Buffer())
+	})
+}
+
+func TestProvenance(t *testing.T) {
+	t.Parallel()
+
+	p := Provenance{
+		GeneratorVersion: "v3.90.0",
+		SchemaHash:       "sha256:0123456789abcdef",
+		Timestamp:        time.Date(2023, 10, 14, 12, 30, 45, 500, time.FixedZone("EST", -5*60*60)),
+	}
+
+	path := filepath.Join(t.TempDir(), "gen.go")
+	g, err := NewGenWriter("pulumi-gen-test", path)
+	require.NoError(t, err)
+	g.EmitHeaderWarning("//")
+	g.EmitProvenance(p)
+	g.Writefmtln("package gen")
+	require.NoError(t, g.Close())
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "// *** WARNING: this file was generated by pulumi-gen-test. ***\n"+
+		"// *** Do not edit by hand unless you're certain you know what you are doing! ***\n"+
+		"\n"+
+		"// pulumi-codegen: generator=pulumi-gen-test\n"+
+		"// pulumi-codegen: version=v3.90.0\n"+
+		"// pulumi-codegen: schema-hash=sha256:0123456789abcdef\n"+
+		"// pulumi-codegen: timestamp=2023-10-14T17:30:45.0000005Z\n"+
+		"\n"+
+		"package gen\n", string(b))
+
+	parsed, err := ParseProvenance(path)
+	require.NoError(t, err)
+	assert.Equal(t, "pulumi-gen-test", parsed.Generator)
+	assert.Equal(t, p.GeneratorVersion, parsed.GeneratorVersion)
+	assert.Equal(t, p.SchemaHash, parsed.SchemaHash)
+	assert.True(t, p.Timestamp.Equal(parsed.Timestamp))
+
+	t.Run("empty fields", func(t *testing.T) {
+		t.Parallel()
+
+		g := newBufferedGenWriter(t)
+		g.EmitProvenance(Provenance{Generator: "other", SchemaHash: "abc"})
+		require.NoError(t, g.Close())
+		assert.Equal(t, "// pulumi-codegen: generator=other\n// pulumi-codegen: schema-hash=abc\n\n", g.Buffer())
+
+		path := filepath.Join(t.TempDir(), "gen.go")
+		require.NoError(t, os.WriteFile(path, []byte(g.Buffer()), 0o600))
+		parsed, err := ParseProvenance(path)
+		require.NoError(t, err)
+		assert.Equal(t, Provenance{Generator: "other", SchemaHash: "abc"}, parsed)
+	})
+
+	cases := []struct {
+		name     string
+		content  string
+		expected Provenance
+		err      string
+	}{
+		{
+			name:     "unknown keys",
+			content:  "// pulumi-codegen: generator=gen\r\n// pulumi-codegen: flavor=vanilla\r\n\r\npackage gen\r\n",
+			expected: Provenance{Generator: "gen"},
+		},
+		{
+			name:    "missing",
+			content: "package gen\n",
+			err:     "does not have a provenance block",
+		},
+		{
+			name:    "malformed",
+			content: "// pulumi-codegen: generator\n",
+			err:     `:1: malformed provenance entry "generator"`,
+		},
+		{
+			name:    "duplicate",
+			content: "// pulumi-codegen: version=1\n// pulumi-codegen: version=2\n",
+			err:     `:2: duplicate provenance key "version"`,
+		},
+		{
+			name:    "invalid timestamp",
+			content: "// pulumi-codegen: timestamp=yesterday\n",
+			err:     ":1: invalid provenance timestamp",
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "gen.go")
+			require.NoError(t, os.WriteFile(path, []byte(c.content), 0o600))
+
+			parsed, err := ParseProvenance(path)
+			if c.err != "" {
+				assert.ErrorContains(t, err, c.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, parsed)
+		})
+	}
+}
+
+func TestGenWriterAtomic(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "gen.go")
+	require.NoError(t, os.WriteFile(path, []byte("package old\n"), 0o600))
+
+	assertContent := func(t *testing.T, expected string) {
+		b, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(b))
+	}
+	assertDir := func(t *testing.T, expected string) {
+		assertContent(t, expected)
+
+		// No temporary files should be left behind.
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+	}
+
+	// Aborting leaves the existing file untouched.
+	g, err := NewGenWriter("test", path)
+	require.NoError(t, err)
+	g.Writefmtln("package partial")
+	require.NoError(t, g.Flush())
+	assertContent(t, "package old\n")
+	g.Abort()
+	assertDir(t, "package old\n")
+
+	// Closing replaces the existing file, and a deferred Abort after Close has no effect.
+	g, err = NewGenWriter("test", path)
+	require.NoError(t, err)
+	g.Writefmtln("package gen")
+	require.NoError(t, g.Close())
+	g.Abort()
+	assertDir(t, "package gen\n")
+}
+
+func TestWriteImportBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("dedupe and ordering", func(t *testing.T) {
+		t.Parallel()
+
+		g := newBufferedGenWriter(t)
+		for _, path := range []string{
+			"github.com/pulumi/pulumi/sdk/v3/go/pulumi",
+			"strings",
+			"context",
+			"github.com/pulumi/pulumi/sdk/v3/go/pulumi",
+			"example.com/internal",
+			"strings",
+			"encoding/json",
+		} {
+			require.NoError(t, g.AddImport(path, ""))
+		}
+		g.WriteImportBlock()
+		require.NoError(t, g.Flush())
+		assert.Equal(t, `import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"example.com/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+`, g.Buffer())
+	})
+
+	t.Run("aliases", func(t *testing.T) {
+		t.Parallel()
+
+		g := newBufferedGenWriter(t)
+		require.NoError(t, g.AddImport("github.com/pulumi/pulumi/sdk/v3/go/pulumix", "px"))
+		require.NoError(t, g.AddImport("github.com/pulumi/pulumi/sdk/v3/go/pulumix", "px"))
+		require.NoError(t, g.AddImport("embed", "_"))
+		require.NoError(t, g.AddImport("example.com/plugins", "_"))
+
+		err := g.AddImport("github.com/pulumi/pulumi/sdk/v3/go/pulumix", "")
+		assert.EqualError(t, err,
+			`import "github.com/pulumi/pulumi/sdk/v3/go/pulumix" cannot be aliased as both "px" and ""`)
+		err = g.AddImport("example.com/px", "px")
+		assert.EqualError(t, err,
+			`alias "px" cannot be used for both "github.com/pulumi/pulumi/sdk/v3/go/pulumix" and "example.com/px"`)
+
+		g.WriteImportBlock()
+		require.NoError(t, g.Flush())
+		assert.Equal(t, `import (
+	_ "embed"
+
+	_ "example.com/plugins"
+	px "github.com/pulumi/pulumi/sdk/v3/go/pulumix"
+)
+
+`, g.Buffer())
+	})
+
+	t.Run("stable and reset", func(t *testing.T) {
+		t.Parallel()
+
+		render := func(paths ...string) string {
+			g := newBufferedGenWriter(t)
+			for _, path := range paths {
+				require.NoError(t, g.AddImport(path, ""))
+			}
+			g.WriteImportBlock()
+			// Imports are forgotten once written, so a second block is empty.
+			g.WriteImportBlock()
+			require.NoError(t, g.Flush())
+			return g.Buffer()
+		}
+
+		expected := render("fmt", "github.com/a/b", "os", "github.com/a/a")
+		assert.Equal(t, expected, render("github.com/a/a", "os", "github.com/a/b", "fmt"))
+		assert.Equal(t, "import (\n\t\"github.com/a/a\"\n)\n\n", render("github.com/a/a"))
+		assert.Empty(t, render())
+	})
+}
+
+func TestMergeIntoFile(t *testing.T) {
+	t.Parallel()
+
+	generate := func(t *testing.T, regions ...string) *GenWriter {
+		g := newBufferedGenWriter(t)
+		g.WriteString("package foo\n\n")
+		for _, name := range regions {
+			g.BeginRegion(name)
+			g.Writefmtln("// generated %s", name)
+			g.EndRegion(name)
+		}
+		return g
+	}
+
+	t.Run("new file", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "foo.go")
+		require.NoError(t, generate(t, "a").MergeIntoFile(path))
+
+		b, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "package foo\n\n"+
+			"// pulumi:begin-region a\n// generated a\n// pulumi:end-region a\n", string(b))
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "foo.go")
+		existing := "package foo\n\n" +
+			"// Hand-written code.\n" +
+			"func f() {}\n\n" +
+			"// pulumi:begin-region a\n// stale a\n// more stale a\n// pulumi:end-region a\n\n" +
+			"func g() {\n" +
+			"\t// pulumi:begin-region b\n\t// stale b\n\t// pulumi:end-region b\n" +
+			"}\n"
+		require.NoError(t, os.WriteFile(path, []byte(existing), 0o600))
+
+		require.NoError(t, generate(t, "a", "b").MergeIntoFile(path))
+		expected := "package foo\n\n" +
+			"// Hand-written code.\n" +
+			"func f() {}\n\n" +
+			"// pulumi:begin-region a\n// generated a\n// pulumi:end-region a\n\n" +
+			"func g() {\n" +
+			"\t// pulumi:begin-region b\n// generated b\n\t// pulumi:end-region b\n" +
+			"}\n"
+		b, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(b))
+
+		// Merging the same content again leaves the file unchanged.
+		require.NoError(t, generate(t, "a", "b").MergeIntoFile(path))
+		b, err = os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(b))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			name     string
+			existing string
+			regions  []string
+			expected string
+		}{
+			{
+				name:     "unterminated",
+				existing: "// pulumi:begin-region a\n",
+				regions:  []string{"a"},
+				expected: `region "a" is never ended`,
+			},
+			{
+				name:     "unmatched end",
+				existing: "// pulumi:end-region a\n",
+				regions:  []string{"a"},
+				expected: `:1: end of region "a" does not match a beginning`,
+			},
+			{
+				name: "duplicate",
+				existing: "// pulumi:begin-region a\n// pulumi:end-region a\n" +
+					"// pulumi:begin-region a\n// pulumi:end-region a\n",
+				regions:  []string{"a"},
+				expected: `:3: duplicate region "a"`,
+			},
+			{
+				name:     "nested",
+				existing: "// pulumi:begin-region a\n// pulumi:begin-region b\n",
+				regions:  []string{"a", "b"},
+				expected: `:2: region "b" begins inside region "a"`,
+			},
+			{
+				name:     "not generated",
+				existing: "// pulumi:begin-region a\n// pulumi:end-region a\n",
+				regions:  []string{"b"},
+				expected: `region "a" is not generated`,
+			},
+			{
+				name:     "missing",
+				existing: "// pulumi:begin-region a\n// pulumi:end-region a\n",
+				regions:  []string{"a", "b"},
+				expected: `generated region "b" is missing`,
+			},
+		}
+		for _, c := range cases {
+			c := c
+			t.Run(c.name, func(t *testing.T) {
+				t.Parallel()
+
+				path := filepath.Join(t.TempDir(), "foo.go")
+				require.NoError(t, os.WriteFile(path, []byte(c.existing), 0o600))
+
+				err := generate(t, c.regions...).MergeIntoFile(path)
+				assert.ErrorContains(t, err, c.expected)
+
+				// The file is left untouched.
+				b, err := os.ReadFile(path)
+				require.NoError(t, err)
+				assert.Equal(t, c.existing, string(b))
+			})
+		}
+	})
+
+	t.Run("misuse", func(t *testing.T) {
+		t.Parallel()
+
+		g := newBufferedGenWriter(t)
+		g.BeginRegion("a")
+		assert.Panics(t, func() { g.BeginRegion("b") })
+		assert.Panics(t, func() { g.EndRegion("b") })
+		g.EndRegion("a")
+		assert.Panics(t, func() { g.BeginRegion("two words") })
+	})
+}
+
+func TestGenWriterSections(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reorder", func(t *testing.T) {
+		t.Parallel()
+
+		g := newBufferedGenWriter(t)
+		g.WriteString("package foo\n\n")
+		g.Section("body")
+		g.WriteString("func f() {}\n")
+		g.Section("imports")
+		g.WriteString("import \"fmt\"\n\n")
+		g.Section("body")
+		g.WriteString("func g() {}\n")
+		g.FlushSections("imports", "body")
+		g.WriteString("// trailer\n")
+		require.NoError(t, g.Flush())
+		assert.Equal(t, "package foo\n\nimport \"fmt\"\n\nfunc f() {}\nfunc g() {}\n// trailer\n", g.Buffer())
+	})
+
+	t.Run("missing and unlisted", func(t *testing.T) {
+		t.Parallel()
+
+		g := newBufferedGenWriter(t)
+		g.Section("c")
+		g.WriteString("c\n")
+		g.Section("a")
+		g.WriteString("a\n")
+		g.Section("b")
+		g.WriteString("b\n")
+		// "missing" was never written and "" is not a named section, so both are ignored; "a" is emitted once, and
+		// the unlisted "c" follows in creation order.
+		g.FlushSections("b", "missing", "", "a", "b")
+		require.NoError(t, g.Flush())
+		assert.Equal(t, "b\na\nc\n", g.Buffer())
+	})
+
+	t.Run("flushed on close", func(t *testing.T) {
+		t.Parallel()
+
+		g := newBufferedGenWriter(t)
+		g.Section("body")
+		g.WriteString("body\n")
+		g.Section("")
+		g.WriteString("header\n")
+		require.NoError(t, g.Close())
+		assert.Equal(t, "header\nbody\n", g.Buffer())
+	})
+}
@@ -0,0 +1,39 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+// EnumMeta describes an enum type in a generated SDK. Generated packages emit a package-level EnumMeta for each enum so
+// that tooling can enumerate enum types and their values at runtime.
+type EnumMeta struct {
+	// Name is the name of the generated Go type.
+	Name string
+	// Type is the schema token of the enum type.
+	Type string
+	// Values holds the enum's declared values, in schema order.
+	Values []EnumValueMeta
+}
+
+// EnumValueMeta describes a single declared value of an enum type.
+type EnumValueMeta struct {
+	// Name is the name of the generated Go constant.
+	Name string
+	// Value is the generated constant itself, which has the enum's Go type.
+	Value interface{}
+	// Deprecated is the value's deprecation message, or empty if the value is not deprecated.
+	Deprecated string
+	// Literal is the canonical text of a number enum's value. It is only recorded by SDKs generated with precise enum
+	// literals.
+	Literal string
+}
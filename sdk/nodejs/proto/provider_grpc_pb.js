@@ -34,6 +34,17 @@ function deserialize_google_protobuf_Empty(buffer_arg) {
   return google_protobuf_empty_pb.Empty.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_AnnotateRequest(arg) {
+  if (!(arg instanceof pulumi_provider_pb.AnnotateRequest)) {
+    throw new Error('Expected argument of type pulumirpc.AnnotateRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_AnnotateRequest(buffer_arg) {
+  return pulumi_provider_pb.AnnotateRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pulumirpc_CallRequest(arg) {
   if (!(arg instanceof pulumi_provider_pb.CallRequest)) {
     throw new Error('Expected argument of type pulumirpc.CallRequest');
@@ -577,6 +588,34 @@ getMappings: {
     responseSerialize: serialize_pulumirpc_GetMappingsResponse,
     responseDeserialize: deserialize_pulumirpc_GetMappingsResponse,
   },
+  // Health is an optional method that checks whether the provider can currently service requests, e.g. whether the
+// service it manages resources in is reachable. It should return an error if the provider is unhealthy. If a
+// provider does not implement this method the engine assumes that it is healthy.
+health: {
+    path: '/pulumirpc.ResourceProvider/Health',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Annotate is an optional method that attaches annotations, such as tags or labels, to an existing resource without
+// updating its inputs or outputs. The engine only calls this method for providers that report the "annotate"
+// capability in their ConfigureResponse.
+annotate: {
+    path: '/pulumirpc.ResourceProvider/Annotate',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_provider_pb.AnnotateRequest,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_pulumirpc_AnnotateRequest,
+    requestDeserialize: deserialize_pulumirpc_AnnotateRequest,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
 };
 
 exports.ResourceProviderClient = grpc.makeGenericClientConstructor(ResourceProviderService);
@@ -75,6 +75,14 @@ type ResourceProviderClient interface {
 	// implement this method the engine falls back to the old behaviour of just calling GetMapping without a name.
 	// If this method is implemented than the engine will then call GetMapping only with the names returned from this method.
 	GetMappings(ctx context.Context, in *GetMappingsRequest, opts ...grpc.CallOption) (*GetMappingsResponse, error)
+	// Health is an optional method that checks whether the provider can currently service requests, e.g. whether the
+	// service it manages resources in is reachable. It should return an error if the provider is unhealthy. If a
+	// provider does not implement this method the engine assumes that it is healthy.
+	Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Annotate is an optional method that attaches annotations, such as tags or labels, to an existing resource without
+	// updating its inputs or outputs. The engine only calls this method for providers that report the "annotate"
+	// capability in their ConfigureResponse.
+	Annotate(ctx context.Context, in *AnnotateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type resourceProviderClient struct {
@@ -279,6 +287,24 @@ func (c *resourceProviderClient) GetMappings(ctx context.Context, in *GetMapping
 	return out, nil
 }
 
+func (c *resourceProviderClient) Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pulumirpc.ResourceProvider/Health", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceProviderClient) Annotate(ctx context.Context, in *AnnotateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pulumirpc.ResourceProvider/Annotate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ResourceProviderServer is the server API for ResourceProvider service.
 // All implementations must embed UnimplementedResourceProviderServer
 // for forward compatibility
@@ -335,6 +361,14 @@ type ResourceProviderServer interface {
 	// implement this method the engine falls back to the old behaviour of just calling GetMapping without a name.
 	// If this method is implemented than the engine will then call GetMapping only with the names returned from this method.
 	GetMappings(context.Context, *GetMappingsRequest) (*GetMappingsResponse, error)
+	// Health is an optional method that checks whether the provider can currently service requests, e.g. whether the
+	// service it manages resources in is reachable. It should return an error if the provider is unhealthy. If a
+	// provider does not implement this method the engine assumes that it is healthy.
+	Health(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Annotate is an optional method that attaches annotations, such as tags or labels, to an existing resource without
+	// updating its inputs or outputs. The engine only calls this method for providers that report the "annotate"
+	// capability in their ConfigureResponse.
+	Annotate(context.Context, *AnnotateRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedResourceProviderServer()
 }
 
@@ -399,6 +433,12 @@ func (UnimplementedResourceProviderServer) GetMapping(context.Context, *GetMappi
 func (UnimplementedResourceProviderServer) GetMappings(context.Context, *GetMappingsRequest) (*GetMappingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMappings not implemented")
 }
+func (UnimplementedResourceProviderServer) Health(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+func (UnimplementedResourceProviderServer) Annotate(context.Context, *AnnotateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Annotate not implemented")
+}
 func (UnimplementedResourceProviderServer) mustEmbedUnimplementedResourceProviderServer() {}
 
 // UnsafeResourceProviderServer may be embedded to opt out of forward compatibility for this service.
@@ -757,6 +797,42 @@ func _ResourceProvider_GetMappings_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResourceProvider_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceProviderServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.ResourceProvider/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceProviderServer).Health(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceProvider_Annotate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnotateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceProviderServer).Annotate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.ResourceProvider/Annotate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceProviderServer).Annotate(ctx, req.(*AnnotateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ResourceProvider_ServiceDesc is the grpc.ServiceDesc for ResourceProvider service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -836,6 +912,14 @@ var ResourceProvider_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMappings",
 			Handler:    _ResourceProvider_GetMappings_Handler,
 		},
+		{
+			MethodName: "Health",
+			Handler:    _ResourceProvider_Health_Handler,
+		},
+		{
+			MethodName: "Annotate",
+			Handler:    _ResourceProvider_Annotate_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
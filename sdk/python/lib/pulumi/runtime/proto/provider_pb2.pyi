@@ -124,10 +124,45 @@ global___ConfigureRequest = ConfigureRequest
 class ConfigureResponse(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
+    @typing_extensions.final
+    class CompositeIdSeparatorsEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        value: builtins.str
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: builtins.str = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
+    @typing_extensions.final
+    class TagPropertyPathsEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        value: builtins.str
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: builtins.str = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
     ACCEPTSECRETS_FIELD_NUMBER: builtins.int
     SUPPORTSPREVIEW_FIELD_NUMBER: builtins.int
     ACCEPTRESOURCES_FIELD_NUMBER: builtins.int
     ACCEPTOUTPUTS_FIELD_NUMBER: builtins.int
+    CAPABILITIES_FIELD_NUMBER: builtins.int
+    COMPOSITEIDSEPARATORS_FIELD_NUMBER: builtins.int
+    TAGPROPERTYPATHS_FIELD_NUMBER: builtins.int
     acceptSecrets: builtins.bool
     """when true, the engine should pass secrets as strongly typed values to the provider."""
     supportsPreview: builtins.bool
@@ -136,6 +171,21 @@ class ConfigureResponse(google.protobuf.message.Message):
     """when true, the engine should pass resources as strongly typed values to the provider."""
     acceptOutputs: builtins.bool
     """when true, the engine should pass output values to the provider."""
+    @property
+    def capabilities(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]:
+        """the optional engine capabilities that the provider supports, e.g. "batchDelete". The engine assumes that a
+        provider supports none of these capabilities unless it reports them here.
+        """
+    @property
+    def compositeIdSeparators(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.str]:
+        """the separator that the provider uses to join the parts of a composite ID, keyed by resource type token. The
+        engine only imports resources by composite ID if their type is present in this map.
+        """
+    @property
+    def tagPropertyPaths(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.str]:
+        """the path of the input property that holds the tags of resources as a map of strings, keyed by resource type
+        token. The engine only merges automatic tags into the inputs of resources whose type is present in this map.
+        """
     def __init__(
         self,
         *,
@@ -143,8 +193,11 @@ class ConfigureResponse(google.protobuf.message.Message):
         supportsPreview: builtins.bool = ...,
         acceptResources: builtins.bool = ...,
         acceptOutputs: builtins.bool = ...,
+        capabilities: collections.abc.Iterable[builtins.str] | None = ...,
+        compositeIdSeparators: collections.abc.Mapping[builtins.str, builtins.str] | None = ...,
+        tagPropertyPaths: collections.abc.Mapping[builtins.str, builtins.str] | None = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["acceptOutputs", b"acceptOutputs", "acceptResources", b"acceptResources", "acceptSecrets", b"acceptSecrets", "supportsPreview", b"supportsPreview"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["acceptOutputs", b"acceptOutputs", "acceptResources", b"acceptResources", "acceptSecrets", b"acceptSecrets", "capabilities", b"capabilities", "compositeIdSeparators", b"compositeIdSeparators", "supportsPreview", b"supportsPreview", "tagPropertyPaths", b"tagPropertyPaths"]) -> None: ...
 
 global___ConfigureResponse = ConfigureResponse
 
@@ -885,16 +938,20 @@ class UpdateResponse(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
     PROPERTIES_FIELD_NUMBER: builtins.int
+    ID_FIELD_NUMBER: builtins.int
     @property
     def properties(self) -> google.protobuf.struct_pb2.Struct:
         """any properties that were computed during updating."""
+    id: builtins.str
+    """the resource's new ID, if the provider had to recreate it."""
     def __init__(
         self,
         *,
         properties: google.protobuf.struct_pb2.Struct | None = ...,
+        id: builtins.str = ...,
     ) -> None: ...
     def HasField(self, field_name: typing_extensions.Literal["properties", b"properties"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["properties", b"properties"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["id", b"id", "properties", b"properties"]) -> None: ...
 
 global___UpdateResponse = UpdateResponse
 
@@ -1352,3 +1409,46 @@ class GetMappingsResponse(google.protobuf.message.Message):
     def ClearField(self, field_name: typing_extensions.Literal["providers", b"providers"]) -> None: ...
 
 global___GetMappingsResponse = GetMappingsResponse
+
+@typing_extensions.final
+class AnnotateRequest(google.protobuf.message.Message):
+    """AnnotateRequest asks a provider to attach annotations to an existing resource."""
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    @typing_extensions.final
+    class AnnotationsEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        value: builtins.str
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: builtins.str = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
+    ID_FIELD_NUMBER: builtins.int
+    URN_FIELD_NUMBER: builtins.int
+    ANNOTATIONS_FIELD_NUMBER: builtins.int
+    id: builtins.str
+    """the ID of the resource to annotate."""
+    urn: builtins.str
+    """the Pulumi URN for this resource."""
+    @property
+    def annotations(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.str]:
+        """the annotations to attach to the resource, e.g. tags or labels."""
+    def __init__(
+        self,
+        *,
+        id: builtins.str = ...,
+        urn: builtins.str = ...,
+        annotations: collections.abc.Mapping[builtins.str, builtins.str] | None = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["annotations", b"annotations", "id", b"id", "urn", b"urn"]) -> None: ...
+
+global___AnnotateRequest = AnnotateRequest
@@ -146,6 +146,22 @@ class ResourceProviderStub:
     implement this method the engine falls back to the old behaviour of just calling GetMapping without a name.
     If this method is implemented than the engine will then call GetMapping only with the names returned from this method.
     """
+    Health: grpc.UnaryUnaryMultiCallable[
+        google.protobuf.empty_pb2.Empty,
+        google.protobuf.empty_pb2.Empty,
+    ]
+    """Health is an optional method that checks whether the provider can currently service requests, e.g. whether the
+    service it manages resources in is reachable. It should return an error if the provider is unhealthy. If a
+    provider does not implement this method the engine assumes that it is healthy.
+    """
+    Annotate: grpc.UnaryUnaryMultiCallable[
+        pulumi.provider_pb2.AnnotateRequest,
+        google.protobuf.empty_pb2.Empty,
+    ]
+    """Annotate is an optional method that attaches annotations, such as tags or labels, to an existing resource without
+    updating its inputs or outputs. The engine only calls this method for providers that report the "annotate"
+    capability in their ConfigureResponse.
+    """
 
 class ResourceProviderServicer(metaclass=abc.ABCMeta):
     """ResourceProvider is a service that understands how to create, read, update, or delete resources for types defined
@@ -306,5 +322,25 @@ class ResourceProviderServicer(metaclass=abc.ABCMeta):
         implement this method the engine falls back to the old behaviour of just calling GetMapping without a name.
         If this method is implemented than the engine will then call GetMapping only with the names returned from this method.
         """
+    
+    def Health(
+        self,
+        request: google.protobuf.empty_pb2.Empty,
+        context: grpc.ServicerContext,
+    ) -> google.protobuf.empty_pb2.Empty:
+        """Health is an optional method that checks whether the provider can currently service requests, e.g. whether the
+        service it manages resources in is reachable. It should return an error if the provider is unhealthy. If a
+        provider does not implement this method the engine assumes that it is healthy.
+        """
+    
+    def Annotate(
+        self,
+        request: pulumi.provider_pb2.AnnotateRequest,
+        context: grpc.ServicerContext,
+    ) -> google.protobuf.empty_pb2.Empty:
+        """Annotate is an optional method that attaches annotations, such as tags or labels, to an existing resource without
+        updating its inputs or outputs. The engine only calls this method for providers that report the "annotate"
+        capability in their ConfigureResponse.
+        """
 
 def add_ResourceProviderServicer_to_server(servicer: ResourceProviderServicer, server: typing.Union[grpc.Server, grpc.aio.Server]) -> None: ...